@@ -75,6 +75,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("Erro ao criar cliente Discord: %v", err)
 	}
+	discordClient.SetOwnerID(os.Getenv("BOT_OWNER_ID"))
 
 	// Conecta ao Discord
 	if err := discordClient.Connect(); err != nil {
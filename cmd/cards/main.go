@@ -0,0 +1,155 @@
+// Command cards importa e exporta o catálogo de cartas do MongoDB.
+//
+// Uso:
+//
+//	cards import -file cartas.yaml [-dry-run]
+//	cards export -file cartas.yaml
+//
+// O formato é identificado pela extensão do arquivo (.json, .yaml ou .yml).
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"sirdraith/internal/domain/catalog"
+	"sirdraith/internal/domain/services"
+	"sirdraith/internal/infrastructure/mongodb/repositories"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	file := flags.String("file", "", "arquivo de cartas (.json, .yaml ou .yml)")
+	dryRun := flags.Bool("dry-run", false, "apenas mostra o relatório, sem gravar")
+	if err := flags.Parse(os.Args[2:]); err != nil {
+		log.Fatal(err)
+	}
+	if *file == "" {
+		usage()
+	}
+
+	format, err := catalog.FormatFromFilename(*file)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Printf("Aviso: Arquivo .env não encontrado: %v\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	client, err := connect(ctx)
+	if err != nil {
+		log.Fatalf("Erro ao conectar ao MongoDB: %v", err)
+	}
+	defer client.Disconnect(context.Background())
+
+	catalogService := services.NewCatalogService(repositories.NewMongoCardRepository(client.Database("sirdraith")))
+
+	switch command {
+	case "import":
+		data, err := os.ReadFile(*file)
+		if err != nil {
+			log.Fatalf("Erro ao ler arquivo: %v", err)
+		}
+		report, err := catalogService.Import(ctx, data, format, *dryRun)
+		if err != nil {
+			log.Fatalf("Erro ao importar cartas: %v", err)
+		}
+		printReport(report)
+		if report.HasErrors() {
+			os.Exit(1)
+		}
+	case "export":
+		data, err := catalogService.Export(ctx, format)
+		if err != nil {
+			log.Fatalf("Erro ao exportar cartas: %v", err)
+		}
+		if err := os.WriteFile(*file, data, 0o644); err != nil {
+			log.Fatalf("Erro ao gravar arquivo: %v", err)
+		}
+		log.Printf("Catálogo exportado para %s", *file)
+	default:
+		usage()
+	}
+}
+
+// connect conecta ao MongoDB usando as mesmas variáveis de ambiente do bot
+func connect(ctx context.Context) (*mongo.Client, error) {
+	mongoURL := os.Getenv("MONGODB_URL")
+	if mongoURL == "" {
+		mongoURL = "mongodb://localhost:27017"
+	}
+	mongoAuthSource := os.Getenv("MONGODB_AUTH_SOURCE")
+	if mongoAuthSource == "" {
+		mongoAuthSource = "admin"
+	}
+
+	opts := options.Client().ApplyURI(mongoURL)
+	if user, pass := os.Getenv("MONGODB_USER"), os.Getenv("MONGODB_PASS"); user != "" && pass != "" {
+		opts.SetAuth(options.Credential{
+			Username:   user,
+			Password:   pass,
+			AuthSource: mongoAuthSource,
+		})
+	}
+
+	client, err := mongo.Connect(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func printReport(report *services.ImportReport) {
+	printList("Criadas", report.Created)
+	printList("Conflitos (sobrescritas)", report.Conflicts)
+	printList("Sem alterações", report.Unchanged)
+	printList("Duplicadas no arquivo", report.Duplicates)
+	if len(report.Invalid) > 0 {
+		fmt.Printf("Inválidas (%d):\n", len(report.Invalid))
+		for _, issue := range report.Invalid {
+			fmt.Printf("  - %s: %s\n", issue.CardID, issue.Reason)
+		}
+	}
+
+	switch {
+	case report.HasErrors():
+		fmt.Println("Nenhuma carta foi gravada: corrija os erros acima.")
+	case !report.Applied:
+		fmt.Println("Simulação: nenhuma carta foi gravada.")
+	default:
+		fmt.Println("Importação concluída.")
+	}
+}
+
+func printList(title string, ids []string) {
+	if len(ids) == 0 {
+		return
+	}
+	fmt.Printf("%s (%d): %s\n", title, len(ids), strings.Join(ids, ", "))
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "uso: cards <import|export> -file <arquivo.json|arquivo.yaml> [-dry-run]")
+	os.Exit(2)
+}
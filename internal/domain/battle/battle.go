@@ -0,0 +1,145 @@
+package battle
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"sirdraith/internal/domain/entities"
+)
+
+// Regras gerais do duelo
+const (
+	StartingHealth   = 30 // Vida inicial de cada herói
+	MaxMana          = 10 // Mana máxima por turno
+	OpeningHandFirst = 3  // Cartas na mão inicial de quem começa
+	OpeningHandOther = 4  // Cartas na mão inicial de quem joga em segundo
+	MaxHandSize      = 10 // Cartas compradas além desse limite são descartadas
+	MaxBoardSize     = 7  // Criaturas em campo por jogador
+	MaxArtifacts     = 3  // Artefatos em jogo por jogador
+	MaxTurns         = 60 // Limite de turnos antes do desempate por vida
+	MaxLogEntries    = 20 // Entradas mantidas no histórico do duelo
+)
+
+// Palavras-chave com efeito mecânico
+const (
+	KeywordTaunt  = "provocar"  // Inimigos precisam atacar esta criatura primeiro
+	KeywordCharge = "investida" // Pode atacar no turno em que entra em campo
+)
+
+// HeroSlot indica o herói como alvo em vez de uma criatura
+const HeroSlot = -1
+
+var (
+	// ErrDuelFinished indica que o duelo já terminou
+	ErrDuelFinished = errors.New("o duelo já terminou")
+	// ErrNotYourTurn indica uma ação fora do turno do jogador
+	ErrNotYourTurn = errors.New("não é o seu turno")
+	// ErrUnknownPlayer indica um jogador que não participa do duelo
+	ErrUnknownPlayer = errors.New("jogador não participa deste duelo")
+	// ErrInvalidHandIndex indica uma posição inválida na mão
+	ErrInvalidHandIndex = errors.New("carta inválida na mão")
+	// ErrInvalidAttacker indica uma criatura inválida para atacar
+	ErrInvalidAttacker = errors.New("criatura atacante inválida")
+	// ErrInvalidTarget indica um alvo inválido
+	ErrInvalidTarget = errors.New("alvo inválido")
+	// ErrNotEnoughMana indica mana insuficiente para jogar a carta
+	ErrNotEnoughMana = errors.New("mana insuficiente")
+	// ErrBoardFull indica que não há espaço em campo
+	ErrBoardFull = errors.New("campo cheio")
+	// ErrCannotAttack indica que a criatura não pode atacar neste turno
+	ErrCannotAttack = errors.New("esta criatura não pode atacar neste turno")
+	// ErrMustAttackTaunt indica que existe uma criatura com provocar no campo inimigo
+	ErrMustAttackTaunt = errors.New("é preciso atacar uma criatura com provocar primeiro")
+	// ErrEmptyDeck indica um deck sem cartas
+	ErrEmptyDeck = errors.New("o deck não possui cartas")
+)
+
+// DuelStatus representa o estado de um duelo
+type DuelStatus string
+
+const (
+	StatusActive   DuelStatus = "active"
+	StatusFinished DuelStatus = "finished"
+)
+
+// Target identifica o alvo de uma carta ou ataque
+type Target struct {
+	Player int // Índice do jogador dono do alvo (0 ou 1)
+	Slot   int // Posição da criatura em campo ou HeroSlot
+}
+
+// HeroTarget retorna o alvo que representa o herói de um jogador
+func HeroTarget(player int) Target {
+	return Target{Player: player, Slot: HeroSlot}
+}
+
+// CardInstance representa uma cópia de carta dentro de um duelo
+type CardInstance struct {
+	InstanceID int           `bson:"instance_id"`
+	Card       entities.Card `bson:"card"`
+	Attack     int           `bson:"attack"`     // Ataque atual
+	Health     int           `bson:"health"`     // Defesa restante
+	MaxHealth  int           `bson:"max_health"` // Defesa máxima
+	CanAttack  bool          `bson:"can_attack"` // Se ainda pode atacar neste turno
+	Modifiers  []Modifier    `bson:"modifiers,omitempty"`
+}
+
+// HasKeyword verifica se a carta possui uma palavra-chave
+func (ci *CardInstance) HasKeyword(keyword string) bool {
+	for _, k := range ci.Card.Keywords {
+		if k == keyword {
+			return true
+		}
+	}
+	return false
+}
+
+// Player representa o estado de um jogador no duelo
+type Player struct {
+	ID        string          `bson:"id"`
+	Name      string          `bson:"name"`
+	Health    int             `bson:"health"`
+	MaxHealth int             `bson:"max_health"`
+	Mana      int             `bson:"mana"`
+	MaxMana   int             `bson:"max_mana"`
+	Fatigue   int             `bson:"fatigue"` // Dano da próxima compra com o deck vazio
+	Library   []*CardInstance `bson:"library"`
+	Hand      []*CardInstance `bson:"hand"`
+	Board     []*CardInstance `bson:"board"`
+	Artifacts []*CardInstance `bson:"artifacts"`
+	Graveyard []*CardInstance `bson:"graveyard"`
+}
+
+// PlayerSetup contém os dados necessários para um jogador entrar no duelo
+type PlayerSetup struct {
+	ID    string
+	Name  string
+	Cards []*entities.Card // Uma entrada por cópia
+}
+
+// ExpandDeck converte um deck no conjunto de cartas usado no duelo.
+// As cartas são ordenadas pelo ID para que o embaralhamento dependa apenas da semente.
+func ExpandDeck(deck *entities.Deck, catalog map[string]*entities.Card) ([]*entities.Card, error) {
+	ids := make([]string, 0, len(deck.Cards))
+	for id := range deck.Cards {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	cards := make([]*entities.Card, 0, deck.GetCardCount())
+	for _, id := range ids {
+		card, ok := catalog[id]
+		if !ok || card == nil {
+			return nil, fmt.Errorf("carta %s não encontrada no catálogo", id)
+		}
+		for i := 0; i < deck.Cards[id]; i++ {
+			cards = append(cards, card)
+		}
+	}
+
+	if len(cards) == 0 {
+		return nil, ErrEmptyDeck
+	}
+	return cards, nil
+}
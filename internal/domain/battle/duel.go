@@ -0,0 +1,447 @@
+package battle
+
+import (
+	"fmt"
+	"math/rand"
+
+	"sirdraith/internal/domain/entities"
+)
+
+// Duel representa uma partida entre dois jogadores
+type Duel struct {
+	Players        []*Player       `bson:"players"`
+	Active         int             `bson:"active"` // Índice do jogador da vez
+	Turn           int             `bson:"turn"`
+	Status         DuelStatus      `bson:"status"`
+	Winner         string          `bson:"winner,omitempty"` // ID do vencedor, vazio em caso de empate
+	Seed           int64           `bson:"seed"`
+	NextInstanceID int             `bson:"next_instance_id"`
+	Ongoing        []OngoingEffect `bson:"ongoing,omitempty"`
+	Log            []string        `bson:"log"`
+}
+
+// NewDuel prepara um duelo: embaralha os decks, sorteia quem começa e
+// distribui as mãos iniciais. O resultado depende apenas da semente informada.
+func NewDuel(first, second PlayerSetup, seed int64) (*Duel, error) {
+	if len(first.Cards) == 0 || len(second.Cards) == 0 {
+		return nil, ErrEmptyDeck
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	d := &Duel{
+		Status: StatusActive,
+		Seed:   seed,
+	}
+
+	for _, setup := range []PlayerSetup{first, second} {
+		p := &Player{
+			ID:        setup.ID,
+			Name:      setup.Name,
+			Health:    StartingHealth,
+			MaxHealth: StartingHealth,
+			Fatigue:   1,
+		}
+		for _, card := range setup.Cards {
+			p.Library = append(p.Library, d.newInstance(card))
+		}
+		rng.Shuffle(len(p.Library), func(i, j int) {
+			p.Library[i], p.Library[j] = p.Library[j], p.Library[i]
+		})
+		d.Players = append(d.Players, p)
+	}
+
+	d.Active = rng.Intn(2)
+	for i := 0; i < OpeningHandFirst; i++ {
+		d.draw(d.Active)
+	}
+	for i := 0; i < OpeningHandOther; i++ {
+		d.draw(d.opponentOf(d.Active))
+	}
+
+	d.logf("⚔️ %s começa o duelo!", d.Players[d.Active].Name)
+	d.startTurn()
+	return d, nil
+}
+
+// newInstance cria uma nova cópia de carta com identificador único no duelo
+func (d *Duel) newInstance(card *entities.Card) *CardInstance {
+	d.NextInstanceID++
+	return &CardInstance{
+		InstanceID: d.NextInstanceID,
+		Card:       *card,
+		Attack:     card.Attack,
+		Health:     card.Defense,
+		MaxHealth:  card.Defense,
+	}
+}
+
+// ActivePlayer retorna o jogador da vez
+func (d *Duel) ActivePlayer() *Player {
+	return d.Players[d.Active]
+}
+
+// PlayerIndex retorna o índice de um jogador pelo ID
+func (d *Duel) PlayerIndex(playerID string) (int, error) {
+	for i, p := range d.Players {
+		if p.ID == playerID {
+			return i, nil
+		}
+	}
+	return -1, ErrUnknownPlayer
+}
+
+// IsFinished indica se o duelo terminou
+func (d *Duel) IsFinished() bool {
+	return d.Status == StatusFinished
+}
+
+func (d *Duel) opponentOf(index int) int {
+	return 1 - index
+}
+
+// checkTurn garante que a ação pertence ao jogador da vez
+func (d *Duel) checkTurn(playerID string) (int, error) {
+	if d.IsFinished() {
+		return -1, ErrDuelFinished
+	}
+	index, err := d.PlayerIndex(playerID)
+	if err != nil {
+		return -1, err
+	}
+	if index != d.Active {
+		return -1, ErrNotYourTurn
+	}
+	return index, nil
+}
+
+// PlayCard joga uma carta da mão do jogador. O alvo é usado por feitiços e encantamentos.
+func (d *Duel) PlayCard(playerID string, handIndex int, target *Target) error {
+	index, err := d.checkTurn(playerID)
+	if err != nil {
+		return err
+	}
+	p := d.Players[index]
+
+	if handIndex < 0 || handIndex >= len(p.Hand) {
+		return ErrInvalidHandIndex
+	}
+	ci := p.Hand[handIndex]
+	if ci.Card.Cost > p.Mana {
+		return fmt.Errorf("%w: %s custa %d e você tem %d", ErrNotEnoughMana, ci.Card.Name, ci.Card.Cost, p.Mana)
+	}
+
+	if target != nil && !d.validTarget(*target) {
+		return ErrInvalidTarget
+	}
+	if needsChosenCreature(&ci.Card) && (target == nil || !d.validCreature(*target)) {
+		return fmt.Errorf("%w: %s precisa de uma criatura como alvo", ErrInvalidTarget, ci.Card.Name)
+	}
+
+	switch ci.Card.Type {
+	case entities.CardTypeCreature:
+		if len(p.Board) >= MaxBoardSize {
+			return ErrBoardFull
+		}
+	case entities.CardTypeArtifact:
+		if len(p.Artifacts) >= MaxArtifacts {
+			return ErrBoardFull
+		}
+	case entities.CardTypeEnchant:
+		if target == nil || target.Player != index || !d.validCreature(*target) {
+			return fmt.Errorf("%w: encantamentos precisam de uma criatura aliada", ErrInvalidTarget)
+		}
+	case entities.CardTypeSpell:
+	default:
+		return fmt.Errorf("tipo de carta desconhecido: %s", ci.Card.Type)
+	}
+
+	p.Mana -= ci.Card.Cost
+	p.Hand = append(p.Hand[:handIndex], p.Hand[handIndex+1:]...)
+
+	switch ci.Card.Type {
+	case entities.CardTypeCreature:
+		ci.CanAttack = ci.HasKeyword(KeywordCharge)
+		p.Board = append(p.Board, ci)
+		d.logf("🐉 %s invoca %s (%d/%d)", p.Name, ci.Card.Name, ci.Attack, ci.Health)
+		d.resolveEffects(index, ci, target)
+	case entities.CardTypeArtifact:
+		p.Artifacts = append(p.Artifacts, ci)
+		d.logf("🏺 %s ativa o artefato %s", p.Name, ci.Card.Name)
+	case entities.CardTypeEnchant:
+		creature := d.Players[target.Player].Board[target.Slot]
+		creature.Attack += ci.Card.Attack
+		creature.Health += ci.Card.Defense
+		creature.MaxHealth += ci.Card.Defense
+		p.Graveyard = append(p.Graveyard, ci)
+		d.logf("✨ %s encanta %s com %s", p.Name, creature.Card.Name, ci.Card.Name)
+		d.resolveEffects(index, ci, target)
+	case entities.CardTypeSpell:
+		if hasEffects(&ci.Card) {
+			d.logf("📜 %s lança %s", p.Name, ci.Card.Name)
+			d.resolveEffects(index, ci, target)
+		} else {
+			d.resolveSpell(index, ci, target)
+		}
+		p.Graveyard = append(p.Graveyard, ci)
+	}
+
+	d.removeDead()
+	d.checkWinner()
+	return nil
+}
+
+// resolveSpell aplica um feitiço sem efeitos estruturados: o ataque causa dano ao alvo
+// (herói inimigo por padrão) e a defesa cura o herói de quem lançou
+func (d *Duel) resolveSpell(caster int, ci *CardInstance, target *Target) {
+	p := d.Players[caster]
+	d.logf("📜 %s lança %s", p.Name, ci.Card.Name)
+
+	if ci.Card.Attack > 0 {
+		t := HeroTarget(d.opponentOf(caster))
+		if target != nil {
+			t = *target
+		}
+		d.damage(t, ci.Card.Attack)
+	}
+	if ci.Card.Defense > 0 {
+		d.healHero(caster, ci.Card.Defense)
+	}
+}
+
+// Attack faz uma criatura do jogador atacar um alvo inimigo
+func (d *Duel) Attack(playerID string, attackerSlot int, target Target) error {
+	index, err := d.checkTurn(playerID)
+	if err != nil {
+		return err
+	}
+	p := d.Players[index]
+
+	if attackerSlot < 0 || attackerSlot >= len(p.Board) {
+		return ErrInvalidAttacker
+	}
+	attacker := p.Board[attackerSlot]
+	if !attacker.CanAttack || attacker.Attack <= 0 {
+		return ErrCannotAttack
+	}
+
+	enemy := d.opponentOf(index)
+	if target.Player != enemy || !d.validTarget(target) {
+		return ErrInvalidTarget
+	}
+	if d.hasTaunt(enemy) && (target.Slot == HeroSlot || !d.Players[enemy].Board[target.Slot].HasKeyword(KeywordTaunt)) {
+		return ErrMustAttackTaunt
+	}
+
+	attacker.CanAttack = false
+	if target.Slot == HeroSlot {
+		d.logf("🗡️ %s ataca %s diretamente", attacker.Card.Name, d.Players[enemy].Name)
+		d.damage(target, attacker.Attack)
+	} else {
+		defender := d.Players[enemy].Board[target.Slot]
+		d.logf("🗡️ %s ataca %s", attacker.Card.Name, defender.Card.Name)
+		defender.Health -= attacker.Attack
+		attacker.Health -= defender.Attack
+	}
+
+	d.removeDead()
+	d.checkWinner()
+	return nil
+}
+
+// EndTurn encerra o turno do jogador e inicia o turno do oponente
+func (d *Duel) EndTurn(playerID string) error {
+	index, err := d.checkTurn(playerID)
+	if err != nil {
+		return err
+	}
+
+	d.logf("⏭️ %s encerra o turno", d.Players[index].Name)
+	d.Active = d.opponentOf(index)
+	d.startTurn()
+	return nil
+}
+
+// Surrender encerra o duelo com a vitória do oponente. Pode ser usado fora do turno.
+func (d *Duel) Surrender(playerID string) error {
+	if d.IsFinished() {
+		return ErrDuelFinished
+	}
+	index, err := d.PlayerIndex(playerID)
+	if err != nil {
+		return err
+	}
+
+	d.logf("🏳️ %s desistiu", d.Players[index].Name)
+	d.finish(d.Players[d.opponentOf(index)].ID)
+	return nil
+}
+
+// startTurn prepara o turno do jogador ativo: mana, compra, criaturas, artefatos
+// e efeitos com duração
+func (d *Duel) startTurn() {
+	d.Turn++
+	if d.Turn > MaxTurns {
+		d.finishByHealth()
+		return
+	}
+	d.expireModifiers()
+
+	p := d.ActivePlayer()
+	if p.MaxMana < MaxMana {
+		p.MaxMana++
+	}
+	p.Mana = p.MaxMana
+
+	for _, creature := range p.Board {
+		creature.CanAttack = true
+	}
+
+	d.draw(d.Active)
+
+	for _, artifact := range p.Artifacts {
+		if hasEffects(&artifact.Card) {
+			d.resolveEffects(d.Active, artifact, nil)
+			continue
+		}
+		if artifact.Card.Attack > 0 {
+			d.damage(HeroTarget(d.opponentOf(d.Active)), artifact.Card.Attack)
+		}
+		if artifact.Card.Defense > 0 {
+			d.healHero(d.Active, artifact.Card.Defense)
+		}
+	}
+	d.tickOngoing()
+
+	d.removeDead()
+	d.checkWinner()
+}
+
+// draw compra uma carta para o jogador. Com o deck vazio o herói sofre dano de fadiga crescente.
+func (d *Duel) draw(index int) {
+	p := d.Players[index]
+	if len(p.Library) == 0 {
+		d.logf("💀 %s não tem cartas e sofre %d de fadiga", p.Name, p.Fatigue)
+		p.Health -= p.Fatigue
+		p.Fatigue++
+		return
+	}
+
+	card := p.Library[0]
+	p.Library = p.Library[1:]
+	if len(p.Hand) >= MaxHandSize {
+		p.Graveyard = append(p.Graveyard, card)
+		d.logf("🔥 A mão de %s está cheia: %s foi descartada", p.Name, card.Card.Name)
+		return
+	}
+	p.Hand = append(p.Hand, card)
+}
+
+// damage causa dano a uma criatura ou herói
+func (d *Duel) damage(t Target, amount int) {
+	if t.Slot == HeroSlot {
+		d.Players[t.Player].Health -= amount
+		return
+	}
+	d.Players[t.Player].Board[t.Slot].Health -= amount
+}
+
+// healHero cura o herói sem ultrapassar a vida máxima
+func (d *Duel) healHero(index int, amount int) {
+	p := d.Players[index]
+	p.Health += amount
+	if p.Health > p.MaxHealth {
+		p.Health = p.MaxHealth
+	}
+}
+
+// validTarget verifica se o alvo existe
+func (d *Duel) validTarget(t Target) bool {
+	if t.Player < 0 || t.Player >= len(d.Players) {
+		return false
+	}
+	return t.Slot == HeroSlot || d.validCreature(t)
+}
+
+// validCreature verifica se o alvo é uma criatura em campo
+func (d *Duel) validCreature(t Target) bool {
+	if t.Player < 0 || t.Player >= len(d.Players) {
+		return false
+	}
+	return t.Slot >= 0 && t.Slot < len(d.Players[t.Player].Board)
+}
+
+// hasTaunt verifica se o jogador possui criaturas com provocar
+func (d *Duel) hasTaunt(index int) bool {
+	for _, creature := range d.Players[index].Board {
+		if creature.HasKeyword(KeywordTaunt) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeDead move criaturas sem defesa para o cemitério
+func (d *Duel) removeDead() {
+	for _, p := range d.Players {
+		alive := p.Board[:0]
+		for _, creature := range p.Board {
+			if creature.Health > 0 {
+				alive = append(alive, creature)
+				continue
+			}
+			p.Graveyard = append(p.Graveyard, creature)
+			d.logf("☠️ %s de %s foi destruída", creature.Card.Name, p.Name)
+		}
+		p.Board = alive
+	}
+}
+
+// checkWinner encerra o duelo quando algum herói chega a zero de vida
+func (d *Duel) checkWinner() {
+	if d.IsFinished() {
+		return
+	}
+
+	firstDown := d.Players[0].Health <= 0
+	secondDown := d.Players[1].Health <= 0
+	switch {
+	case firstDown && secondDown:
+		d.finish("")
+	case firstDown:
+		d.finish(d.Players[1].ID)
+	case secondDown:
+		d.finish(d.Players[0].ID)
+	}
+}
+
+// finishByHealth encerra o duelo no limite de turnos: vence quem tiver mais vida
+func (d *Duel) finishByHealth() {
+	d.logf("⌛ Limite de %d turnos atingido", MaxTurns)
+	switch {
+	case d.Players[0].Health > d.Players[1].Health:
+		d.finish(d.Players[0].ID)
+	case d.Players[1].Health > d.Players[0].Health:
+		d.finish(d.Players[1].ID)
+	default:
+		d.finish("")
+	}
+}
+
+func (d *Duel) finish(winnerID string) {
+	d.Status = StatusFinished
+	d.Winner = winnerID
+	if winnerID == "" {
+		d.logf("🤝 O duelo terminou empatado")
+		return
+	}
+	index, _ := d.PlayerIndex(winnerID)
+	d.logf("🏆 %s venceu o duelo!", d.Players[index].Name)
+}
+
+// logf registra um evento no histórico do duelo
+func (d *Duel) logf(format string, args ...interface{}) {
+	d.Log = append(d.Log, fmt.Sprintf(format, args...))
+	if len(d.Log) > MaxLogEntries {
+		d.Log = d.Log[len(d.Log)-MaxLogEntries:]
+	}
+}
@@ -0,0 +1,308 @@
+package battle
+
+import (
+	"fmt"
+	"testing"
+
+	"sirdraith/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func creature(id string, cost, attack, defense int, keywords ...string) *entities.Card {
+	return &entities.Card{
+		ID:       id,
+		Name:     id,
+		Type:     entities.CardTypeCreature,
+		Rarity:   entities.CardRarityCommon,
+		Cost:     cost,
+		Attack:   attack,
+		Defense:  defense,
+		Keywords: keywords,
+	}
+}
+
+func spell(id string, cost, attack, defense int) *entities.Card {
+	return &entities.Card{
+		ID:      id,
+		Name:    id,
+		Type:    entities.CardTypeSpell,
+		Rarity:  entities.CardRarityCommon,
+		Cost:    cost,
+		Attack:  attack,
+		Defense: defense,
+	}
+}
+
+func copies(card *entities.Card, n int) []*entities.Card {
+	cards := make([]*entities.Card, n)
+	for i := range cards {
+		cards[i] = card
+	}
+	return cards
+}
+
+func newTestDuel(t *testing.T, first, second []*entities.Card, seed int64) *Duel {
+	t.Helper()
+	d, err := NewDuel(
+		PlayerSetup{ID: "p1", Name: "Arthur", Cards: first},
+		PlayerSetup{ID: "p2", Name: "Mordred", Cards: second},
+		seed,
+	)
+	require.NoError(t, err)
+	return d
+}
+
+// playGreedy joga um duelo inteiro: cada jogador baixa o que puder e ataca o herói
+func playGreedy(t *testing.T, d *Duel) {
+	t.Helper()
+	for !d.IsFinished() {
+		p := d.ActivePlayer()
+		for i := 0; i < len(p.Hand); {
+			if err := d.PlayCard(p.ID, i, nil); err != nil {
+				i++
+			}
+			if d.IsFinished() {
+				return
+			}
+		}
+		enemy := d.opponentOf(d.Active)
+		for slot := range p.Board {
+			target := HeroTarget(enemy)
+			for i, c := range d.Players[enemy].Board {
+				if c.HasKeyword(KeywordTaunt) {
+					target = Target{Player: enemy, Slot: i}
+				}
+			}
+			_ = d.Attack(p.ID, slot, target)
+			if d.IsFinished() {
+				return
+			}
+		}
+		require.NoError(t, d.EndTurn(p.ID))
+	}
+}
+
+func TestNewDuel(t *testing.T) {
+	deck := copies(creature("soldado", 1, 1, 1), 20)
+
+	t.Run("should deal opening hands and start first turn", func(t *testing.T) {
+		d := newTestDuel(t, deck, deck, 42)
+
+		first := d.ActivePlayer()
+		second := d.Players[d.opponentOf(d.Active)]
+		assert.Equal(t, StatusActive, d.Status)
+		assert.Equal(t, 1, d.Turn)
+		assert.Len(t, first.Hand, OpeningHandFirst+1)
+		assert.Len(t, second.Hand, OpeningHandOther)
+		assert.Equal(t, 1, first.Mana)
+		assert.Equal(t, StartingHealth, first.Health)
+	})
+
+	t.Run("should fail with empty deck", func(t *testing.T) {
+		_, err := NewDuel(PlayerSetup{ID: "p1", Cards: deck}, PlayerSetup{ID: "p2"}, 1)
+		assert.ErrorIs(t, err, ErrEmptyDeck)
+	})
+}
+
+func TestDuel_Deterministic(t *testing.T) {
+	var first, second []*entities.Card
+	for i := 0; i < 20; i++ {
+		first = append(first, creature(fmt.Sprintf("a%d", i), i%5+1, i%4+1, i%3+1))
+		second = append(second, creature(fmt.Sprintf("b%d", i), i%4+1, i%3+1, i%5+1))
+	}
+
+	d1 := newTestDuel(t, first, second, 7)
+	d2 := newTestDuel(t, first, second, 7)
+	playGreedy(t, d1)
+	playGreedy(t, d2)
+
+	assert.True(t, d1.IsFinished())
+	assert.Equal(t, d1.Winner, d2.Winner)
+	assert.Equal(t, d1.Turn, d2.Turn)
+	assert.Equal(t, d1.Log, d2.Log)
+}
+
+func TestDuel_PlayCard(t *testing.T) {
+	tests := []struct {
+		name    string
+		card    *entities.Card
+		target  func(d *Duel) *Target
+		wantErr error
+		check   func(t *testing.T, d *Duel)
+	}{
+		{
+			name: "should summon creature",
+			card: creature("lobo", 1, 2, 1),
+			check: func(t *testing.T, d *Duel) {
+				p := d.ActivePlayer()
+				require.Len(t, p.Board, 1)
+				assert.False(t, p.Board[0].CanAttack)
+				assert.Equal(t, 0, p.Mana)
+			},
+		},
+		{
+			name: "should let charge creature attack immediately",
+			card: creature("cavaleiro", 1, 2, 1, KeywordCharge),
+			check: func(t *testing.T, d *Duel) {
+				assert.True(t, d.ActivePlayer().Board[0].CanAttack)
+			},
+		},
+		{
+			name:    "should reject card without mana",
+			card:    creature("dragao", 8, 8, 8),
+			wantErr: ErrNotEnoughMana,
+		},
+		{
+			name: "should damage enemy hero with spell by default",
+			card: spell("raio", 1, 3, 0),
+			check: func(t *testing.T, d *Duel) {
+				assert.Equal(t, StartingHealth-3, d.Players[d.opponentOf(d.Active)].Health)
+			},
+		},
+		{
+			name: "should heal caster with spell defense",
+			card: spell("prece", 1, 0, 5),
+			target: func(d *Duel) *Target {
+				d.ActivePlayer().Health = 20
+				return nil
+			},
+			check: func(t *testing.T, d *Duel) {
+				assert.Equal(t, 25, d.ActivePlayer().Health)
+			},
+		},
+		{
+			name:    "should require allied creature for enchant",
+			card:    &entities.Card{ID: "bencao", Name: "Bênção", Type: entities.CardTypeEnchant, Cost: 1, Attack: 1},
+			wantErr: ErrInvalidTarget,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newTestDuel(t, copies(tt.card, 10), copies(tt.card, 10), 1)
+			var target *Target
+			if tt.target != nil {
+				target = tt.target(d)
+			}
+
+			err := d.PlayCard(d.ActivePlayer().ID, 0, target)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, d)
+		})
+	}
+}
+
+func TestDuel_TurnOrder(t *testing.T) {
+	d := newTestDuel(t, copies(creature("soldado", 1, 1, 1), 10), copies(creature("soldado", 1, 1, 1), 10), 3)
+	active := d.ActivePlayer()
+	waiting := d.Players[d.opponentOf(d.Active)]
+
+	assert.ErrorIs(t, d.EndTurn(waiting.ID), ErrNotYourTurn)
+	assert.ErrorIs(t, d.PlayCard("intruso", 0, nil), ErrUnknownPlayer)
+
+	require.NoError(t, d.EndTurn(active.ID))
+	assert.Equal(t, waiting.ID, d.ActivePlayer().ID)
+	assert.Equal(t, 1, waiting.MaxMana)
+
+	require.NoError(t, d.EndTurn(waiting.ID))
+	assert.Equal(t, 2, active.MaxMana)
+	assert.Equal(t, 2, active.Mana)
+}
+
+func TestDuel_Attack(t *testing.T) {
+	setup := func(t *testing.T, attacker, defender *entities.Card) (*Duel, *Player, *Player) {
+		d := newTestDuel(t, copies(attacker, 10), copies(defender, 10), 5)
+		first := d.ActivePlayer()
+		second := d.Players[d.opponentOf(d.Active)]
+		first.Board = append(first.Board, d.newInstance(attacker))
+		second.Board = append(second.Board, d.newInstance(defender))
+		first.Board[0].CanAttack = true
+		return d, first, second
+	}
+
+	t.Run("should trade damage between creatures", func(t *testing.T) {
+		d, first, second := setup(t, creature("orc", 2, 3, 2), creature("elfo", 2, 2, 3))
+		require.NoError(t, d.Attack(first.ID, 0, Target{Player: d.opponentOf(d.Active), Slot: 0}))
+
+		assert.Empty(t, first.Board)
+		assert.Empty(t, second.Board)
+		assert.Len(t, first.Graveyard, 1)
+		assert.Len(t, second.Graveyard, 1)
+	})
+
+	t.Run("should not attack twice", func(t *testing.T) {
+		d, first, _ := setup(t, creature("orc", 2, 3, 5), creature("elfo", 2, 0, 9))
+		require.NoError(t, d.Attack(first.ID, 0, HeroTarget(d.opponentOf(d.Active))))
+		assert.ErrorIs(t, d.Attack(first.ID, 0, HeroTarget(d.opponentOf(d.Active))), ErrCannotAttack)
+	})
+
+	t.Run("should respect taunt", func(t *testing.T) {
+		d, first, _ := setup(t, creature("orc", 2, 3, 2), creature("guarda", 2, 1, 4, KeywordTaunt))
+		assert.ErrorIs(t, d.Attack(first.ID, 0, HeroTarget(d.opponentOf(d.Active))), ErrMustAttackTaunt)
+	})
+
+	t.Run("should win when hero reaches zero", func(t *testing.T) {
+		d, first, second := setup(t, creature("orc", 2, 5, 2), creature("elfo", 2, 0, 1))
+		second.Health = 5
+		require.NoError(t, d.Attack(first.ID, 0, HeroTarget(d.opponentOf(d.Active))))
+
+		assert.True(t, d.IsFinished())
+		assert.Equal(t, first.ID, d.Winner)
+		assert.ErrorIs(t, d.EndTurn(first.ID), ErrDuelFinished)
+	})
+}
+
+func TestDuel_Fatigue(t *testing.T) {
+	d := newTestDuel(t, copies(creature("soldado", 1, 1, 1), 4), copies(creature("soldado", 1, 1, 1), 4), 9)
+	first := d.ActivePlayer()
+	assert.Empty(t, first.Library)
+
+	second := d.Players[d.opponentOf(d.Active)]
+	require.NoError(t, d.EndTurn(first.ID))
+	require.NoError(t, d.EndTurn(second.ID))
+
+	assert.Equal(t, StartingHealth-1, first.Health)
+	assert.Equal(t, 2, first.Fatigue)
+}
+
+func TestDuel_Surrender(t *testing.T) {
+	d := newTestDuel(t, copies(creature("soldado", 1, 1, 1), 10), copies(creature("soldado", 1, 1, 1), 10), 13)
+	waiting := d.Players[d.opponentOf(d.Active)]
+
+	require.NoError(t, d.Surrender(waiting.ID))
+	assert.True(t, d.IsFinished())
+	assert.Equal(t, d.ActivePlayer().ID, d.Winner)
+	assert.ErrorIs(t, d.Surrender(waiting.ID), ErrDuelFinished)
+}
+
+func TestExpandDeck(t *testing.T) {
+	catalog := map[string]*entities.Card{
+		"a": creature("a", 1, 1, 1),
+		"b": creature("b", 1, 1, 1),
+	}
+
+	t.Run("should expand quantities in stable order", func(t *testing.T) {
+		deck := entities.NewDeck("u", "g", "Deck", "", "warrior")
+		deck.Cards["b"] = 1
+		deck.Cards["a"] = 2
+
+		cards, err := ExpandDeck(deck, catalog)
+		require.NoError(t, err)
+		require.Len(t, cards, 3)
+		assert.Equal(t, []string{"a", "a", "b"}, []string{cards[0].ID, cards[1].ID, cards[2].ID})
+	})
+
+	t.Run("should fail with unknown card", func(t *testing.T) {
+		deck := entities.NewDeck("u", "g", "Deck", "", "warrior")
+		deck.Cards["x"] = 1
+
+		_, err := ExpandDeck(deck, catalog)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,290 @@
+package battle
+
+import (
+	"sirdraith/internal/domain/entities"
+)
+
+// Modifier é um bônus temporário aplicado a uma criatura
+type Modifier struct {
+	Attack    int `bson:"attack"`
+	Health    int `bson:"health"`
+	ExpiresOn int `bson:"expires_on"` // Turno do duelo em que o bônus é desfeito
+}
+
+// OngoingEffect é um efeito de dano ou cura que se repete a cada turno de quem o lançou
+type OngoingEffect struct {
+	Effect     entities.Effect `bson:"effect"`
+	Caster     int             `bson:"caster"`
+	Player     int             `bson:"player"`      // Dono do alvo
+	InstanceID int             `bson:"instance_id"` // Criatura alvo, zero para o herói
+	Remaining  int             `bson:"remaining"`   // Turnos restantes
+}
+
+// hasEffects indica se a carta possui efeitos estruturados executáveis
+func hasEffects(card *entities.Card) bool {
+	for _, effect := range card.Effects {
+		if effect.IsExecutable() {
+			return true
+		}
+	}
+	return false
+}
+
+// needsChosenCreature indica se algum efeito exige uma criatura escolhida como alvo
+func needsChosenCreature(card *entities.Card) bool {
+	for _, effect := range card.Effects {
+		if effect.Target != entities.EffectTargetChosen {
+			continue
+		}
+		if effect.Type == entities.EffectBuff || effect.Type == entities.EffectDebuff {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveEffects aplica os efeitos estruturados de uma carta. As condições de cada
+// efeito são avaliadas no momento da resolução; efeitos cujas condições falham são ignorados.
+func (d *Duel) resolveEffects(caster int, source *CardInstance, chosen *Target) {
+	for _, effect := range source.Card.Effects {
+		if !effect.IsExecutable() {
+			continue
+		}
+		if !d.conditionsMet(caster, effect.Conditions) {
+			d.logf("🚫 Condição de %s não atendida", source.Card.Name)
+			continue
+		}
+		d.applyEffect(caster, source, effect, chosen)
+	}
+}
+
+// conditionsMet avalia as condições de um efeito para quem o lançou
+func (d *Duel) conditionsMet(caster int, conditions []string) bool {
+	p := d.Players[caster]
+	enemy := d.Players[d.opponentOf(caster)]
+
+	for _, condition := range conditions {
+		name, value, err := entities.ParseCondition(condition)
+		if err != nil {
+			return false
+		}
+
+		var met bool
+		switch name {
+		case entities.ConditionCasterHealthBelow:
+			met = p.Health < value
+		case entities.ConditionOpponentHealthBelow:
+			met = enemy.Health < value
+		case entities.ConditionAllyCreaturesAtLeast:
+			met = len(p.Board) >= value
+		case entities.ConditionEnemyCreaturesAtLeast:
+			met = len(enemy.Board) >= value
+		case entities.ConditionHandAtLeast:
+			met = len(p.Hand) >= value
+		case entities.ConditionTurnAtLeast:
+			met = d.Turn >= value
+		}
+		if !met {
+			return false
+		}
+	}
+	return true
+}
+
+// applyEffect aplica um único efeito aos alvos resolvidos
+func (d *Duel) applyEffect(caster int, source *CardInstance, effect entities.Effect, chosen *Target) {
+	targets := d.effectTargets(caster, source, effect, chosen)
+
+	for _, t := range targets {
+		switch effect.Type {
+		case entities.EffectDamage:
+			d.damage(t, effect.Value)
+		case entities.EffectHeal:
+			d.heal(t, effect.Value)
+		case entities.EffectBuff:
+			d.modify(t, effect.Value, effect.Value, effect.Duration)
+		case entities.EffectDebuff:
+			d.modify(t, -effect.Value, 0, effect.Duration)
+		case entities.EffectDraw:
+			if t.Slot == HeroSlot {
+				for i := 0; i < effect.Value; i++ {
+					d.draw(t.Player)
+				}
+			}
+		}
+
+		if effect.Duration > 0 && (effect.Type == entities.EffectDamage || effect.Type == entities.EffectHeal) {
+			ongoing := OngoingEffect{
+				Effect:    effect,
+				Caster:    caster,
+				Player:    t.Player,
+				Remaining: effect.Duration,
+			}
+			if t.Slot != HeroSlot {
+				ongoing.InstanceID = d.Players[t.Player].Board[t.Slot].InstanceID
+			}
+			d.Ongoing = append(d.Ongoing, ongoing)
+		}
+	}
+
+	d.logf("✨ %s: %s %d", source.Card.Name, effect.Type, effect.Value)
+}
+
+// effectTargets converte o alvo declarado no efeito em alvos concretos do duelo
+func (d *Duel) effectTargets(caster int, source *CardInstance, effect entities.Effect, chosen *Target) []Target {
+	enemy := d.opponentOf(caster)
+
+	target := effect.Target
+	if target == "" || (target == entities.EffectTargetChosen && chosen == nil) {
+		target = defaultEffectTarget(effect.Type)
+	}
+
+	switch target {
+	case entities.EffectTargetSelf:
+		return []Target{HeroTarget(caster)}
+	case entities.EffectTargetOpponent:
+		return []Target{HeroTarget(enemy)}
+	case entities.EffectTargetChosen:
+		if d.validTarget(*chosen) {
+			return []Target{*chosen}
+		}
+	case entities.EffectTargetSource:
+		for slot, creature := range d.Players[caster].Board {
+			if creature.InstanceID == source.InstanceID {
+				return []Target{{Player: caster, Slot: slot}}
+			}
+		}
+	case entities.EffectTargetAllyCreatures:
+		return d.creatureTargets(caster)
+	case entities.EffectTargetEnemyCreatures:
+		return d.creatureTargets(enemy)
+	case entities.EffectTargetAllCreatures:
+		return append(d.creatureTargets(caster), d.creatureTargets(enemy)...)
+	}
+	return nil
+}
+
+// defaultEffectTarget define o alvo de efeitos sem alvo declarado
+func defaultEffectTarget(effectType string) string {
+	switch effectType {
+	case entities.EffectDamage:
+		return entities.EffectTargetOpponent
+	case entities.EffectBuff:
+		return entities.EffectTargetSource
+	case entities.EffectDebuff:
+		return entities.EffectTargetEnemyCreatures
+	default:
+		return entities.EffectTargetSelf
+	}
+}
+
+// creatureTargets lista todas as criaturas em campo de um jogador
+func (d *Duel) creatureTargets(player int) []Target {
+	targets := make([]Target, 0, len(d.Players[player].Board))
+	for slot := range d.Players[player].Board {
+		targets = append(targets, Target{Player: player, Slot: slot})
+	}
+	return targets
+}
+
+// heal cura uma criatura ou herói sem ultrapassar o máximo
+func (d *Duel) heal(t Target, amount int) {
+	if t.Slot == HeroSlot {
+		d.healHero(t.Player, amount)
+		return
+	}
+	creature := d.Players[t.Player].Board[t.Slot]
+	creature.Health += amount
+	if creature.Health > creature.MaxHealth {
+		creature.Health = creature.MaxHealth
+	}
+}
+
+// modify altera ataque e defesa de uma criatura. Com duração, o bônus é desfeito
+// no início do turno correspondente de quem o lançou.
+func (d *Duel) modify(t Target, attack, health, duration int) {
+	if t.Slot == HeroSlot {
+		return
+	}
+	creature := d.Players[t.Player].Board[t.Slot]
+
+	// O ataque nunca fica negativo; registra apenas a redução efetiva
+	if creature.Attack+attack < 0 {
+		attack = -creature.Attack
+	}
+	creature.Attack += attack
+	creature.Health += health
+	creature.MaxHealth += health
+
+	if duration > 0 {
+		creature.Modifiers = append(creature.Modifiers, Modifier{
+			Attack:    attack,
+			Health:    health,
+			ExpiresOn: d.Turn + 2*duration,
+		})
+	}
+}
+
+// expireModifiers desfaz os bônus temporários vencidos
+func (d *Duel) expireModifiers() {
+	for _, p := range d.Players {
+		for _, creature := range p.Board {
+			active := creature.Modifiers[:0]
+			for _, m := range creature.Modifiers {
+				if m.ExpiresOn > d.Turn {
+					active = append(active, m)
+					continue
+				}
+				creature.Attack -= m.Attack
+				creature.MaxHealth -= m.Health
+				if creature.Health > creature.MaxHealth {
+					creature.Health = creature.MaxHealth
+				}
+			}
+			creature.Modifiers = active
+		}
+	}
+}
+
+// tickOngoing aplica os efeitos contínuos de quem inicia o turno
+func (d *Duel) tickOngoing() {
+	remaining := d.Ongoing[:0]
+	for _, ongoing := range d.Ongoing {
+		if ongoing.Caster != d.Active {
+			remaining = append(remaining, ongoing)
+			continue
+		}
+
+		t := HeroTarget(ongoing.Player)
+		if ongoing.InstanceID != 0 {
+			slot := d.findCreature(ongoing.Player, ongoing.InstanceID)
+			if slot < 0 {
+				continue // A criatura alvo já saiu de campo
+			}
+			t.Slot = slot
+		}
+
+		switch ongoing.Effect.Type {
+		case entities.EffectDamage:
+			d.damage(t, ongoing.Effect.Value)
+		case entities.EffectHeal:
+			d.heal(t, ongoing.Effect.Value)
+		}
+
+		ongoing.Remaining--
+		if ongoing.Remaining > 0 {
+			remaining = append(remaining, ongoing)
+		}
+	}
+	d.Ongoing = remaining
+}
+
+// findCreature retorna a posição de uma criatura pelo identificador da instância
+func (d *Duel) findCreature(player, instanceID int) int {
+	for slot, creature := range d.Players[player].Board {
+		if creature.InstanceID == instanceID {
+			return slot
+		}
+	}
+	return -1
+}
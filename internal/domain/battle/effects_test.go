@@ -0,0 +1,140 @@
+package battle
+
+import (
+	"testing"
+
+	"sirdraith/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withEffects(card *entities.Card, effects ...entities.Effect) *entities.Card {
+	c := *card
+	c.Effects = effects
+	return &c
+}
+
+// setupEffectDuel cria um duelo em que o jogador da vez tem a carta na mão e mana de sobra
+func setupEffectDuel(t *testing.T, card *entities.Card) (*Duel, *Player, *Player) {
+	t.Helper()
+	filler := creature("soldado", 1, 1, 1)
+	d := newTestDuel(t, copies(filler, 10), copies(filler, 10), 11)
+
+	p := d.ActivePlayer()
+	enemy := d.Players[d.opponentOf(d.Active)]
+	p.Hand = append([]*CardInstance{d.newInstance(card)}, p.Hand...)
+	p.Mana = MaxMana
+	return d, p, enemy
+}
+
+func TestDuel_ResolveEffects(t *testing.T) {
+	t.Run("should deal damage to every enemy creature", func(t *testing.T) {
+		card := withEffects(spell("tempestade", 2, 0, 0),
+			entities.Effect{Type: entities.EffectDamage, Value: 2, Target: entities.EffectTargetEnemyCreatures})
+		d, p, enemy := setupEffectDuel(t, card)
+		enemy.Board = append(enemy.Board, d.newInstance(creature("goblin", 1, 1, 2)), d.newInstance(creature("ogro", 3, 3, 5)))
+
+		require.NoError(t, d.PlayCard(p.ID, 0, nil))
+		require.Len(t, enemy.Board, 1)
+		assert.Equal(t, 3, enemy.Board[0].Health)
+		assert.Equal(t, StartingHealth, enemy.Health)
+	})
+
+	t.Run("should draw cards for the caster", func(t *testing.T) {
+		card := withEffects(spell("estudo", 1, 0, 0), entities.Effect{Type: entities.EffectDraw, Value: 2})
+		d, p, _ := setupEffectDuel(t, card)
+		handBefore := len(p.Hand)
+
+		require.NoError(t, d.PlayCard(p.ID, 0, nil))
+		assert.Len(t, p.Hand, handBefore-1+2)
+	})
+
+	t.Run("should buff summoned creature by default", func(t *testing.T) {
+		card := withEffects(creature("capitao", 2, 2, 2), entities.Effect{Type: entities.EffectBuff, Value: 1})
+		d, p, _ := setupEffectDuel(t, card)
+
+		require.NoError(t, d.PlayCard(p.ID, 0, nil))
+		require.Len(t, p.Board, 1)
+		assert.Equal(t, 3, p.Board[0].Attack)
+		assert.Equal(t, 3, p.Board[0].Health)
+	})
+
+	t.Run("should require creature target for chosen buff", func(t *testing.T) {
+		card := withEffects(spell("furia", 1, 0, 0),
+			entities.Effect{Type: entities.EffectBuff, Value: 2, Target: entities.EffectTargetChosen})
+		d, p, _ := setupEffectDuel(t, card)
+
+		assert.ErrorIs(t, d.PlayCard(p.ID, 0, nil), ErrInvalidTarget)
+	})
+
+	t.Run("should skip effect when condition fails", func(t *testing.T) {
+		card := withEffects(spell("desespero", 1, 0, 0),
+			entities.Effect{Type: entities.EffectDamage, Value: 10, Conditions: []string{"caster_health_below:10"}})
+		d, p, enemy := setupEffectDuel(t, card)
+
+		require.NoError(t, d.PlayCard(p.ID, 0, nil))
+		assert.Equal(t, StartingHealth, enemy.Health)
+	})
+
+	t.Run("should apply effect when condition holds at resolution", func(t *testing.T) {
+		card := withEffects(spell("desespero", 1, 0, 0),
+			entities.Effect{Type: entities.EffectDamage, Value: 10, Conditions: []string{"caster_health_below:10"}})
+		d, p, enemy := setupEffectDuel(t, card)
+		p.Health = 5
+
+		require.NoError(t, d.PlayCard(p.ID, 0, nil))
+		assert.Equal(t, StartingHealth-10, enemy.Health)
+	})
+}
+
+func TestDuel_DurationEffects(t *testing.T) {
+	t.Run("should revert temporary buff after duration", func(t *testing.T) {
+		card := withEffects(spell("furia", 1, 0, 0),
+			entities.Effect{Type: entities.EffectBuff, Value: 2, Duration: 1, Target: entities.EffectTargetChosen})
+		d, p, enemy := setupEffectDuel(t, card)
+		p.Board = append(p.Board, d.newInstance(creature("lobo", 1, 1, 1)))
+		own := d.Active
+
+		require.NoError(t, d.PlayCard(p.ID, 0, &Target{Player: own, Slot: 0}))
+		assert.Equal(t, 3, p.Board[0].Attack)
+		assert.Equal(t, 3, p.Board[0].Health)
+
+		require.NoError(t, d.EndTurn(p.ID))
+		assert.Equal(t, 3, p.Board[0].Attack, "buff lasts through the opponent turn")
+
+		require.NoError(t, d.EndTurn(enemy.ID))
+		assert.Equal(t, 1, p.Board[0].Attack)
+		assert.Equal(t, 1, p.Board[0].Health)
+	})
+
+	t.Run("should repeat damage over caster turns", func(t *testing.T) {
+		card := withEffects(spell("veneno", 1, 0, 0),
+			entities.Effect{Type: entities.EffectDamage, Value: 2, Duration: 2, Target: entities.EffectTargetOpponent})
+		d, p, enemy := setupEffectDuel(t, card)
+
+		require.NoError(t, d.PlayCard(p.ID, 0, nil))
+		assert.Equal(t, StartingHealth-2, enemy.Health)
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, d.EndTurn(p.ID))
+			require.NoError(t, d.EndTurn(enemy.ID))
+		}
+		assert.Equal(t, StartingHealth-6, enemy.Health)
+		assert.Empty(t, d.Ongoing)
+	})
+
+	t.Run("should not reduce attack below zero", func(t *testing.T) {
+		card := withEffects(spell("fraqueza", 1, 0, 0),
+			entities.Effect{Type: entities.EffectDebuff, Value: 5, Duration: 1})
+		d, p, enemy := setupEffectDuel(t, card)
+		enemy.Board = append(enemy.Board, d.newInstance(creature("goblin", 1, 2, 2)))
+
+		require.NoError(t, d.PlayCard(p.ID, 0, nil))
+		assert.Equal(t, 0, enemy.Board[0].Attack)
+
+		require.NoError(t, d.EndTurn(p.ID))
+		require.NoError(t, d.EndTurn(enemy.ID))
+		assert.Equal(t, 2, enemy.Board[0].Attack)
+	})
+}
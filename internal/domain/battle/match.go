@@ -0,0 +1,82 @@
+package battle
+
+import (
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MatchStatus representa a situação de um desafio entre jogadores
+type MatchStatus string
+
+const (
+	MatchPending  MatchStatus = "pending"  // Aguardando resposta do desafiado
+	MatchActive   MatchStatus = "active"   // Duelo em andamento
+	MatchFinished MatchStatus = "finished" // Duelo encerrado
+	MatchDeclined MatchStatus = "declined" // Desafio recusado ou cancelado
+)
+
+// ErrMatchNotActive indica uma ação em um desafio que não está em andamento
+var ErrMatchNotActive = errors.New("o duelo não está em andamento")
+
+// Match representa um desafio entre dois membros de um servidor e o duelo resultante
+type Match struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	GuildID        string             `bson:"guild_id"`
+	ChannelID      string             `bson:"channel_id"`
+	MessageID      string             `bson:"message_id,omitempty"` // Mensagem com o tabuleiro
+	ChallengerID   string             `bson:"challenger_id"`
+	ChallengerName string             `bson:"challenger_name"`
+	ChallengerDeck string             `bson:"challenger_deck"`
+	OpponentID     string             `bson:"opponent_id"`
+	OpponentName   string             `bson:"opponent_name"`
+	OpponentDeck   string             `bson:"opponent_deck,omitempty"`
+	Status         MatchStatus        `bson:"status"`
+	Duel           *Duel              `bson:"duel,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	UpdatedAt      time.Time          `bson:"updated_at"`
+}
+
+// NewMatch cria um desafio pendente
+func NewMatch(guildID, channelID, challengerID, challengerName, deckID, opponentID, opponentName string) *Match {
+	now := time.Now()
+	return &Match{
+		ID:             primitive.NewObjectID(),
+		GuildID:        guildID,
+		ChannelID:      channelID,
+		ChallengerID:   challengerID,
+		ChallengerName: challengerName,
+		ChallengerDeck: deckID,
+		OpponentID:     opponentID,
+		OpponentName:   opponentName,
+		Status:         MatchPending,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// Start inicia o duelo após o desafio ser aceito
+func (m *Match) Start(challenger, opponent PlayerSetup, seed int64) error {
+	duel, err := NewDuel(challenger, opponent, seed)
+	if err != nil {
+		return err
+	}
+	m.Duel = duel
+	m.Status = MatchActive
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// Involves indica se o usuário participa do desafio
+func (m *Match) Involves(userID string) bool {
+	return m.ChallengerID == userID || m.OpponentID == userID
+}
+
+// Sync atualiza a situação do desafio a partir do duelo
+func (m *Match) Sync() {
+	if m.Duel != nil && m.Duel.IsFinished() {
+		m.Status = MatchFinished
+	}
+	m.UpdatedAt = time.Now()
+}
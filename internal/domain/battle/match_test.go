@@ -0,0 +1,51 @@
+package battle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestMatch_Lifecycle(t *testing.T) {
+	match := NewMatch("guild", "canal", "p1", "Ana", "deck1", "p2", "Bia")
+	assert.Equal(t, MatchPending, match.Status)
+	assert.True(t, match.Involves("p2"))
+	assert.False(t, match.Involves("p3"))
+
+	cards := copies(creature("soldado", 1, 1, 1), 10)
+	require.NoError(t, match.Start(
+		PlayerSetup{ID: "p1", Name: "Ana", Cards: cards},
+		PlayerSetup{ID: "p2", Name: "Bia", Cards: cards},
+		21,
+	))
+	assert.Equal(t, MatchActive, match.Status)
+
+	require.NoError(t, match.Duel.Surrender("p1"))
+	match.Sync()
+	assert.Equal(t, MatchFinished, match.Status)
+	assert.Equal(t, "p2", match.Duel.Winner)
+}
+
+func TestMatch_BSONRoundTrip(t *testing.T) {
+	match := NewMatch("guild", "canal", "p1", "Ana", "deck1", "p2", "Bia")
+	cards := copies(creature("soldado", 1, 1, 1), 10)
+	require.NoError(t, match.Start(
+		PlayerSetup{ID: "p1", Name: "Ana", Cards: cards},
+		PlayerSetup{ID: "p2", Name: "Bia", Cards: cards},
+		21,
+	))
+
+	data, err := bson.Marshal(match)
+	require.NoError(t, err)
+
+	var loaded Match
+	require.NoError(t, bson.Unmarshal(data, &loaded))
+	assert.Equal(t, match.Duel.Players, loaded.Duel.Players)
+	assert.Equal(t, match.Duel.Active, loaded.Duel.Active)
+
+	active := loaded.Duel.ActivePlayer()
+	require.NoError(t, loaded.Duel.EndTurn(active.ID))
+	assert.NotEqual(t, active.ID, loaded.Duel.ActivePlayer().ID)
+}
@@ -0,0 +1,160 @@
+package bestiary
+
+import (
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/gamedata"
+)
+
+// Monsters retorna o bestiário inicial. Cada chamada devolve cópias novas
+func Monsters() []*entities.Monster {
+	return []*entities.Monster{
+		{
+			ID:              "rato-gigante",
+			Name:            "Rato Gigante",
+			Description:     "Um roedor do tamanho de um cão, comum nos esgotos das cidades.",
+			ChallengeRating: 0.125,
+			Experience:      10,
+			Gold:            1,
+			MaxHealth:       7,
+			Armor:           12,
+			Initiative:      2,
+			Attributes:      gamedata.Attributes{Strength: 7, Dexterity: 15, Constitution: 11, Intelligence: 2, Wisdom: 10, Charisma: 4},
+			Attacks:         []entities.MonsterAttack{{Name: "Mordida", Bonus: 4, DamageDice: 1, DamageDie: 4, DamageBonus: 2}},
+		},
+		{
+			ID:              "goblin",
+			Name:            "Goblin",
+			Description:     "Pequeno, ardiloso e covarde quando está sozinho.",
+			ChallengeRating: 0.25,
+			Experience:      25,
+			Gold:            5,
+			MaxHealth:       7,
+			Armor:           15,
+			Initiative:      2,
+			Attributes:      gamedata.Attributes{Strength: 8, Dexterity: 14, Constitution: 10, Intelligence: 10, Wisdom: 8, Charisma: 8},
+			Attacks:         []entities.MonsterAttack{{Name: "Cimitarra", Bonus: 4, DamageDice: 1, DamageDie: 6, DamageBonus: 2}},
+			Loot: []entities.LootEntry{
+				{Item: entities.Item{Name: "Adaga Enferrujada", Description: "Uma adaga velha e lascada.", Quantity: 1, Type: gamedata.Weapon, Value: 2, Slot: gamedata.MainHand, Proficiency: gamedata.SimpleWeapons, Rarity: gamedata.Common, Stats: gamedata.ItemStats{Attack: 1}}, Chance: 20},
+			},
+		},
+		{
+			ID:              "lobo",
+			Name:            "Lobo",
+			Description:     "Caça em matilhas e cerca as presas mais fracas.",
+			ChallengeRating: 0.25,
+			Experience:      30,
+			Gold:            0,
+			MaxHealth:       11,
+			Armor:           13,
+			Initiative:      2,
+			Attributes:      gamedata.Attributes{Strength: 12, Dexterity: 15, Constitution: 12, Intelligence: 3, Wisdom: 12, Charisma: 6},
+			Attacks:         []entities.MonsterAttack{{Name: "Mordida", Bonus: 4, DamageDice: 2, DamageDie: 4, DamageBonus: 2}},
+			Loot: []entities.LootEntry{
+				{Item: entities.Item{Name: "Pele de Lobo", Description: "Uma pele grossa, vendida por bom preço.", Quantity: 1, Type: gamedata.Quest, Value: 5, Rarity: gamedata.Common}, Chance: 50},
+			},
+		},
+		{
+			ID:              "bandido",
+			Name:            "Bandido",
+			Description:     "Salteador das estradas do reino.",
+			ChallengeRating: 0.125,
+			Experience:      35,
+			Gold:            12,
+			MaxHealth:       11,
+			Armor:           12,
+			Initiative:      1,
+			Attributes:      gamedata.Attributes{Strength: 11, Dexterity: 12, Constitution: 12, Intelligence: 10, Wisdom: 10, Charisma: 10},
+			Attacks:         []entities.MonsterAttack{{Name: "Espada Curta", Bonus: 3, DamageDice: 1, DamageDie: 6, DamageBonus: 1}},
+			Loot: []entities.LootEntry{
+				{Item: entities.Item{Name: "Poção de Cura", Description: "Um frasco de líquido vermelho e amargo.", Quantity: 1, Type: gamedata.Consumable, Value: 25, Effects: "heal:8", Rarity: gamedata.Common}, Chance: 30},
+			},
+		},
+		{
+			ID:              "esqueleto",
+			Name:            "Esqueleto",
+			Description:     "Ossos animados por magia antiga, incansáveis.",
+			ChallengeRating: 0.25,
+			Experience:      40,
+			Gold:            3,
+			MaxHealth:       13,
+			Armor:           13,
+			Initiative:      2,
+			Attributes:      gamedata.Attributes{Strength: 10, Dexterity: 14, Constitution: 15, Intelligence: 6, Wisdom: 8, Charisma: 5},
+			Attacks:         []entities.MonsterAttack{{Name: "Espada Curta", Bonus: 4, DamageDice: 1, DamageDie: 6, DamageBonus: 2}},
+		},
+		{
+			ID:              "orc",
+			Name:            "Orc",
+			Description:     "Guerreiro brutal que vive para a batalha.",
+			ChallengeRating: 0.5,
+			Experience:      60,
+			Gold:            10,
+			MaxHealth:       15,
+			Armor:           13,
+			Initiative:      1,
+			Attributes:      gamedata.Attributes{Strength: 16, Dexterity: 12, Constitution: 16, Intelligence: 7, Wisdom: 11, Charisma: 10},
+			Attacks:         []entities.MonsterAttack{{Name: "Machado Grande", Bonus: 5, DamageDice: 1, DamageDie: 12, DamageBonus: 3}},
+			Loot: []entities.LootEntry{
+				{Item: entities.Item{Name: "Machado de Orc", Description: "Pesado e mal equilibrado, mas afiado.", Quantity: 1, Type: gamedata.Weapon, Value: 15, Slot: gamedata.MainHand, Proficiency: gamedata.MartialWeapons, Rarity: gamedata.Common, Stats: gamedata.ItemStats{Attack: 3}}, Chance: 15},
+			},
+		},
+		{
+			ID:              "aranha-gigante",
+			Name:            "Aranha Gigante",
+			Description:     "Tece teias entre as árvores e envenena suas presas.",
+			ChallengeRating: 1,
+			Experience:      80,
+			Gold:            0,
+			MaxHealth:       26,
+			Armor:           14,
+			Initiative:      3,
+			Attributes:      gamedata.Attributes{Strength: 14, Dexterity: 16, Constitution: 12, Intelligence: 2, Wisdom: 11, Charisma: 4},
+			Attacks:         []entities.MonsterAttack{{Name: "Picada", Bonus: 5, DamageDice: 1, DamageDie: 8, DamageBonus: 3, Condition: gamedata.Poisoned}},
+		},
+		{
+			ID:              "ogro",
+			Name:            "Ogro",
+			Description:     "Gigante estúpido e faminto que esmaga tudo no caminho.",
+			ChallengeRating: 2,
+			Experience:      150,
+			Gold:            30,
+			MaxHealth:       59,
+			Armor:           11,
+			Initiative:      -1,
+			Attributes:      gamedata.Attributes{Strength: 19, Dexterity: 8, Constitution: 16, Intelligence: 5, Wisdom: 7, Charisma: 7},
+			Attacks:         []entities.MonsterAttack{{Name: "Clava Gigante", Bonus: 6, DamageDice: 2, DamageDie: 8, DamageBonus: 4}},
+			Loot: []entities.LootEntry{
+				{Item: entities.Item{Name: "Bolsa do Ogro", Description: "Uma bolsa imunda com moedas roubadas.", Quantity: 1, Type: gamedata.Quest, Value: 25, Rarity: gamedata.Uncommon}, Chance: 40},
+			},
+		},
+		{
+			ID:              "troll",
+			Name:            "Troll",
+			Description:     "Suas feridas se fecham diante dos olhos dos inimigos.",
+			ChallengeRating: 5,
+			Experience:      250,
+			Gold:            60,
+			MaxHealth:       84,
+			Armor:           15,
+			Initiative:      1,
+			Attributes:      gamedata.Attributes{Strength: 18, Dexterity: 13, Constitution: 20, Intelligence: 7, Wisdom: 9, Charisma: 7},
+			Attacks:         []entities.MonsterAttack{{Name: "Garras", Bonus: 7, DamageDice: 2, DamageDie: 6, DamageBonus: 4}},
+		},
+		{
+			ID:              "dragao-jovem",
+			Name:            "Dragão Jovem",
+			Description:     "Ainda longe do seu tamanho final, mas já temível.",
+			ChallengeRating: 7,
+			Experience:      500,
+			Gold:            250,
+			MaxHealth:       136,
+			Armor:           18,
+			Initiative:      0,
+			Attributes:      gamedata.Attributes{Strength: 21, Dexterity: 10, Constitution: 19, Intelligence: 14, Wisdom: 11, Charisma: 19},
+			Attacks:         []entities.MonsterAttack{{Name: "Mordida", Bonus: 8, DamageDice: 2, DamageDie: 10, DamageBonus: 5}},
+			Loot: []entities.LootEntry{
+				{Item: entities.Item{Name: "Escama de Dragão", Description: "Dura como aço e quente ao toque.", Quantity: 1, Type: gamedata.Quest, Value: 200, Rarity: gamedata.Rare}, Chance: 100},
+			},
+		},
+	}
+}
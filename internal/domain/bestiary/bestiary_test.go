@@ -0,0 +1,89 @@
+package bestiary
+
+import (
+	"math/rand"
+	"testing"
+
+	"sirdraith/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonsters(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, m := range Monsters() {
+		assert.NoError(t, m.Validate(), m.ID)
+		assert.False(t, seen[m.ID], "duplicated monster %s", m.ID)
+		seen[m.ID] = true
+	}
+}
+
+func TestExperienceBudget(t *testing.T) {
+	assert.Equal(t, 50, ExperienceBudget([]int{1}))
+	assert.Equal(t, 50+150, ExperienceBudget([]int{1, 5}))
+	assert.Equal(t, 50, ExperienceBudget([]int{0}), "levels below the starting level count as level 1")
+}
+
+func TestGenerateEncounter(t *testing.T) {
+	monsters := Monsters()
+
+	tests := []struct {
+		name   string
+		levels []int
+	}{
+		{name: "should fit a solo beginner", levels: []int{1}},
+		{name: "should fit a mid level party", levels: []int{5, 5, 6, 4}},
+		{name: "should fit a high level party", levels: []int{15, 15, 15}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for seed := int64(0); seed < 20; seed++ {
+				plan, err := GenerateEncounter(tt.levels, monsters, rand.New(rand.NewSource(seed)))
+				require.NoError(t, err)
+				require.NotEmpty(t, plan.Monsters)
+				assert.LessOrEqual(t, plan.Difficulty, plan.Budget)
+				assert.LessOrEqual(t, len(plan.Monsters), len(tt.levels)*MonstersPerMember)
+			}
+		})
+	}
+
+	t.Run("should be deterministic for the same seed", func(t *testing.T) {
+		first, err := GenerateEncounter([]int{3, 4}, monsters, rand.New(rand.NewSource(9)))
+		require.NoError(t, err)
+		second, err := GenerateEncounter([]int{3, 4}, monsters, rand.New(rand.NewSource(9)))
+		require.NoError(t, err)
+		assert.Equal(t, first.Monsters, second.Monsters)
+	})
+
+	t.Run("should fall back to the weakest monster", func(t *testing.T) {
+		strong := []*entities.Monster{
+			{ID: "troll", Experience: 250},
+			{ID: "ogro", Experience: 150},
+		}
+		plan, err := GenerateEncounter([]int{1}, strong, rand.New(rand.NewSource(1)))
+		require.NoError(t, err)
+		require.Len(t, plan.Monsters, 1)
+		assert.Equal(t, "ogro", plan.Monsters[0].ID)
+	})
+
+	t.Run("should require party and monsters", func(t *testing.T) {
+		_, err := GenerateEncounter(nil, monsters, rand.New(rand.NewSource(1)))
+		assert.ErrorIs(t, err, ErrEmptyParty)
+		_, err = GenerateEncounter([]int{1}, nil, rand.New(rand.NewSource(1)))
+		assert.ErrorIs(t, err, ErrNoMonsters)
+	})
+}
+
+func TestMonster_RollLoot(t *testing.T) {
+	m := &entities.Monster{Loot: []entities.LootEntry{
+		{Item: entities.Item{Name: "Sempre"}, Chance: 100},
+		{Item: entities.Item{Name: "Nunca"}, Chance: 0},
+	}}
+
+	loot, rolls := m.RollLoot(rand.New(rand.NewSource(1)))
+	require.Len(t, loot, 1)
+	require.Len(t, rolls, 2)
+	assert.Equal(t, "Sempre", loot[0].Name)
+}
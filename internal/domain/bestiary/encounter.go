@@ -0,0 +1,119 @@
+package bestiary
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/gamedata"
+)
+
+// Regras de geração de encontros
+const (
+	EncountersPerLevel = 4 // Encontros necessários para subir um nível
+	MaxEncounterSize   = 8 // Máximo de monstros em um encontro
+	MonstersPerMember  = 2 // Máximo de monstros por membro do grupo
+)
+
+var (
+	// ErrEmptyParty indica um grupo sem personagens
+	ErrEmptyParty = errors.New("o grupo não possui personagens")
+	// ErrNoMonsters indica um bestiário vazio
+	ErrNoMonsters = errors.New("o bestiário não possui monstros")
+)
+
+// EncounterPlan descreve os monstros escolhidos para um encontro
+type EncounterPlan struct {
+	Monsters   []*entities.Monster
+	Budget     int // Experiência ajustada máxima para o grupo
+	Experience int // Experiência concedida pelos monstros
+	Difficulty int // Experiência ajustada pela quantidade de monstros
+}
+
+// ExperienceBudget calcula a experiência ajustada que um encontro pode ter.
+// Cada membro contribui com a fração da experiência do próximo nível que um
+// encontro deve render, seguindo as regras de progressão padrão
+func ExperienceBudget(levels []int) int {
+	budget := 0
+	for _, level := range levels {
+		// No nível máximo, o orçamento é o do próprio nível
+		next := min(max(level, gamedata.StartingLevel)+1, gamedata.MaxLevel)
+		budget += gamedata.DefaultProgression().ExpForLevel(next) / EncountersPerLevel
+	}
+	return budget
+}
+
+// GroupMultiplier retorna o multiplicador de dificuldade para a quantidade de
+// monstros, já que grupos maiores atacam mais vezes por rodada
+func GroupMultiplier(count int) float64 {
+	switch {
+	case count <= 1:
+		return 1
+	case count == 2:
+		return 1.5
+	case count <= 6:
+		return 2
+	default:
+		return 2.5
+	}
+}
+
+// AdjustedExperience calcula a dificuldade de um grupo de monstros
+func AdjustedExperience(monsters []*entities.Monster) int {
+	total := 0
+	for _, m := range monsters {
+		total += m.Experience
+	}
+	return int(float64(total) * GroupMultiplier(len(monsters)))
+}
+
+// GenerateEncounter monta um grupo de monstros equilibrado para os níveis do
+// grupo. Os monstros são sorteados enquanto couberem no orçamento; se nenhum
+// couber, o monstro mais fraco é usado sozinho
+func GenerateEncounter(levels []int, monsters []*entities.Monster, rng *rand.Rand) (*EncounterPlan, error) {
+	if len(levels) == 0 {
+		return nil, ErrEmptyParty
+	}
+	if len(monsters) == 0 {
+		return nil, ErrNoMonsters
+	}
+
+	// Ordena para que o resultado dependa apenas do gerador, não da ordem recebida
+	pool := append([]*entities.Monster(nil), monsters...)
+	sort.Slice(pool, func(i, j int) bool {
+		if pool[i].Experience != pool[j].Experience {
+			return pool[i].Experience < pool[j].Experience
+		}
+		return pool[i].ID < pool[j].ID
+	})
+
+	maxSize := len(levels) * MonstersPerMember
+	if maxSize > MaxEncounterSize {
+		maxSize = MaxEncounterSize
+	}
+
+	plan := &EncounterPlan{Budget: ExperienceBudget(levels)}
+	for len(plan.Monsters) < maxSize {
+		var candidates []*entities.Monster
+		for _, m := range pool {
+			if AdjustedExperience(append(plan.Monsters, m)) <= plan.Budget {
+				candidates = append(candidates, m)
+			}
+		}
+		if len(candidates) == 0 {
+			break
+		}
+		plan.Monsters = append(plan.Monsters, candidates[rng.Intn(len(candidates))])
+	}
+
+	if len(plan.Monsters) == 0 {
+		plan.Monsters = []*entities.Monster{pool[0]}
+	}
+
+	for _, m := range plan.Monsters {
+		plan.Experience += m.Experience
+	}
+	plan.Difficulty = AdjustedExperience(plan.Monsters)
+	return plan, nil
+}
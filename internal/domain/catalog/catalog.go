@@ -0,0 +1,102 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"sirdraith/internal/domain/entities"
+)
+
+// Format representa o formato de arquivo de um conjunto de cartas
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// ErrUnknownFormat indica um formato de arquivo não suportado
+var ErrUnknownFormat = errors.New("formato desconhecido, use json ou yaml")
+
+// CardSet é o documento raiz dos arquivos de cartas
+type CardSet struct {
+	Cards []*entities.Card `json:"cards" yaml:"cards"`
+}
+
+// ParseFormat converte o nome de um formato ("json", "yaml" ou "yml")
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToLower(strings.TrimPrefix(name, ".")) {
+	case "json":
+		return FormatJSON, nil
+	case "yaml", "yml":
+		return FormatYAML, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownFormat, name)
+	}
+}
+
+// FormatFromFilename identifica o formato pela extensão do arquivo
+func FormatFromFilename(filename string) (Format, error) {
+	return ParseFormat(filepath.Ext(filename))
+}
+
+// Decode lê um conjunto de cartas. Campos desconhecidos são rejeitados para
+// que erros de digitação no arquivo não passem despercebidos.
+func Decode(data []byte, format Format) ([]*entities.Card, error) {
+	var set CardSet
+	switch format {
+	case FormatJSON:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&set); err != nil {
+			return nil, fmt.Errorf("erro ao ler JSON: %w", err)
+		}
+	case FormatYAML:
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&set); err != nil {
+			return nil, fmt.Errorf("erro ao ler YAML: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+	return set.Cards, nil
+}
+
+// Encode escreve um conjunto de cartas ordenado pelo ID, para que o arquivo
+// gere diffs estáveis quando versionado
+func Encode(cards []*entities.Card, format Format) ([]byte, error) {
+	sorted := make([]*entities.Card, len(cards))
+	copy(sorted, cards)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+	set := CardSet{Cards: sorted}
+
+	switch format {
+	case FormatJSON:
+		data, err := json.MarshalIndent(set, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	case FormatYAML:
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(set); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
@@ -0,0 +1,121 @@
+package catalog
+
+import (
+	"testing"
+
+	"sirdraith/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Format
+		wantErr bool
+	}{
+		{input: "json", want: FormatJSON},
+		{input: ".YML", want: FormatYAML},
+		{input: "yaml", want: FormatYAML},
+		{input: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseFormat(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnknownFormat)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("should decode YAML with structured and legacy effects", func(t *testing.T) {
+		data := []byte(`
+cards:
+  - id: bola-de-fogo
+    name: Bola de Fogo
+    type: spell
+    rarity: rare
+    cost: 4
+    effects:
+      - type: damage
+        value: 4
+        target: opponent
+      - "heal:2"
+    requirements:
+      level: 3
+      class: mage
+`)
+		cards, err := Decode(data, FormatYAML)
+		require.NoError(t, err)
+		require.Len(t, cards, 1)
+
+		card := cards[0]
+		assert.Equal(t, "bola-de-fogo", card.ID)
+		assert.Equal(t, entities.CardRarityRare, card.Rarity)
+		assert.Equal(t, []entities.Effect{
+			{Type: entities.EffectDamage, Value: 4, Target: entities.EffectTargetOpponent},
+			{Type: entities.EffectHeal, Value: 2},
+		}, card.Effects)
+		assert.Equal(t, "mage", card.Class())
+	})
+
+	t.Run("should reject unknown fields", func(t *testing.T) {
+		_, err := Decode([]byte(`{"cards": [{"id": "x", "nmae": "X"}]}`), FormatJSON)
+		assert.Error(t, err)
+
+		_, err = Decode([]byte("cards:\n  - id: x\n    nmae: X\n"), FormatYAML)
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject unknown effect fields", func(t *testing.T) {
+		_, err := Decode([]byte(`{"cards": [{"id": "x", "effects": [{"type": "damage", "valu": 3}]}]}`), FormatJSON)
+		assert.Error(t, err)
+
+		_, err = Decode([]byte("cards:\n  - id: x\n    effects:\n      - type: damage\n        valu: 3\n"), FormatYAML)
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject malformed legacy effects", func(t *testing.T) {
+		_, err := Decode([]byte(`{"cards": [{"id": "x", "effects": ["damage:abc"]}]}`), FormatJSON)
+		assert.ErrorIs(t, err, entities.ErrInvalidEffect)
+
+		_, err = Decode([]byte("cards:\n  - id: x\n    effects:\n      - \"heal:2:ninguem\"\n"), FormatYAML)
+		assert.ErrorIs(t, err, entities.ErrInvalidEffect)
+
+		cards, err := Decode([]byte(`{"cards": [{"id": "x", "effects": ["Um brilho dourado", "text:sem valor"]}]}`), FormatJSON)
+		require.NoError(t, err)
+		for _, effect := range cards[0].Effects {
+			assert.Equal(t, entities.EffectText, effect.Type)
+		}
+	})
+}
+
+func TestEncode(t *testing.T) {
+	cards := []*entities.Card{
+		entities.NewCard("zumbi", "Zumbi", entities.CardTypeCreature, entities.CardRarityCommon, "", 2, 2, 2, nil, nil, ""),
+		entities.NewCard("anjo", "Anjo", entities.CardTypeCreature, entities.CardRarityEpic, "", 6, 5, 5,
+			[]entities.Effect{{Type: entities.EffectHeal, Value: 3, Target: entities.EffectTargetSelf}}, []string{"provocar"}, ""),
+	}
+
+	for _, format := range []Format{FormatJSON, FormatYAML} {
+		t.Run(string(format), func(t *testing.T) {
+			data, err := Encode(cards, format)
+			require.NoError(t, err)
+			assert.NotContains(t, string(data), "created_at")
+
+			decoded, err := Decode(data, format)
+			require.NoError(t, err)
+			require.Len(t, decoded, 2)
+			assert.Equal(t, "anjo", decoded[0].ID, "cards are sorted by ID")
+			assert.Equal(t, cards[1].Effects, decoded[0].Effects)
+			assert.Equal(t, cards[1].Keywords, decoded[0].Keywords)
+		})
+	}
+}
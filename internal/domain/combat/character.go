@@ -0,0 +1,91 @@
+package combat
+
+import (
+	"math/rand"
+	"time"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/gamedata"
+)
+
+// CharacterCombatant adapta um personagem para participar de combates
+type CharacterCombatant struct {
+	Character *entities.Character
+}
+
+// NewCharacterCombatant cria o participante de combate de um personagem
+func NewCharacterCombatant(character *entities.Character) *CharacterCombatant {
+	return &CharacterCombatant{Character: character}
+}
+
+// ID retorna o identificador do personagem
+func (c *CharacterCombatant) ID() string {
+	return c.Character.ID.Hex()
+}
+
+// Name retorna o nome do personagem
+func (c *CharacterCombatant) Name() string {
+	return c.Character.Name
+}
+
+// InitiativeBonus retorna a iniciativa do personagem
+func (c *CharacterCombatant) InitiativeBonus() int {
+	return c.Character.Combat.Initiative
+}
+
+// ArmorClass retorna a armadura do personagem somada à defesa dos equipamentos
+func (c *CharacterCombatant) ArmorClass() int {
+	return c.Character.DerivedStats().Armor
+}
+
+// AttackBonus retorna o bônus de ataque da classe do personagem somado ao ataque dos equipamentos
+func (c *CharacterCombatant) AttackBonus() int {
+	return c.Character.DerivedStats().Attack
+}
+
+// RollDamage rola o dano desarmado somado ao ataque das armas equipadas. Como
+// uma arma mágica, o ataque da arma vale no acerto e no dano; o poder mágico
+// só vale para as magias
+func (c *CharacterCombatant) RollDamage(rng *rand.Rand) int {
+	damage := roll(rng, UnarmedDamageDie)
+	for _, item := range c.Character.Equipment {
+		if item.Type == gamedata.Weapon {
+			damage += item.Stats.Attack
+		}
+	}
+	if damage < 1 {
+		return 1
+	}
+	return damage
+}
+
+// Health retorna os pontos de vida atuais do personagem
+func (c *CharacterCombatant) Health() int {
+	return c.Character.Combat.Health
+}
+
+// TakeDamage aplica o dano ao personagem
+func (c *CharacterCombatant) TakeDamage(damage int) bool {
+	return c.Character.TakeDamage(damage)
+}
+
+func (c *CharacterCombatant) setInCombat(inCombat bool) {
+	c.Character.Combat.IsInCombat = inCombat
+}
+
+// startTurn aplica os efeitos das condições do personagem no início do seu turno
+func (c *CharacterCombatant) startTurn() TurnEffects {
+	tick := c.Character.TickConditions(time.Now())
+	effects := TurnEffects{Damage: tick.Damage, Healed: tick.Healed, SkipTurn: tick.SkipTurn}
+	for _, expired := range tick.Expired {
+		if condition := expired.Definition(); condition != nil {
+			effects.Expired = append(effects.Expired, condition.Name)
+		}
+	}
+	return effects
+}
+
+// applyCondition aplica ao personagem a condição causada por um ataque
+func (c *CharacterCombatant) applyCondition(id gamedata.ConditionID) bool {
+	return c.Character.ApplyCondition(id, time.Now()) == nil
+}
@@ -0,0 +1,103 @@
+package combat
+
+import (
+	"errors"
+	"math/rand"
+
+	"sirdraith/internal/domain/gamedata"
+)
+
+// Regras gerais do combate
+const (
+	InitiativeDie    = 20 // Dado rolado para a iniciativa
+	AttackDie        = 20 // Dado rolado para o ataque
+	UnarmedDamageDie = 4  // Dado de dano sem arma equipada
+	CriticalHit      = 20 // Resultado natural que acerta e dobra o dano
+	CriticalMiss     = 1  // Resultado natural que sempre erra
+	MaxLogEntries    = 20 // Entradas mantidas no histórico do combate
+	MaxRounds        = 50 // Limite de rodadas antes do combate ser encerrado
+)
+
+var (
+	// ErrNoCombatants indica um combate sem participantes em um dos lados
+	ErrNoCombatants = errors.New("o combate precisa de participantes dos dois lados")
+	// ErrCombatantDefeated indica um participante sem vida
+	ErrCombatantDefeated = errors.New("participante já foi derrotado")
+	// ErrCombatFinished indica uma ação em um combate encerrado
+	ErrCombatFinished = errors.New("o combate já terminou")
+	// ErrNotYourTurn indica uma ação fora do turno do participante
+	ErrNotYourTurn = errors.New("não é o seu turno")
+	// ErrUnknownCombatant indica um participante que não está no combate
+	ErrUnknownCombatant = errors.New("participante não está neste combate")
+	// ErrInvalidTarget indica um alvo inválido para o ataque
+	ErrInvalidTarget = errors.New("alvo inválido")
+)
+
+// Side identifica o lado de um participante no combate
+type Side int
+
+const (
+	SideParty   Side = iota // Personagens dos jogadores
+	SideEnemies             // Monstros e outros inimigos
+)
+
+// Opponent retorna o lado adversário
+func (s Side) Opponent() Side {
+	if s == SideParty {
+		return SideEnemies
+	}
+	return SideParty
+}
+
+// Combatant é qualquer criatura que participa de um combate
+type Combatant interface {
+	ID() string
+	Name() string
+	InitiativeBonus() int          // Bônus somado à rolagem de iniciativa
+	ArmorClass() int               // Valor que o ataque precisa alcançar para acertar
+	AttackBonus() int              // Bônus somado à rolagem de ataque
+	RollDamage(rng *rand.Rand) int // Dano causado por um ataque que acerta
+	Health() int                   // Pontos de vida atuais
+	TakeDamage(damage int) bool    // Aplica o dano e indica se foi derrotado
+}
+
+// Rewarder é implementado pelos combatentes que concedem recompensa ao serem derrotados
+type Rewarder interface {
+	Reward() Reward
+}
+
+// inCombatMarker é implementado pelos combatentes que registram se estão em combate
+type inCombatMarker interface {
+	setInCombat(inCombat bool)
+}
+
+// TurnEffects descreve os efeitos aplicados no início do turno de um participante
+type TurnEffects struct {
+	Damage   int      // Dano sofrido pelas condições
+	Healed   int      // Vida recuperada pelas condições
+	Expired  []string // Nomes das condições que acabaram
+	SkipTurn bool     // Se o participante perde o turno
+}
+
+// turnStarter é implementado pelos combatentes com efeitos no início do turno
+type turnStarter interface {
+	startTurn() TurnEffects
+}
+
+// ConditionInflicter é implementado pelos combatentes cujos ataques aplicam uma condição
+type ConditionInflicter interface {
+	InflictedCondition() gamedata.ConditionID
+}
+
+// conditionTarget é implementado pelos combatentes que podem sofrer condições
+type conditionTarget interface {
+	applyCondition(id gamedata.ConditionID) bool
+}
+
+// roll rola um dado com a quantidade de faces informada
+func roll(rng *rand.Rand, sides int) int {
+	if sides < 1 {
+		return 0
+	}
+	return rng.Intn(sides) + 1
+}
@@ -0,0 +1,354 @@
+package combat
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"sirdraith/internal/domain/gamedata"
+)
+
+// Participant é um combatente com seu lado e resultado de iniciativa
+type Participant struct {
+	Combatant
+	Side       Side
+	Initiative int // Resultado da rolagem de iniciativa
+}
+
+// IsDefeated indica se o participante está sem vida
+func (p *Participant) IsDefeated() bool {
+	return p.Health() <= 0
+}
+
+// AttackResult descreve o resultado de um ataque
+type AttackResult struct {
+	Attacker *Participant
+	Target   *Participant
+	Roll     int  // Resultado natural do dado
+	Total    int  // Rolagem somada ao bônus de ataque
+	Hit      bool // Se o ataque alcançou a armadura do alvo
+	Critical bool // Se foi um acerto crítico
+	Damage   int
+	Defeated bool // Se o alvo foi derrotado pelo ataque
+}
+
+// Encounter representa um combate por turnos entre o grupo e seus inimigos
+type Encounter struct {
+	Participants []*Participant // Ordem dos turnos, pela iniciativa
+	Current      int            // Índice do participante da vez
+	Round        int
+	Finished     bool
+	Winner       Side
+	Log          []string
+	rng          *rand.Rand
+}
+
+// NewEncounter rola a iniciativa dos participantes e inicia o combate
+func NewEncounter(party, enemies []Combatant, rng *rand.Rand) (*Encounter, error) {
+	if len(party) == 0 || len(enemies) == 0 {
+		return nil, ErrNoCombatants
+	}
+
+	e := &Encounter{Round: 1, rng: rng}
+	for _, side := range []struct {
+		side       Side
+		combatants []Combatant
+	}{{SideParty, party}, {SideEnemies, enemies}} {
+		for _, c := range side.combatants {
+			if c.Health() <= 0 {
+				return nil, fmt.Errorf("%w: %s", ErrCombatantDefeated, c.Name())
+			}
+			e.Participants = append(e.Participants, &Participant{
+				Combatant:  c,
+				Side:       side.side,
+				Initiative: roll(rng, InitiativeDie) + c.InitiativeBonus(),
+			})
+		}
+	}
+
+	// Empates são decididos pelo bônus de iniciativa e, depois, pela ordem de entrada
+	sort.SliceStable(e.Participants, func(i, j int) bool {
+		a, b := e.Participants[i], e.Participants[j]
+		if a.Initiative != b.Initiative {
+			return a.Initiative > b.Initiative
+		}
+		return a.InitiativeBonus() > b.InitiativeBonus()
+	})
+
+	for _, p := range e.Participants {
+		if marker, ok := p.Combatant.(inCombatMarker); ok {
+			marker.setInCombat(true)
+		}
+	}
+
+	e.logf("⚔️ O combate começa! %s age primeiro.", e.CurrentParticipant().Name())
+	if !e.beginTurn() {
+		e.endTurn()
+	}
+	return e, nil
+}
+
+// CurrentParticipant retorna o participante da vez
+func (e *Encounter) CurrentParticipant() *Participant {
+	return e.Participants[e.Current]
+}
+
+// Find retorna o participante com o ID informado
+func (e *Encounter) Find(id string) *Participant {
+	for _, p := range e.Participants {
+		if p.ID() == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// Living retorna os participantes de um lado que ainda estão de pé
+func (e *Encounter) Living(side Side) []*Participant {
+	var living []*Participant
+	for _, p := range e.Participants {
+		if p.Side == side && !p.IsDefeated() {
+			living = append(living, p)
+		}
+	}
+	return living
+}
+
+// Attack resolve o ataque do participante da vez contra um alvo do lado adversário
+// e passa o turno
+func (e *Encounter) Attack(attackerID, targetID string) (*AttackResult, error) {
+	attacker, err := e.checkTurn(attackerID)
+	if err != nil {
+		return nil, err
+	}
+
+	target := e.Find(targetID)
+	if target == nil || target.Side == attacker.Side || target.IsDefeated() {
+		return nil, ErrInvalidTarget
+	}
+
+	result := e.resolveAttack(attacker, target)
+	e.endTurn()
+	return result, nil
+}
+
+// AutoAttack faz o participante da vez atacar um adversário aleatório.
+// Usado para os turnos dos inimigos
+func (e *Encounter) AutoAttack() (*AttackResult, error) {
+	if e.Finished {
+		return nil, ErrCombatFinished
+	}
+
+	attacker := e.CurrentParticipant()
+	targets := e.Living(attacker.Side.Opponent())
+	target := targets[e.rng.Intn(len(targets))]
+	return e.Attack(attacker.ID(), target.ID())
+}
+
+// Pass encerra o turno do participante sem agir
+func (e *Encounter) Pass(participantID string) error {
+	participant, err := e.checkTurn(participantID)
+	if err != nil {
+		return err
+	}
+
+	e.logf("⏭️ %s passa o turno.", participant.Name())
+	e.endTurn()
+	return nil
+}
+
+// RunEnemyTurns executa os turnos dos inimigos até a vez de um membro do
+// grupo ou o fim do combate
+func (e *Encounter) RunEnemyTurns() ([]*AttackResult, error) {
+	var results []*AttackResult
+	for !e.Finished && e.CurrentParticipant().Side == SideEnemies {
+		result, err := e.AutoAttack()
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Reward soma as recompensas dos inimigos derrotados
+func (e *Encounter) Reward() Reward {
+	var total Reward
+	for _, p := range e.Participants {
+		if p.Side != SideEnemies || !p.IsDefeated() {
+			continue
+		}
+		if rewarder, ok := p.Combatant.(Rewarder); ok {
+			total = total.Add(rewarder.Reward())
+		}
+	}
+	return total
+}
+
+// checkTurn verifica se o combate está em andamento e é a vez do participante
+func (e *Encounter) checkTurn(participantID string) (*Participant, error) {
+	if e.Finished {
+		return nil, ErrCombatFinished
+	}
+	participant := e.Find(participantID)
+	if participant == nil {
+		return nil, ErrUnknownCombatant
+	}
+	if participant != e.CurrentParticipant() {
+		return nil, ErrNotYourTurn
+	}
+	return participant, nil
+}
+
+// resolveAttack rola o ataque contra a armadura do alvo e aplica o dano
+func (e *Encounter) resolveAttack(attacker, target *Participant) *AttackResult {
+	result := &AttackResult{
+		Attacker: attacker,
+		Target:   target,
+		Roll:     roll(e.rng, AttackDie),
+	}
+	result.Total = result.Roll + attacker.AttackBonus()
+
+	switch {
+	case result.Roll == CriticalMiss:
+		result.Hit = false
+	case result.Roll == CriticalHit:
+		result.Hit, result.Critical = true, true
+	default:
+		result.Hit = result.Total >= target.ArmorClass()
+	}
+
+	if !result.Hit {
+		e.logf("💨 %s ataca %s e erra (%d contra CA %d).", attacker.Name(), target.Name(), result.Total, target.ArmorClass())
+		return result
+	}
+
+	result.Damage = attacker.RollDamage(e.rng)
+	if result.Critical {
+		result.Damage += attacker.RollDamage(e.rng)
+	}
+	result.Defeated = target.TakeDamage(result.Damage)
+	condition := e.inflictCondition(attacker, target, result.Defeated)
+
+	if result.Critical {
+		e.logf("💥 Crítico! %s causa %d de dano em %s.", attacker.Name(), result.Damage, target.Name())
+	} else {
+		e.logf("🗡️ %s acerta %s (%d contra CA %d) e causa %d de dano.", attacker.Name(), target.Name(), result.Total, target.ArmorClass(), result.Damage)
+	}
+	if condition != nil {
+		e.logf("%s %s está %s.", condition.Emoji, target.Name(), condition.Name)
+	}
+	if result.Defeated {
+		e.logf("☠️ %s foi derrotado!", target.Name())
+	}
+	return result
+}
+
+// inflictCondition aplica ao alvo a condição causada pelo ataque, se houver
+func (e *Encounter) inflictCondition(attacker, target *Participant, defeated bool) *gamedata.Condition {
+	inflicter, ok := attacker.Combatant.(ConditionInflicter)
+	if !ok || defeated {
+		return nil
+	}
+	receiver, ok := target.Combatant.(conditionTarget)
+	if !ok {
+		return nil
+	}
+	condition, ok := gamedata.GetCondition(inflicter.InflictedCondition())
+	if !ok || !receiver.applyCondition(condition.ID) {
+		return nil
+	}
+	return condition
+}
+
+// endTurn verifica o fim do combate e passa a vez ao próximo participante de pé
+// que possa agir
+func (e *Encounter) endTurn() {
+	for {
+		if e.checkFinished() {
+			return
+		}
+
+		for {
+			e.Current++
+			if e.Current >= len(e.Participants) {
+				e.Current = 0
+				e.Round++
+			}
+			if !e.CurrentParticipant().IsDefeated() {
+				break
+			}
+		}
+
+		if e.Round > MaxRounds {
+			// Combates longos demais terminam com a retirada do grupo
+			e.logf("⌛ O combate se arrastou demais e o grupo recua.")
+			e.finish(SideEnemies)
+			return
+		}
+
+		if e.beginTurn() {
+			return
+		}
+	}
+}
+
+// beginTurn aplica os efeitos de início de turno do participante da vez e
+// indica se ele pode agir
+func (e *Encounter) beginTurn() bool {
+	participant := e.CurrentParticipant()
+	starter, ok := participant.Combatant.(turnStarter)
+	if !ok {
+		return true
+	}
+
+	effects := starter.startTurn()
+	if effects.Healed > 0 {
+		e.logf("💚 %s recupera %d de vida.", participant.Name(), effects.Healed)
+	}
+	if effects.Damage > 0 {
+		e.logf("🩸 %s sofre %d de dano das suas condições.", participant.Name(), effects.Damage)
+	}
+	for _, name := range effects.Expired {
+		e.logf("✨ %s não está mais %s.", participant.Name(), name)
+	}
+	if participant.IsDefeated() {
+		e.logf("☠️ %s foi derrotado!", participant.Name())
+		return false
+	}
+	if effects.SkipTurn {
+		e.logf("💫 %s perde o turno.", participant.Name())
+		return false
+	}
+	return true
+}
+
+// checkFinished encerra o combate quando um dos lados não tem mais ninguém de pé
+func (e *Encounter) checkFinished() bool {
+	switch {
+	case len(e.Living(SideEnemies)) == 0:
+		e.logf("🏆 O grupo venceu o combate!")
+		e.finish(SideParty)
+	case len(e.Living(SideParty)) == 0:
+		e.logf("💀 O grupo foi derrotado.")
+		e.finish(SideEnemies)
+	}
+	return e.Finished
+}
+
+func (e *Encounter) finish(winner Side) {
+	e.Finished = true
+	e.Winner = winner
+	for _, p := range e.Participants {
+		if marker, ok := p.Combatant.(inCombatMarker); ok {
+			marker.setInCombat(false)
+		}
+	}
+}
+
+func (e *Encounter) logf(format string, args ...interface{}) {
+	e.Log = append(e.Log, fmt.Sprintf(format, args...))
+	if len(e.Log) > MaxLogEntries {
+		e.Log = e.Log[len(e.Log)-MaxLogEntries:]
+	}
+}
@@ -0,0 +1,258 @@
+package combat
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/gamedata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// dummy é um combatente simples com valores fixos
+type dummy struct {
+	id, name   string
+	initiative int
+	armor      int
+	attack     int
+	damage     int
+	health     int
+	reward     Reward
+}
+
+func (d *dummy) ID() string                { return d.id }
+func (d *dummy) Name() string              { return d.name }
+func (d *dummy) InitiativeBonus() int      { return d.initiative }
+func (d *dummy) ArmorClass() int           { return d.armor }
+func (d *dummy) AttackBonus() int          { return d.attack }
+func (d *dummy) RollDamage(*rand.Rand) int { return d.damage }
+func (d *dummy) Health() int               { return d.health }
+func (d *dummy) Reward() Reward            { return d.reward }
+func (d *dummy) TakeDamage(damage int) bool {
+	d.health -= damage
+	if d.health < 0 {
+		d.health = 0
+	}
+	return d.health == 0
+}
+
+func newCharacter(name string) *entities.Character {
+	c := entities.NewCharacter("user-"+name, "guild", name, string(gamedata.Warrior))
+	c.ID = primitive.NewObjectID()
+	c.Attributes = gamedata.Attributes{Strength: 16, Dexterity: 12, Constitution: 14}
+	c.Combat.Health, c.Combat.MaxHealth = 20, 20
+	return c
+}
+
+func TestNewEncounter(t *testing.T) {
+	t.Run("should order participants by initiative", func(t *testing.T) {
+		fast := &dummy{id: "fast", name: "Rápido", initiative: 100, health: 5}
+		slow := &dummy{id: "slow", name: "Lento", initiative: -100, health: 5}
+
+		e, err := NewEncounter([]Combatant{slow}, []Combatant{fast}, rand.New(rand.NewSource(1)))
+		require.NoError(t, err)
+		assert.Equal(t, "fast", e.CurrentParticipant().ID())
+		assert.Equal(t, "slow", e.Participants[1].ID())
+		assert.Equal(t, 1, e.Round)
+	})
+
+	t.Run("should require both sides", func(t *testing.T) {
+		_, err := NewEncounter(nil, []Combatant{&dummy{health: 1}}, rand.New(rand.NewSource(1)))
+		assert.ErrorIs(t, err, ErrNoCombatants)
+	})
+
+	t.Run("should reject defeated combatants", func(t *testing.T) {
+		_, err := NewEncounter([]Combatant{&dummy{health: 0}}, []Combatant{&dummy{health: 1}}, rand.New(rand.NewSource(1)))
+		assert.ErrorIs(t, err, ErrCombatantDefeated)
+	})
+
+	t.Run("should mark characters in combat", func(t *testing.T) {
+		hero := newCharacter("Aria")
+		_, err := NewEncounter([]Combatant{NewCharacterCombatant(hero)}, []Combatant{&dummy{id: "rato", health: 1}}, rand.New(rand.NewSource(1)))
+		require.NoError(t, err)
+		assert.True(t, hero.Combat.IsInCombat)
+	})
+}
+
+func TestEncounter_Attack(t *testing.T) {
+	setup := func(t *testing.T) (*Encounter, *dummy, *dummy) {
+		t.Helper()
+		hero := &dummy{id: "hero", name: "Herói", initiative: 100, attack: 100, damage: 3, health: 10}
+		goblin := &dummy{id: "goblin", name: "Goblin", initiative: -100, attack: 100, armor: 12, damage: 2, health: 5, reward: Reward{Experience: 50, Gold: 10}}
+		e, err := NewEncounter([]Combatant{hero}, []Combatant{goblin}, rand.New(rand.NewSource(4)))
+		require.NoError(t, err)
+		return e, hero, goblin
+	}
+
+	t.Run("should reject attacks out of turn", func(t *testing.T) {
+		e, _, _ := setup(t)
+		_, err := e.Attack("goblin", "hero")
+		assert.ErrorIs(t, err, ErrNotYourTurn)
+	})
+
+	t.Run("should reject allies as targets", func(t *testing.T) {
+		e, _, _ := setup(t)
+		_, err := e.Attack("hero", "hero")
+		assert.ErrorIs(t, err, ErrInvalidTarget)
+	})
+
+	t.Run("should damage target and pass the turn", func(t *testing.T) {
+		e, _, goblin := setup(t)
+		result, err := e.Attack("hero", "goblin")
+		require.NoError(t, err)
+
+		if result.Roll == CriticalMiss {
+			assert.False(t, result.Hit)
+			return
+		}
+		assert.True(t, result.Hit)
+		assert.Equal(t, 5-result.Damage, goblin.health)
+		assert.Equal(t, "goblin", e.CurrentParticipant().ID())
+	})
+
+	t.Run("should finish with rewards when enemies fall", func(t *testing.T) {
+		e, _, goblin := setup(t)
+		goblin.health = 1
+
+		for !e.Finished {
+			if e.CurrentParticipant().Side == SideParty {
+				_, err := e.Attack("hero", "goblin")
+				require.NoError(t, err)
+				continue
+			}
+			_, err := e.RunEnemyTurns()
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, SideParty, e.Winner)
+		assert.Equal(t, Reward{Experience: 50, Gold: 10}, e.Reward())
+		_, err := e.Attack("hero", "goblin")
+		assert.ErrorIs(t, err, ErrCombatFinished)
+	})
+}
+
+func TestEncounter_RunEnemyTurns(t *testing.T) {
+	hero := &dummy{id: "hero", name: "Herói", initiative: -100, armor: 1, health: 100}
+	wolves := []Combatant{
+		&dummy{id: "lobo1", name: "Lobo", initiative: 100, attack: 10, damage: 2, health: 5},
+		&dummy{id: "lobo2", name: "Lobo", initiative: 90, attack: 10, damage: 2, health: 5},
+	}
+	e, err := NewEncounter([]Combatant{hero}, wolves, rand.New(rand.NewSource(2)))
+	require.NoError(t, err)
+
+	results, err := e.RunEnemyTurns()
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, "hero", e.CurrentParticipant().ID())
+}
+
+func TestCharacterCombatant(t *testing.T) {
+	hero := newCharacter("Aria")
+	hero.Equipment = []entities.Item{
+		{Name: "Espada", Type: gamedata.Weapon, Stats: gamedata.ItemStats{Attack: 3, MagicPower: 2}, IsEquipped: true},
+		{Name: "Cota", Type: gamedata.Armor, Stats: gamedata.ItemStats{Defense: 2}, IsEquipped: true},
+	}
+	c := NewCharacterCombatant(hero)
+
+	assert.Equal(t, hero.ID.Hex(), c.ID())
+	assert.Equal(t, hero.Combat.Armor+2, c.ArmorClass())
+	assert.Equal(t, 2+3+3, c.AttackBonus(), "proficiency 2 plus strength 16 plus sword attack 3")
+
+	classPower := gamedata.SpellPower(hero.Ruleset(), hero.Class, hero.Level, &hero.Attributes)
+	assert.Equal(t, classPower+2, hero.DerivedStats().SpellPower, "sword magic power feeds spell power")
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		damage := c.RollDamage(rng)
+		assert.GreaterOrEqual(t, damage, 1+3, "sword attack adds to damage")
+		assert.LessOrEqual(t, damage, UnarmedDamageDie+3, "sword magic power does not add to damage")
+	}
+}
+
+func TestEncounter_Conditions(t *testing.T) {
+	t.Run("stunned characters lose their turn", func(t *testing.T) {
+		hero := newCharacter("Aria")
+		hero.Combat.Initiative = 100
+		require.NoError(t, hero.ApplyCondition(gamedata.Stunned, time.Now()))
+		goblin := &dummy{id: "goblin", name: "Goblin", initiative: -100, health: 5}
+
+		e, err := NewEncounter([]Combatant{NewCharacterCombatant(hero)}, []Combatant{goblin}, rand.New(rand.NewSource(1)))
+		require.NoError(t, err)
+
+		assert.Equal(t, "goblin", e.CurrentParticipant().ID())
+		assert.Contains(t, e.Log, "💫 Aria perde o turno.")
+		assert.False(t, hero.HasCondition(gamedata.Stunned, time.Now()))
+	})
+
+	t.Run("conditions tick at the start of the turn", func(t *testing.T) {
+		hero := newCharacter("Aria")
+		hero.Combat.Initiative = -100
+		require.NoError(t, hero.ApplyCondition(gamedata.Poisoned, time.Now()))
+		goblin := &dummy{id: "goblin", name: "Goblin", initiative: 100, armor: 1, health: 50}
+
+		e, err := NewEncounter([]Combatant{NewCharacterCombatant(hero)}, []Combatant{goblin}, rand.New(rand.NewSource(1)))
+		require.NoError(t, err)
+		require.NoError(t, e.Pass("goblin"))
+
+		assert.Equal(t, hero.ID.Hex(), e.CurrentParticipant().ID())
+		assert.Equal(t, 19, hero.Combat.Health)
+		assert.Contains(t, e.Log, "🩸 Aria sofre 1 de dano das suas condições.")
+	})
+
+	t.Run("monster attacks inflict their condition", func(t *testing.T) {
+		hero := newCharacter("Aria")
+		hero.Combat.Health, hero.Combat.MaxHealth = 100, 100
+		hero.Combat.Initiative = -100
+		spider := &entities.Monster{ID: "aranha", Name: "Aranha", MaxHealth: 10, Initiative: 100,
+			Attacks: []entities.MonsterAttack{{Name: "Picada", Bonus: 100, DamageDice: 1, DamageDie: 4, Condition: gamedata.Poisoned}}}
+
+		e, err := NewEncounter([]Combatant{NewCharacterCombatant(hero)}, []Combatant{NewMonsterCombatants([]*entities.Monster{spider})[0]}, rand.New(rand.NewSource(3)))
+		require.NoError(t, err)
+		results, err := e.RunEnemyTurns()
+		require.NoError(t, err)
+
+		require.Len(t, results, 1)
+		require.True(t, results[0].Hit)
+		assert.True(t, hero.HasCondition(gamedata.Poisoned, time.Now()))
+		assert.Contains(t, e.Log, "🤢 Aria está Envenenado.")
+	})
+}
+
+func TestDistributeRewards(t *testing.T) {
+	aria, bram, caio := newCharacter("Aria"), newCharacter("Bram"), newCharacter("Caio")
+	caio.Combat.Health = 0
+	startGold := aria.Gold
+
+	require.NoError(t, DistributeRewards([]*entities.Character{aria, bram, caio}, Reward{Experience: 101, Gold: 11}))
+
+	assert.Equal(t, 51, aria.Experience)
+	assert.Equal(t, 50, bram.Experience)
+	assert.Zero(t, caio.Experience)
+	assert.Equal(t, startGold+6, aria.Gold)
+	assert.Equal(t, startGold+5, bram.Gold)
+}
+
+func TestNewMonsterCombatants(t *testing.T) {
+	goblin := &entities.Monster{ID: "goblin", Name: "Goblin", MaxHealth: 7, Experience: 25, Gold: 5,
+		Attacks: []entities.MonsterAttack{{Name: "Cimitarra", Bonus: 4, DamageDice: 1, DamageDie: 6, DamageBonus: 2}}}
+	wolf := &entities.Monster{ID: "lobo", Name: "Lobo", MaxHealth: 11,
+		Attacks: []entities.MonsterAttack{{Name: "Mordida", Bonus: 4, DamageDice: 2, DamageDie: 4, DamageBonus: 2}}}
+
+	combatants := NewMonsterCombatants([]*entities.Monster{goblin, wolf, goblin})
+
+	require.Len(t, combatants, 3)
+	assert.Equal(t, "Goblin 1", combatants[0].Name())
+	assert.Equal(t, "Lobo", combatants[1].Name())
+	assert.Equal(t, "Goblin 2", combatants[2].Name())
+	assert.NotEqual(t, combatants[0].ID(), combatants[2].ID())
+	assert.Equal(t, 7, combatants[0].Health())
+	assert.Equal(t, 4, combatants[0].AttackBonus())
+	assert.Equal(t, Reward{Experience: 25, Gold: 5}, combatants[0].Reward())
+
+	assert.True(t, combatants[0].TakeDamage(10))
+	assert.Equal(t, 7, combatants[2].Health(), "instances do not share health")
+}
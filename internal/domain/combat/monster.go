@@ -0,0 +1,97 @@
+package combat
+
+import (
+	"fmt"
+	"math/rand"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/gamedata"
+)
+
+// MonsterCombatant é uma instância de um monstro do bestiário em combate
+type MonsterCombatant struct {
+	Monster       *entities.Monster
+	InstanceID    string // Diferencia monstros iguais no mesmo combate
+	Label         string // Nome exibido, numerado quando há repetidos
+	CurrentHealth int
+}
+
+// NewMonsterCombatants cria as instâncias de combate dos monstros, numerando
+// os nomes repetidos (ex: "Goblin 1", "Goblin 2")
+func NewMonsterCombatants(monsters []*entities.Monster) []*MonsterCombatant {
+	total := make(map[string]int)
+	for _, m := range monsters {
+		total[m.ID]++
+	}
+
+	seen := make(map[string]int)
+	combatants := make([]*MonsterCombatant, 0, len(monsters))
+	for i, m := range monsters {
+		seen[m.ID]++
+		label := m.Name
+		if total[m.ID] > 1 {
+			label = fmt.Sprintf("%s %d", m.Name, seen[m.ID])
+		}
+		combatants = append(combatants, &MonsterCombatant{
+			Monster:       m,
+			InstanceID:    fmt.Sprintf("%s#%d", m.ID, i+1),
+			Label:         label,
+			CurrentHealth: m.MaxHealth,
+		})
+	}
+	return combatants
+}
+
+// ID retorna o identificador da instância do monstro
+func (m *MonsterCombatant) ID() string {
+	return m.InstanceID
+}
+
+// Name retorna o nome exibido do monstro
+func (m *MonsterCombatant) Name() string {
+	return m.Label
+}
+
+// InitiativeBonus retorna a iniciativa do monstro
+func (m *MonsterCombatant) InitiativeBonus() int {
+	return m.Monster.Initiative
+}
+
+// ArmorClass retorna a armadura do monstro
+func (m *MonsterCombatant) ArmorClass() int {
+	return m.Monster.Armor
+}
+
+// AttackBonus retorna o bônus do ataque principal do monstro
+func (m *MonsterCombatant) AttackBonus() int {
+	return m.Monster.Attacks[0].Bonus
+}
+
+// RollDamage rola o dano do ataque principal do monstro
+func (m *MonsterCombatant) RollDamage(rng *rand.Rand) int {
+	return m.Monster.Attacks[0].RollDamage(rng)
+}
+
+// InflictedCondition retorna a condição aplicada pelo ataque principal do monstro
+func (m *MonsterCombatant) InflictedCondition() gamedata.ConditionID {
+	return m.Monster.Attacks[0].Condition
+}
+
+// Health retorna os pontos de vida atuais do monstro
+func (m *MonsterCombatant) Health() int {
+	return m.CurrentHealth
+}
+
+// TakeDamage aplica o dano ao monstro
+func (m *MonsterCombatant) TakeDamage(damage int) bool {
+	m.CurrentHealth -= damage
+	if m.CurrentHealth < 0 {
+		m.CurrentHealth = 0
+	}
+	return m.CurrentHealth == 0
+}
+
+// Reward retorna a experiência e o ouro do monstro
+func (m *MonsterCombatant) Reward() Reward {
+	return Reward{Experience: m.Monster.Experience, Gold: m.Monster.Gold}
+}
@@ -0,0 +1,49 @@
+package combat
+
+import (
+	"fmt"
+
+	"sirdraith/internal/domain/entities"
+)
+
+// Reward representa a experiência e o ouro concedidos por um combate
+type Reward struct {
+	Experience int
+	Gold       int
+}
+
+// Add soma duas recompensas
+func (r Reward) Add(other Reward) Reward {
+	return Reward{
+		Experience: r.Experience + other.Experience,
+		Gold:       r.Gold + other.Gold,
+	}
+}
+
+// DistributeRewards divide a recompensa igualmente entre os personagens que
+// sobreviveram. O resto da divisão fica com o primeiro sobrevivente.
+func DistributeRewards(characters []*entities.Character, reward Reward) error {
+	var survivors []*entities.Character
+	for _, character := range characters {
+		if character.Combat.Health > 0 {
+			survivors = append(survivors, character)
+		}
+	}
+	if len(survivors) == 0 {
+		return nil
+	}
+
+	n := len(survivors)
+	for i, character := range survivors {
+		exp, gold := reward.Experience/n, reward.Gold/n
+		if i == 0 {
+			exp += reward.Experience % n
+			gold += reward.Gold % n
+		}
+		if err := character.AddExperience(exp); err != nil {
+			return fmt.Errorf("erro ao conceder experiência a %s: %w", character.Name, err)
+		}
+		character.Gold += gold
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Package dice interpreta e rola expressões de dados como "2d6+3", "4d6kh3",
+// "1d20+FOR" e "3d6!".
+package dice
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+// Limites das expressões, para que uma rolagem não trave o bot
+const (
+	MaxExpressionLength = 200  // Caracteres de uma expressão
+	MaxDice             = 100  // Dados de uma única rolagem, como o 100 de "100d6"
+	MaxSides            = 1000 // Faces de um dado
+	MaxExplosions       = 10   // Dados extras que um único dado explosivo pode gerar
+)
+
+var (
+	// ErrInvalidExpression indica uma expressão de dados mal formada
+	ErrInvalidExpression = errors.New("expressão de dados inválida")
+	// ErrUnknownReference indica uma referência que não pôde ser resolvida
+	ErrUnknownReference = errors.New("referência desconhecida")
+)
+
+// Mode indica se os d20 da expressão são rolados com vantagem ou desvantagem
+type Mode int
+
+const (
+	Normal       Mode = iota
+	Advantage         // Rola cada d20 duas vezes e fica com o maior
+	Disadvantage      // Rola cada d20 duas vezes e fica com o menor
+)
+
+// Resolver resolve referências da expressão, como "FOR" ou "stealth", para o
+// seu valor. Retorna false para referências desconhecidas
+type Resolver func(name string) (int, bool)
+
+// Die é um dado rolado
+type Die struct {
+	Value    int
+	Dropped  bool // Descartado por manter ou descartar maiores/menores
+	Exploded bool // Gerado pela explosão de outro dado
+}
+
+// Term é uma parte da expressão mostrada no detalhamento: uma rolagem de dados
+// ou uma referência ao personagem
+type Term struct {
+	Notation string // Ex: "4d6kh3" ou "FOR"
+	Dice     []Die  // Dados rolados, vazio para referências
+	Value    int    // Soma dos dados mantidos ou valor da referência
+}
+
+// IsReference indica se o termo é uma referência em vez de uma rolagem
+func (t Term) IsReference() bool {
+	return t.Dice == nil
+}
+
+// Result é o resultado de uma expressão rolada
+type Result struct {
+	Expression string
+	Mode       Mode
+	Terms      []Term
+	Total      int
+}
+
+// Options configura uma rolagem
+type Options struct {
+	Mode    Mode
+	Resolve Resolver // Obrigatório se a expressão tiver referências
+}
+
+// Expression é uma expressão de dados já interpretada, que pode ser rolada várias vezes
+type Expression struct {
+	source string
+	root   node
+}
+
+// Parse interpreta uma expressão de dados. Aceita números, NdM (N opcional e
+// M podendo ser %), os operadores + - * / e parênteses, manter ou descartar os
+// maiores ou menores dados (kh, kl, dh, dl; k equivale a kh), dados explosivos
+// (!) e referências como FOR, DES ou stealth
+func Parse(source string) (*Expression, error) {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		return nil, fmt.Errorf("%w: expressão vazia", ErrInvalidExpression)
+	}
+	if len([]rune(source)) > MaxExpressionLength {
+		return nil, fmt.Errorf("%w: a expressão passa de %d caracteres", ErrInvalidExpression, MaxExpressionLength)
+	}
+
+	p := &parser{input: []rune(source)}
+	root, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if !p.done() {
+		return nil, p.errorf("caractere inesperado %q", p.peek())
+	}
+	return &Expression{source: source, root: root}, nil
+}
+
+// String retorna a expressão como foi escrita
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Roll rola a expressão
+func (e *Expression) Roll(rng *rand.Rand, opts Options) (*Result, error) {
+	r := &roll{rng: rng, opts: opts}
+	total, err := e.root.eval(r)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{Expression: e.source, Mode: opts.Mode, Terms: r.terms, Total: total}, nil
+}
+
+// Roll interpreta e rola uma expressão de dados
+func Roll(source string, rng *rand.Rand, opts Options) (*Result, error) {
+	expression, err := Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	return expression.Roll(rng, opts)
+}
+
+// roll guarda o estado de uma rolagem em andamento
+type roll struct {
+	rng   *rand.Rand
+	opts  Options
+	terms []Term
+}
+
+// selection indica quais dados de uma rolagem são mantidos
+type selection struct {
+	keep    bool // Manter em vez de descartar
+	highest bool // Os maiores em vez dos menores
+	count   int
+}
+
+// notation escreve a seleção no formato da expressão, como "kh3"
+func (s selection) notation() string {
+	kind := "d"
+	if s.keep {
+		kind = "k"
+	}
+	order := "l"
+	if s.highest {
+		order = "h"
+	}
+	return fmt.Sprintf("%s%s%d", kind, order, s.count)
+}
+
+// apply marca como descartados os dados fora da seleção
+func (s selection) apply(dice []Die) {
+	order := make([]int, len(dice))
+	for i := range order {
+		order[i] = i
+	}
+	// Ordena dos maiores para os menores, ou o contrário, mantendo a ordem de
+	// rolagem nos empates
+	sort.SliceStable(order, func(a, b int) bool {
+		if s.highest {
+			return dice[order[a]].Value > dice[order[b]].Value
+		}
+		return dice[order[a]].Value < dice[order[b]].Value
+	})
+
+	count := min(s.count, len(dice))
+	for rank, i := range order {
+		selected := rank < count
+		dice[i].Dropped = selected != s.keep
+	}
+}
@@ -0,0 +1,197 @@
+package dice
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rollSource é uma fonte aleatória que faz rng.Intn(n) retornar os valores
+// informados, em ordem, para qualquer n maior que eles
+type rollSource []int64
+
+func (s *rollSource) Int63() int64 {
+	v := (*s)[0]
+	*s = (*s)[1:]
+	return v << 32
+}
+
+func (s *rollSource) Seed(int64) {}
+
+// rolls cria um gerador cujos dados saem com os resultados informados, a partir de 1
+func rolls(results ...int) *rand.Rand {
+	source := make(rollSource, len(results))
+	for i, r := range results {
+		source[i] = int64(r - 1)
+	}
+	return rand.New(&source)
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		expression  string
+		errContains string
+	}{
+		{name: "empty", expression: "  ", errContains: "expressão vazia"},
+		{name: "too long", expression: strings.Repeat("1+", 101) + "1", errContains: "passa de 200 caracteres"},
+		{name: "dangling operator", expression: "2d6+", errContains: "fim inesperado"},
+		{name: "unclosed parenthesis", expression: "(1d4+2", errContains: "parêntese não fechado"},
+		{name: "unexpected character", expression: "2d6 # 3", errContains: "caractere inesperado '#' na posição 5"},
+		{name: "too many dice", expression: "101d6", errContains: "de 1 a 100"},
+		{name: "zero dice", expression: "0d6", errContains: "de 1 a 100"},
+		{name: "too many sides", expression: "1d1001", errContains: "de 1 a 1000 faces"},
+		{name: "keep more than rolled", expression: "2d6kh3", errContains: "de 1 a 2 dados"},
+		{name: "drop every die", expression: "2d6dl2", errContains: "descartar de 1 a 1 dados"},
+		{name: "drop the only die", expression: "1d20dh1", errContains: "único dado"},
+		{name: "keep without count", expression: "4d6kh", errContains: "quantos dados"},
+		{name: "two selections", expression: "4d6kh3dl1", errContains: "uma vez por rolagem"},
+		{name: "exploding d1", expression: "3d1!", errContains: "uma face"},
+		{name: "number glued to reference", expression: "2FOR", errContains: "caractere inesperado 'F'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.expression)
+			require.ErrorIs(t, err, ErrInvalidExpression)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
+func TestRoll(t *testing.T) {
+	references := map[string]int{"for": 3, "stealth": 5}
+	resolve := func(name string) (int, bool) {
+		value, ok := references[strings.ToLower(name)]
+		return value, ok
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		rolls      []int
+		mode       Mode
+		wantTotal  int
+		wantTerms  []Term
+	}{
+		{
+			name: "dice plus modifier", expression: "2d6+3", rolls: []int{4, 6}, wantTotal: 13,
+			wantTerms: []Term{{Notation: "2d6", Dice: []Die{{Value: 4}, {Value: 6}}, Value: 10}},
+		},
+		{
+			name: "implicit single die and percentile", expression: "d8 + d%", rolls: []int{3, 42}, wantTotal: 45,
+			wantTerms: []Term{
+				{Notation: "1d8", Dice: []Die{{Value: 3}}, Value: 3},
+				{Notation: "1d100", Dice: []Die{{Value: 42}}, Value: 42},
+			},
+		},
+		{
+			name: "keep highest", expression: "4d6kh3", rolls: []int{6, 1, 5, 3}, wantTotal: 14,
+			wantTerms: []Term{{Notation: "4d6kh3", Dice: []Die{{Value: 6}, {Value: 1, Dropped: true}, {Value: 5}, {Value: 3}}, Value: 14}},
+		},
+		{
+			name: "k is keep highest", expression: "2d20k1", rolls: []int{8, 15}, wantTotal: 15,
+			wantTerms: []Term{{Notation: "2d20kh1", Dice: []Die{{Value: 8, Dropped: true}, {Value: 15}}, Value: 15}},
+		},
+		{
+			name: "keep lowest", expression: "2d20kl1", rolls: []int{8, 15}, wantTotal: 8,
+			wantTerms: []Term{{Notation: "2d20kl1", Dice: []Die{{Value: 8}, {Value: 15, Dropped: true}}, Value: 8}},
+		},
+		{
+			name: "drop lowest", expression: "4D6DL1", rolls: []int{2, 2, 5, 4}, wantTotal: 11,
+			wantTerms: []Term{{Notation: "4d6dl1", Dice: []Die{{Value: 2, Dropped: true}, {Value: 2}, {Value: 5}, {Value: 4}}, Value: 11}},
+		},
+		{
+			name: "drop highest", expression: "3d6dh1", rolls: []int{6, 1, 6}, wantTotal: 7,
+			wantTerms: []Term{{Notation: "3d6dh1", Dice: []Die{{Value: 6, Dropped: true}, {Value: 1}, {Value: 6}}, Value: 7}},
+		},
+		{
+			name: "exploding dice", expression: "3d6!", rolls: []int{6, 6, 2, 3, 4}, wantTotal: 21,
+			wantTerms: []Term{{Notation: "3d6!", Dice: []Die{{Value: 6}, {Value: 6, Exploded: true}, {Value: 2, Exploded: true}, {Value: 3}, {Value: 4}}, Value: 21}},
+		},
+		{
+			name: "arithmetic and precedence", expression: "(1d4+2)*3-10/4", rolls: []int{2}, wantTotal: 10,
+			wantTerms: []Term{{Notation: "1d4", Dice: []Die{{Value: 2}}, Value: 2}},
+		},
+		{name: "division rounds down", expression: "-7/2", wantTotal: -4},
+		{
+			name: "character references", expression: "1d20+FOR-Stealth", rolls: []int{11}, wantTotal: 9,
+			wantTerms: []Term{
+				{Notation: "1d20", Dice: []Die{{Value: 11}}, Value: 11},
+				{Notation: "FOR", Value: 3},
+				{Notation: "STEALTH", Value: 5},
+			},
+		},
+		{
+			name: "advantage", expression: "1d20+2", rolls: []int{5, 17}, mode: Advantage, wantTotal: 19,
+			wantTerms: []Term{{Notation: "2d20kh1", Dice: []Die{{Value: 5, Dropped: true}, {Value: 17}}, Value: 17}},
+		},
+		{
+			name: "disadvantage", expression: "d20+2", rolls: []int{5, 17}, mode: Disadvantage, wantTotal: 7,
+			wantTerms: []Term{{Notation: "2d20kl1", Dice: []Die{{Value: 5}, {Value: 17, Dropped: true}}, Value: 5}},
+		},
+		{
+			name: "advantage only changes single d20s", expression: "1d6+2d20kh1", rolls: []int{4, 3, 9}, mode: Advantage, wantTotal: 13,
+			wantTerms: []Term{
+				{Notation: "1d6", Dice: []Die{{Value: 4}}, Value: 4},
+				{Notation: "2d20kh1", Dice: []Die{{Value: 3, Dropped: true}, {Value: 9}}, Value: 9},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Roll(tt.expression, rolls(tt.rolls...), Options{Mode: tt.mode, Resolve: resolve})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantTotal, result.Total)
+			assert.Equal(t, tt.wantTerms, result.Terms)
+			assert.Equal(t, tt.mode, result.Mode)
+		})
+	}
+}
+
+func TestRoll_Errors(t *testing.T) {
+	_, err := Roll("1d20+FOR", rolls(10), Options{})
+	assert.ErrorIs(t, err, ErrUnknownReference)
+
+	_, err = Roll("1d20+magia", rolls(10), Options{Resolve: func(string) (int, bool) { return 0, false }})
+	assert.ErrorIs(t, err, ErrUnknownReference)
+	assert.ErrorContains(t, err, "magia")
+
+	_, err = Roll("1d6/(2-2)", rolls(3), Options{})
+	assert.ErrorContains(t, err, "divisão por zero")
+
+	_, err = Roll("1000000000*10", rolls(), Options{})
+	assert.ErrorContains(t, err, "grande demais")
+}
+
+func TestRoll_ExplosionLimit(t *testing.T) {
+	results := make([]int, MaxExplosions+1)
+	for i := range results {
+		results[i] = 2
+	}
+
+	result, err := Roll("1d2!", rolls(results...), Options{})
+	require.NoError(t, err)
+	assert.Len(t, result.Terms[0].Dice, MaxExplosions+1)
+	assert.Equal(t, 2*(MaxExplosions+1), result.Total)
+}
+
+func TestExpression_RollAgain(t *testing.T) {
+	expression, err := Parse("2d6")
+	require.NoError(t, err)
+	assert.Equal(t, "2d6", expression.String())
+
+	rng := rolls(1, 2, 6, 6)
+	first, err := expression.Roll(rng, Options{})
+	require.NoError(t, err)
+	second, err := expression.Roll(rng, Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, first.Total)
+	assert.Equal(t, 12, second.Total)
+}
@@ -0,0 +1,378 @@
+package dice
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// maxValue limita os números e resultados intermediários da expressão
+const maxValue = 1_000_000_000
+
+// node é um nó da árvore de uma expressão interpretada
+type node interface {
+	eval(r *roll) (int, error)
+}
+
+// number é um número constante
+type number struct {
+	value int
+}
+
+func (n number) eval(*roll) (int, error) {
+	return n.value, nil
+}
+
+// reference é uma referência resolvida na hora da rolagem, como FOR ou stealth
+type reference struct {
+	name string
+}
+
+func (n reference) eval(r *roll) (int, error) {
+	if r.opts.Resolve == nil {
+		return 0, fmt.Errorf("%w: %s (nenhum personagem para consultar)", ErrUnknownReference, n.name)
+	}
+	value, ok := r.opts.Resolve(n.name)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownReference, n.name)
+	}
+	r.terms = append(r.terms, Term{Notation: strings.ToUpper(n.name), Value: value})
+	return value, nil
+}
+
+// negation inverte o sinal de uma expressão
+type negation struct {
+	operand node
+}
+
+func (n negation) eval(r *roll) (int, error) {
+	value, err := n.operand.eval(r)
+	return -value, err
+}
+
+// binary é uma operação aritmética entre duas expressões
+type binary struct {
+	op          rune
+	left, right node
+}
+
+func (n binary) eval(r *roll) (int, error) {
+	left, err := n.left.eval(r)
+	if err != nil {
+		return 0, err
+	}
+	right, err := n.right.eval(r)
+	if err != nil {
+		return 0, err
+	}
+
+	var value int
+	switch n.op {
+	case '+':
+		value = left + right
+	case '-':
+		value = left - right
+	case '*':
+		value = left * right
+	case '/':
+		if right == 0 {
+			return 0, fmt.Errorf("%w: divisão por zero", ErrInvalidExpression)
+		}
+		// Divisão arredondada para baixo
+		value = left / right
+		if left%right != 0 && (left < 0) != (right < 0) {
+			value--
+		}
+	}
+	if value > maxValue || value < -maxValue {
+		return 0, fmt.Errorf("%w: resultado grande demais", ErrInvalidExpression)
+	}
+	return value, nil
+}
+
+// diceRoll é uma rolagem de dados como 4d6kh3 ou 3d6!
+type diceRoll struct {
+	count     int
+	sides     int
+	explode   bool
+	selection *selection
+}
+
+// notation escreve a rolagem no formato da expressão
+func (n diceRoll) notation() string {
+	notation := fmt.Sprintf("%dd%d", n.count, n.sides)
+	if n.explode {
+		notation += "!"
+	}
+	if n.selection != nil {
+		notation += n.selection.notation()
+	}
+	return notation
+}
+
+func (n diceRoll) eval(r *roll) (int, error) {
+	// Vantagem e desvantagem transformam um d20 simples em 2d20kh1 ou 2d20kl1
+	if r.opts.Mode != Normal && n.count == 1 && n.sides == 20 && !n.explode && n.selection == nil {
+		n.count = 2
+		n.selection = &selection{keep: true, highest: r.opts.Mode == Advantage, count: 1}
+	}
+
+	dice := make([]Die, 0, n.count)
+	for i := 0; i < n.count; i++ {
+		die := Die{Value: r.rng.Intn(n.sides) + 1}
+		dice = append(dice, die)
+		for explosions := 0; n.explode && die.Value == n.sides && explosions < MaxExplosions; explosions++ {
+			die = Die{Value: r.rng.Intn(n.sides) + 1, Exploded: true}
+			dice = append(dice, die)
+		}
+	}
+	if n.selection != nil {
+		n.selection.apply(dice)
+	}
+
+	total := 0
+	for _, die := range dice {
+		if !die.Dropped {
+			total += die.Value
+		}
+	}
+	r.terms = append(r.terms, Term{Notation: n.notation(), Dice: dice, Value: total})
+	return total, nil
+}
+
+// parser interpreta uma expressão por descida recursiva:
+//
+//	expressão := termo (("+" | "-") termo)*
+//	termo     := unário (("*" | "/") unário)*
+//	unário    := ("-" | "+") unário | primário
+//	primário  := "(" expressão ")" | número | dados | referência
+type parser struct {
+	input []rune
+	pos   int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *parser) peek() rune {
+	if p.done() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *parser) peekAt(offset int) rune {
+	if p.pos+offset >= len(p.input) {
+		return 0
+	}
+	return p.input[p.pos+offset]
+}
+
+func (p *parser) skipSpaces() {
+	for !p.done() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+// errorf cria um erro de interpretação indicando a posição, a partir de 1
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s na posição %d", ErrInvalidExpression, fmt.Sprintf(format, args...), p.pos+1)
+}
+
+func (p *parser) parseExpression() (node, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpaces()
+		op := p.peek()
+		if op != '+' && op != '-' {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) parseTerm() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpaces()
+		op := p.peek()
+		if op != '*' && op != '/' {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary() (node, error) {
+	p.skipSpaces()
+	switch p.peek() {
+	case '-':
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return negation{operand: operand}, nil
+	case '+':
+		p.pos++
+		return p.parseUnary()
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	p.skipSpaces()
+	c := p.peek()
+	switch {
+	case p.done():
+		return nil, p.errorf("fim inesperado")
+	case c == '(':
+		p.pos++
+		inner, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if p.peek() != ')' {
+			return nil, p.errorf("parêntese não fechado")
+		}
+		p.pos++
+		return inner, nil
+	case unicode.IsDigit(c):
+		value, err := p.parseNumber()
+		if err != nil {
+			return nil, err
+		}
+		if p.atDice() {
+			return p.parseDice(value)
+		}
+		return number{value: value}, nil
+	case p.atDice():
+		return p.parseDice(1)
+	case unicode.IsLetter(c):
+		start := p.pos
+		for !p.done() && (unicode.IsLetter(p.peek()) || p.peek() == '_') {
+			p.pos++
+		}
+		return reference{name: string(p.input[start:p.pos])}, nil
+	}
+	return nil, p.errorf("caractere inesperado %q", c)
+}
+
+// atDice indica se a posição atual começa a parte "dM" de uma rolagem
+func (p *parser) atDice() bool {
+	next := p.peekAt(1)
+	return unicode.ToLower(p.peek()) == 'd' && (unicode.IsDigit(next) || next == '%')
+}
+
+func (p *parser) parseNumber() (int, error) {
+	start := p.pos
+	for !p.done() && unicode.IsDigit(p.peek()) {
+		p.pos++
+	}
+	value, err := strconv.Atoi(string(p.input[start:p.pos]))
+	if err != nil || value > maxValue {
+		p.pos = start
+		return 0, p.errorf("número grande demais")
+	}
+	return value, nil
+}
+
+// parseDice interpreta a parte "dM" de uma rolagem e seus modificadores
+func (p *parser) parseDice(count int) (node, error) {
+	p.pos++ // d
+	sides := 100
+	if p.peek() == '%' {
+		p.pos++
+	} else {
+		var err error
+		if sides, err = p.parseNumber(); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case count < 1 || count > MaxDice:
+		return nil, p.errorf("a quantidade de dados deve ser de 1 a %d", MaxDice)
+	case sides < 1 || sides > MaxSides:
+		return nil, p.errorf("os dados devem ter de 1 a %d faces", MaxSides)
+	}
+
+	roll := diceRoll{count: count, sides: sides}
+	for {
+		switch c := unicode.ToLower(p.peek()); {
+		case c == '!':
+			if roll.explode {
+				return nil, p.errorf("explosão repetida")
+			}
+			if sides < 2 {
+				return nil, p.errorf("dados de uma face não podem explodir")
+			}
+			p.pos++
+			roll.explode = true
+		case c == 'k' || (c == 'd' && isSelectionOrder(p.peekAt(1))):
+			if roll.selection != nil {
+				return nil, p.errorf("só é possível manter ou descartar uma vez por rolagem")
+			}
+			selection, err := p.parseSelection(count)
+			if err != nil {
+				return nil, err
+			}
+			roll.selection = selection
+		default:
+			return roll, nil
+		}
+	}
+}
+
+// parseSelection interpreta kh, kl, dh, dl ou k seguidos da quantidade de dados
+func (p *parser) parseSelection(count int) (*selection, error) {
+	s := &selection{keep: unicode.ToLower(p.peek()) == 'k', highest: true}
+	p.pos++
+	if isSelectionOrder(p.peek()) {
+		s.highest = unicode.ToLower(p.peek()) == 'h'
+		p.pos++
+	}
+	if !unicode.IsDigit(p.peek()) {
+		return nil, p.errorf("informe quantos dados manter ou descartar")
+	}
+	n, err := p.parseNumber()
+	if err != nil {
+		return nil, err
+	}
+	if n < 1 || n > count {
+		return nil, p.errorf("é possível manter ou descartar de 1 a %d dados", count)
+	}
+	// Descartar todos os dados zeraria a rolagem
+	if !s.keep && n == count {
+		if count == 1 {
+			return nil, p.errorf("não é possível descartar o único dado")
+		}
+		return nil, p.errorf("é possível descartar de 1 a %d dados", count-1)
+	}
+	s.count = n
+	return s, nil
+}
+
+func isSelectionOrder(c rune) bool {
+	c = unicode.ToLower(c)
+	return c == 'h' || c == 'l'
+}
@@ -0,0 +1,52 @@
+package dice
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Source é a fonte aleatória das rolagens do jogo. Pode ser usada por vários
+// comandos ao mesmo tempo e, criada com uma semente fixa, repete sempre a mesma
+// sequência de resultados, o que permite testar as rolagens
+type Source struct {
+	mu  sync.Mutex
+	src rand.Source64
+}
+
+// NewSource cria uma fonte com a semente informada
+func NewSource(seed int64) *Source {
+	return &Source{src: rand.NewSource(seed).(rand.Source64)}
+}
+
+// NewRandomSource cria uma fonte com semente tirada do relógio
+func NewRandomSource() *Source {
+	return NewSource(time.Now().UnixNano())
+}
+
+// Int63 implementa rand.Source
+func (s *Source) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Int63()
+}
+
+// Uint64 implementa rand.Source64
+func (s *Source) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Uint64()
+}
+
+// Seed reinicia a fonte com uma nova semente
+func (s *Source) Seed(seed int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.src.Seed(seed)
+}
+
+// Rand cria um gerador sobre a fonte. Como a fonte é protegida, o gerador pode
+// ser compartilhado entre comandos sem trava própria (exceto o método Read)
+func (s *Source) Rand() *rand.Rand {
+	return rand.New(s)
+}
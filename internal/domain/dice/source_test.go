@@ -0,0 +1,46 @@
+package dice
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSource_SameSeedSameRolls(t *testing.T) {
+	first, err := Roll("10d20+4d6!", NewSource(42).Rand(), Options{})
+	require.NoError(t, err)
+	second, err := Roll("10d20+4d6!", NewSource(42).Rand(), Options{})
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	source := NewSource(7)
+	rng := source.Rand()
+	values := []int{rng.Intn(20), rng.Intn(20), rng.Intn(20)}
+	source.Seed(7)
+	assert.Equal(t, values, []int{rng.Intn(20), rng.Intn(20), rng.Intn(20)}, "reseeding restarts the sequence")
+}
+
+func TestSource_ConcurrentRolls(t *testing.T) {
+	source := NewRandomSource()
+	expression, err := Parse("4d6kh3")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		rng := source.Rand()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				result, err := expression.Roll(rng, Options{})
+				if assert.NoError(t, err) {
+					assert.GreaterOrEqual(t, result.Total, 3)
+					assert.LessOrEqual(t, result.Total, 18)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
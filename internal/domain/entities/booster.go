@@ -0,0 +1,100 @@
+package entities
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+)
+
+// ErrEmptyCardPool indicates there are no cards to draw a booster from
+var ErrEmptyCardPool = errors.New("no cards available for booster")
+
+// BoosterPack defines a purchasable pack of random cards
+type BoosterPack struct {
+	ID      string
+	Name    string
+	Price   int                // Price in gold
+	Size    int                // Number of cards in the pack
+	Weights map[CardRarity]int // Relative chance of each rarity per card
+}
+
+// BoosterPacks lists the packs available for purchase
+var BoosterPacks = map[string]*BoosterPack{
+	"basico": {
+		ID:    "basico",
+		Name:  "Pacote Básico",
+		Price: 50,
+		Size:  5,
+		Weights: map[CardRarity]int{
+			CardRarityCommon:    700,
+			CardRarityUncommon:  220,
+			CardRarityRare:      60,
+			CardRarityEpic:      15,
+			CardRarityLegendary: 5,
+		},
+	},
+	"premium": {
+		ID:    "premium",
+		Name:  "Pacote Premium",
+		Price: 150,
+		Size:  5,
+		Weights: map[CardRarity]int{
+			CardRarityCommon:    400,
+			CardRarityUncommon:  350,
+			CardRarityRare:      180,
+			CardRarityEpic:      55,
+			CardRarityLegendary: 15,
+		},
+	},
+}
+
+// rarityOrder lists rarities from most to least common
+var rarityOrder = []CardRarity{
+	CardRarityCommon,
+	CardRarityUncommon,
+	CardRarityRare,
+	CardRarityEpic,
+	CardRarityLegendary,
+}
+
+// Open draws the pack's cards from the pool. Each card first rolls a rarity by
+// weight, considering only rarities present in the pool, then picks a card of
+// that rarity uniformly. The rarity rolls are returned along with the cards,
+// from 1 to the sum of the weights of the rarities present in the pool.
+func (b *BoosterPack) Open(pool []*Card, rng *rand.Rand) ([]*Card, []int, error) {
+	byRarity := make(map[CardRarity][]*Card)
+	for _, card := range pool {
+		byRarity[card.Rarity] = append(byRarity[card.Rarity], card)
+	}
+	for _, cards := range byRarity {
+		sort.Slice(cards, func(i, j int) bool { return cards[i].ID < cards[j].ID })
+	}
+
+	var rarities []CardRarity
+	total := 0
+	for _, rarity := range rarityOrder {
+		if len(byRarity[rarity]) > 0 && b.Weights[rarity] > 0 {
+			rarities = append(rarities, rarity)
+			total += b.Weights[rarity]
+		}
+	}
+	if total == 0 {
+		return nil, nil, ErrEmptyCardPool
+	}
+
+	cards := make([]*Card, 0, b.Size)
+	rolls := make([]int, 0, b.Size)
+	for i := 0; i < b.Size; i++ {
+		roll := rng.Intn(total)
+		rolls = append(rolls, roll+1)
+		for _, rarity := range rarities {
+			roll -= b.Weights[rarity]
+			if roll < 0 {
+				candidates := byRarity[rarity]
+				cards = append(cards, candidates[rng.Intn(len(candidates))])
+				break
+			}
+		}
+	}
+	return cards, rolls, nil
+}
@@ -37,42 +37,44 @@ const (
 
 // Effect represents a card effect
 type Effect struct {
-	Type       string      `bson:"type"`
-	Value      int         `bson:"value"`
-	Duration   int         `bson:"duration"`
-	Target     string      `bson:"target"`
-	Conditions []string    `bson:"conditions,omitempty"`
-	Properties interface{} `bson:"properties,omitempty"`
+	Type       string      `bson:"type" json:"type" yaml:"type"`
+	Value      int         `bson:"value" json:"value" yaml:"value"`
+	Duration   int         `bson:"duration" json:"duration,omitempty" yaml:"duration,omitempty"`
+	Target     string      `bson:"target" json:"target,omitempty" yaml:"target,omitempty"`
+	Conditions []string    `bson:"conditions,omitempty" json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	Properties interface{} `bson:"properties,omitempty" json:"properties,omitempty" yaml:"properties,omitempty"`
 }
 
-// Requirements represents the requirements to play a card
+// Requirements represents what a character needs to use a card
 type Requirements struct {
-	Level      int            `bson:"level"`
-	Class      string         `bson:"class,omitempty"`
-	Attributes map[string]int `bson:"attributes,omitempty"`
-	Skills     []string       `bson:"skills,omitempty"`
-	Resources  map[string]int `bson:"resources,omitempty"`
+	Level      int            `bson:"level" json:"level,omitempty" yaml:"level,omitempty"`
+	Class      string         `bson:"class,omitempty" json:"class,omitempty" yaml:"class,omitempty"`
+	Attributes map[string]int `bson:"attributes,omitempty" json:"attributes,omitempty" yaml:"attributes,omitempty"`
+	Skills     []string       `bson:"skills,omitempty" json:"skills,omitempty" yaml:"skills,omitempty"`
+	Resources  map[string]int `bson:"resources,omitempty" json:"resources,omitempty" yaml:"resources,omitempty"`
 }
 
-// Card represents a card in the game
+// Card represents a card in the game.
+// Timestamps are not part of the import/export format.
 type Card struct {
-	ID          string     `bson:"_id"`
-	Name        string     `bson:"name"`
-	Description string     `bson:"description"`
-	Type        CardType   `bson:"type"`
-	Rarity      CardRarity `bson:"rarity"`
-	Cost        int        `bson:"cost"`
-	Attack      int        `bson:"attack,omitempty"`
-	Defense     int        `bson:"defense,omitempty"`
-	Effects     []string   `bson:"effects,omitempty"`
-	Keywords    []string   `bson:"keywords,omitempty"`
-	ImageURL    string     `bson:"image_url,omitempty"`
-	CreatedAt   int64      `bson:"created_at"`
-	UpdatedAt   int64      `bson:"updated_at"`
+	ID           string        `bson:"_id" json:"id" yaml:"id"`
+	Name         string        `bson:"name" json:"name" yaml:"name"`
+	Description  string        `bson:"description" json:"description,omitempty" yaml:"description,omitempty"`
+	Type         CardType      `bson:"type" json:"type" yaml:"type"`
+	Rarity       CardRarity    `bson:"rarity" json:"rarity" yaml:"rarity"`
+	Cost         int           `bson:"cost" json:"cost" yaml:"cost"`
+	Attack       int           `bson:"attack,omitempty" json:"attack,omitempty" yaml:"attack,omitempty"`
+	Defense      int           `bson:"defense,omitempty" json:"defense,omitempty" yaml:"defense,omitempty"`
+	Effects      []Effect      `bson:"effects,omitempty" json:"effects,omitempty" yaml:"effects,omitempty"`
+	Keywords     []string      `bson:"keywords,omitempty" json:"keywords,omitempty" yaml:"keywords,omitempty"`
+	Requirements *Requirements `bson:"requirements,omitempty" json:"requirements,omitempty" yaml:"requirements,omitempty"` // Requirements to add the card to a deck
+	ImageURL     string        `bson:"image_url,omitempty" json:"image_url,omitempty" yaml:"image_url,omitempty"`
+	CreatedAt    int64         `bson:"created_at" json:"-" yaml:"-"`
+	UpdatedAt    int64         `bson:"updated_at" json:"-" yaml:"-"`
 }
 
 // NewCard creates a new card instance
-func NewCard(id, name string, cardType CardType, rarity CardRarity, description string, cost int, attack, defense int, effects []string, keywords []string, imageURL string) *Card {
+func NewCard(id, name string, cardType CardType, rarity CardRarity, description string, cost int, attack, defense int, effects []Effect, keywords []string, imageURL string) *Card {
 	now := time.Now()
 	return &Card{
 		ID:          id,
@@ -91,6 +93,14 @@ func NewCard(id, name string, cardType CardType, rarity CardRarity, description
 	}
 }
 
+// Class returns the class required to use the card, or an empty string for neutral cards
+func (c *Card) Class() string {
+	if c.Requirements == nil {
+		return ""
+	}
+	return c.Requirements.Class
+}
+
 // Validate validates the card data
 func (c *Card) Validate() error {
 	if c.ID == "" {
@@ -116,5 +126,10 @@ func (c *Card) Validate() error {
 			return errors.New("defense cannot be negative")
 		}
 	}
+	for _, effect := range c.Effects {
+		if err := effect.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
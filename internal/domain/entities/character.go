@@ -19,26 +19,39 @@ type Character struct {
 	Description string             `bson:"description"`     // História/Descrição
 	Level       int                `bson:"level"`           // Nível do personagem
 	Experience  int                `bson:"experience"`      // Experiência atual
+	Progression string             `bson:"progression"`     // Regras de progressão, vazio para as padrão
 	Gold        int                `bson:"gold"`            // Moedas de ouro
 
 	// Atributos base
 	Attributes gamedata.Attributes `bson:"attributes"`
 
 	// Características medievais
-	Class      gamedata.CharacterClass     `bson:"class"`      // Classe (Cavaleiro, Mago, etc)
-	Background string                      `bson:"background"` // Origem (Nobre, Plebeu, etc)
-	Skills     []gamedata.SkillProficiency `bson:"skills"`     // Perícias do personagem
-	Equipment  []Item                      `bson:"equipment"`  // Itens equipados
-	Inventory  []Item                      `bson:"inventory"`  // Inventário completo
-	Status     []string                    `bson:"status"`     // Estados atuais (Ferido, Envenenado, etc)
+	Class      gamedata.CharacterClass     `bson:"class"`                // Classe (Cavaleiro, Mago, etc)
+	Background string                      `bson:"background"`           // Origem (Nobre, Plebeu, etc)
+	Skills     []gamedata.SkillProficiency `bson:"skills"`               // Perícias do personagem
+	Equipment  []Item                      `bson:"equipment"`            // Itens equipados
+	Inventory  []Item                      `bson:"inventory"`            // Inventário completo
+	Status     []string                    `bson:"status"`               // Estados atuais (Ferido, Envenenado, etc)
+	Buffs      []AttributeBuff             `bson:"buffs,omitempty"`      // Bônus temporários de atributo
+	Conditions []ActiveCondition           `bson:"conditions,omitempty"` // Condições do catálogo com efeitos mecânicos
+
+	// Último descanso de cada tipo, para respeitar o intervalo entre descansos
+	LastRests map[gamedata.RestType]time.Time `bson:"last_rests,omitempty"`
 
 	// Estatísticas de combate
 	Combat Combat `bson:"combat"`
 
+	// Escolhas de evolução de nível ainda não feitas pelo jogador
+	PendingChoices []LevelUpChoice `bson:"pending_choices,omitempty"`
+
 	// Dados de auditoria
 	CreatedAt time.Time `bson:"created_at"`
 	UpdatedAt time.Time `bson:"updated_at"`
 	IsActive  bool      `bson:"is_active"`
+
+	// Personagem em uso pelo usuário no servidor, alvo dos comandos de personagem.
+	// A seleção é guardada à parte e preenchida pelo repositório
+	IsSelected bool `bson:"-"`
 }
 
 // Item representa um item no inventário ou equipado
@@ -51,6 +64,8 @@ type Item struct {
 	Value           int                       `bson:"value"`            // Valor em moedas de ouro
 	Effects         string                    `bson:"effects"`          // Efeitos especiais
 	Slot            gamedata.EquipmentSlot    `bson:"slot"`             // Slot de equipamento
+	TwoHanded       bool                      `bson:"two_handed"`       // Se a arma ocupa as duas mãos
+	Proficiency     gamedata.ProficiencyTier  `bson:"proficiency"`      // Categoria de treinamento exigida (armadura leve, arma marcial, etc)
 	IsEquipped      bool                      `bson:"is_equipped"`      // Se está equipado
 	Rarity          gamedata.ItemRarity       `bson:"rarity"`           // Raridade do item
 	Stats           gamedata.ItemStats        `bson:"stats"`            // Estatísticas do item
@@ -58,6 +73,18 @@ type Item struct {
 	RequiredClasses []gamedata.CharacterClass `bson:"required_classes"` // Classes que podem usar
 }
 
+// LevelUpChoice representa as escolhas pendentes de um nível alcançado
+type LevelUpChoice struct {
+	Level         int `bson:"level"`          // Nível que concedeu as escolhas
+	AbilityPoints int `bson:"ability_points"` // Pontos de atributo a distribuir
+	SkillPicks    int `bson:"skill_picks"`    // Perícias de classe a escolher
+}
+
+// IsEmpty indica se não há mais nada a escolher
+func (c LevelUpChoice) IsEmpty() bool {
+	return c.AbilityPoints <= 0 && c.SkillPicks <= 0
+}
+
 // Combat representa as estatísticas de combate do personagem
 type Combat struct {
 	Health     int  `bson:"health"`       // Pontos de vida atual
@@ -65,6 +92,12 @@ type Combat struct {
 	Armor      int  `bson:"armor"`        // Classe de armadura
 	Initiative int  `bson:"initiative"`   // Iniciativa em combate
 	IsInCombat bool `bson:"is_in_combat"` // Se está em combate
+
+	// Recuperação
+	HitDiceSpent int        `bson:"hit_dice_spent"` // Dados de vida gastos em descansos curtos
+	DeathSaves   DeathSaves `bson:"death_saves"`    // Testes contra a morte enquanto caído
+	Stable       bool       `bson:"stable"`         // Se está caído, mas estabilizado
+	Dead         bool       `bson:"dead"`           // Se morreu de vez
 }
 
 // NewCharacter cria um novo personagem com valores padrão
@@ -141,7 +174,34 @@ func (c *Character) getNextLevelExperience() int {
 	if c.Level >= gamedata.MaxLevel {
 		return -1 // Indica que não há próximo nível
 	}
-	return gamedata.CalculateExpForLevel(c.Level + 1)
+	return c.Ruleset().ExpForLevel(c.Level + 1)
+}
+
+// Ruleset retorna as regras de progressão do personagem
+func (c *Character) Ruleset() *gamedata.ProgressionRuleset {
+	return gamedata.ProgressionRulesetOrDefault(c.Progression)
+}
+
+// ApplyProgression passa o personagem para as regras de progressão informadas e
+// recalcula suas estatísticas. O nível é mantido e a experiência é ajustada à
+// faixa do nível nas novas regras. Retorna se algo mudou
+func (c *Character) ApplyProgression(rulesetID string) bool {
+	before := struct {
+		progression string
+		experience  int
+		combat      Combat
+	}{c.Progression, c.Experience, c.Combat}
+
+	c.Progression = rulesetID
+	ruleset := c.Ruleset()
+	c.Level = min(max(c.Level, gamedata.StartingLevel), gamedata.MaxLevel)
+	c.Experience = max(c.Experience, ruleset.ExpForLevel(c.Level))
+	if next := ruleset.ExpForLevel(c.Level + 1); next != -1 && c.Experience >= next {
+		c.Experience = next - 1
+	}
+	c.refreshCombat()
+
+	return before.progression != c.Progression || before.experience != c.Experience || before.combat != c.Combat
 }
 
 // levelUp aumenta o nível do personagem e atualiza atributos
@@ -151,16 +211,161 @@ func (c *Character) levelUp() error {
 	}
 	c.Level++
 	c.updateCombatConfig()
+
+	// Registra as escolhas concedidas pelo novo nível. Perícias só são
+	// oferecidas enquanto houver perícias de classe sem proficiência
+	choice := LevelUpChoice{
+		Level:         c.Level,
+		AbilityPoints: gamedata.AbilityPointsAtLevel(c.Level),
+		SkillPicks:    gamedata.SkillPicksAtLevel(c.Level),
+	}
+	if available := len(c.AvailableSkillChoices()) - c.PendingSkillPicks(); choice.SkillPicks > available {
+		choice.SkillPicks = max(available, 0)
+	}
+	if !choice.IsEmpty() {
+		c.PendingChoices = append(c.PendingChoices, choice)
+	}
 	return nil
 }
 
+// HasPendingChoices indica se o personagem tem escolhas de evolução a fazer
+func (c *Character) HasPendingChoices() bool {
+	return len(c.PendingChoices) > 0
+}
+
+// PendingAbilityPoints retorna os pontos de atributo ainda não distribuídos
+func (c *Character) PendingAbilityPoints() int {
+	total := 0
+	for _, choice := range c.PendingChoices {
+		total += choice.AbilityPoints
+	}
+	return total
+}
+
+// PendingSkillPicks retorna quantas perícias de classe ainda podem ser escolhidas
+func (c *Character) PendingSkillPicks() int {
+	total := 0
+	for _, choice := range c.PendingChoices {
+		total += choice.SkillPicks
+	}
+	return total
+}
+
+// AvailableSkillChoices retorna as perícias da classe em que o personagem ainda não tem proficiência
+func (c *Character) AvailableSkillChoices() []gamedata.Skill {
+	var available []gamedata.Skill
+	for _, skill := range gamedata.GetSkillsForClass(c.Class) {
+		if !c.isProficient(skill) {
+			available = append(available, skill)
+		}
+	}
+	return available
+}
+
+// SpendAbilityPoint gasta um ponto de atributo pendente no atributo informado
+func (c *Character) SpendAbilityPoint(attribute string) error {
+	if !gamedata.IsValidAttribute(attribute) {
+		return fmt.Errorf("atributo inválido: %s", attribute)
+	}
+	if c.PendingAbilityPoints() <= 0 {
+		return fmt.Errorf("não há pontos de atributo para distribuir")
+	}
+	value := c.Attributes.GetValue(attribute)
+	if value >= gamedata.MaxAttributeValue {
+		return fmt.Errorf("o atributo já está no valor máximo (%d)", gamedata.MaxAttributeValue)
+	}
+
+	c.Attributes.SetValue(attribute, value+1)
+	c.consumeChoice(func(choice *LevelUpChoice) bool {
+		if choice.AbilityPoints <= 0 {
+			return false
+		}
+		choice.AbilityPoints--
+		return true
+	})
+
+	// Atributos mudam a vida máxima, a armadura e a iniciativa, mas não curam o personagem
+	c.refreshCombat()
+	return nil
+}
+
+// ChooseSkillProficiency usa uma escolha de perícia pendente na perícia de classe informada
+func (c *Character) ChooseSkillProficiency(skill gamedata.Skill) error {
+	if c.PendingSkillPicks() <= 0 {
+		return fmt.Errorf("não há perícias para escolher")
+	}
+	if c.isProficient(skill) {
+		return fmt.Errorf("o personagem já tem proficiência em %s", skill)
+	}
+	if err := c.AddSkillProficiency(skill); err != nil {
+		return err
+	}
+
+	c.consumeChoice(func(choice *LevelUpChoice) bool {
+		if choice.SkillPicks <= 0 {
+			return false
+		}
+		choice.SkillPicks--
+		return true
+	})
+	return nil
+}
+
+// consumeChoice aplica o consumo na primeira escolha pendente que o aceitar e
+// remove as escolhas esgotadas
+func (c *Character) consumeChoice(consume func(*LevelUpChoice) bool) {
+	for i := range c.PendingChoices {
+		if consume(&c.PendingChoices[i]) {
+			break
+		}
+	}
+
+	// Escolhas de perícia sem perícias disponíveis também são descartadas
+	noSkillsLeft := len(c.AvailableSkillChoices()) == 0
+	remaining := c.PendingChoices[:0]
+	for _, choice := range c.PendingChoices {
+		if noSkillsLeft {
+			choice.SkillPicks = 0
+		}
+		if !choice.IsEmpty() {
+			remaining = append(remaining, choice)
+		}
+	}
+	c.PendingChoices = remaining
+	if len(c.PendingChoices) == 0 {
+		c.PendingChoices = nil
+	}
+}
+
+// isProficient indica se o personagem tem proficiência na perícia
+func (c *Character) isProficient(skill gamedata.Skill) bool {
+	for _, p := range c.Skills {
+		if p.Skill == skill && p.IsProficient {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Character) updateCombatConfig() {
-	c.Combat.MaxHealth = gamedata.CalculateMaxHealth(c.Level, c.Attributes.Constitution)
+	c.Combat.MaxHealth = c.CalculateMaxHealth()
 	c.Combat.Health = c.Combat.MaxHealth
 	c.Combat.Armor = 10 + c.getDexterityModifier()
 	c.Combat.Initiative = c.getDexterityModifier() + (c.Level / 4)
 }
 
+// refreshCombat recalcula as estatísticas de combate mantendo o dano já sofrido
+func (c *Character) refreshCombat() {
+	down := c.Combat.MaxHealth > 0 && c.Combat.Health <= 0
+	damage := max(c.Combat.MaxHealth-c.Combat.Health, 0)
+	c.updateCombatConfig()
+	c.Combat.Health = max(c.Combat.MaxHealth-damage, 1)
+	if down {
+		// Personagens caídos continuam caídos
+		c.Combat.Health = 0
+	}
+}
+
 // AddItem adiciona um item ao inventário
 func (c *Character) AddItem(item Item) error {
 	if len(c.Inventory) >= gamedata.MaxInventorySize {
@@ -204,33 +409,61 @@ func (c *Character) RemoveItem(itemName string, quantity int) error {
 	return fmt.Errorf("item não encontrado ou está equipado")
 }
 
-// EquipItem equipa um item do inventário
+// EquipItem equipa uma unidade de um item do inventário no seu slot. O item que
+// ocupava o slot volta para o inventário, assim como o da mão secundária quando
+// a arma é de duas mãos
 func (c *Character) EquipItem(itemName string) error {
-	if len(c.Equipment) >= gamedata.MaxEquipmentSize {
-		return fmt.Errorf("limite de equipamentos atingido (%d itens)", gamedata.MaxEquipmentSize)
-	}
-
-	var itemToEquip *Item
-	var itemIndex int
+	itemIndex := -1
 	for i, item := range c.Inventory {
 		if item.Name == itemName && !item.IsEquipped {
-			itemToEquip = &item
 			itemIndex = i
 			break
 		}
 	}
-
-	if itemToEquip == nil {
+	if itemIndex == -1 {
 		return fmt.Errorf("item não encontrado no inventário ou já está equipado")
 	}
 
+	itemToEquip := c.Inventory[itemIndex]
 	if err := gamedata.ValidateEquipment(c.Level, c.Class, itemToEquip.Type, itemToEquip.RequiredLevel, itemToEquip.RequiredClasses); err != nil {
 		return fmt.Errorf("não é possível equipar o item: %w", err)
 	}
+	if err := gamedata.ValidateClassItem(c.Class, itemToEquip.Type, itemToEquip.Proficiency); err != nil {
+		return fmt.Errorf("não é possível equipar o item: %w", err)
+	}
+	if !gamedata.IsValidSlot(itemToEquip.Slot) {
+		return fmt.Errorf("não é possível equipar o item: slot de equipamento inválido")
+	}
+	if itemToEquip.TwoHanded && itemToEquip.Slot != gamedata.MainHand {
+		return fmt.Errorf("não é possível equipar o item: armas de duas mãos ocupam a mão principal")
+	}
+
+	slot := c.freeSlotFor(itemToEquip.Slot)
+	displaced := c.slotsToFree(slot, itemToEquip.TwoHanded)
+
+	// O inventário precisa comportar os itens trocados
+	remaining := len(c.Inventory) + len(displaced)
+	if itemToEquip.Quantity <= 1 {
+		remaining--
+	}
+	if remaining > gamedata.MaxInventorySize {
+		return fmt.Errorf("inventário cheio (limite de %d itens)", gamedata.MaxInventorySize)
+	}
 
+	// Só uma unidade é equipada, o restante continua no inventário
+	if itemToEquip.Quantity > 1 {
+		c.Inventory[itemIndex].Quantity--
+	} else {
+		c.Inventory = append(c.Inventory[:itemIndex], c.Inventory[itemIndex+1:]...)
+	}
+	for _, s := range displaced {
+		c.unequipSlot(s)
+	}
+
+	itemToEquip.Quantity = 1
+	itemToEquip.Slot = slot
 	itemToEquip.IsEquipped = true
-	c.Equipment = append(c.Equipment, *itemToEquip)
-	c.Inventory = append(c.Inventory[:itemIndex], c.Inventory[itemIndex+1:]...)
+	c.Equipment = append(c.Equipment, itemToEquip)
 
 	return nil
 }
@@ -239,18 +472,123 @@ func (c *Character) EquipItem(itemName string) error {
 func (c *Character) UnequipItem(itemName string) error {
 	for i, item := range c.Equipment {
 		if item.Name == itemName {
-			// Desequipa o item
-			item.IsEquipped = false
-			c.Inventory = append(c.Inventory, item)
-			c.Equipment = append(c.Equipment[:i], c.Equipment[i+1:]...)
+			if len(c.Inventory) >= gamedata.MaxInventorySize {
+				return fmt.Errorf("inventário cheio (limite de %d itens)", gamedata.MaxInventorySize)
+			}
+			c.unequipAt(i)
 			return nil
 		}
 	}
 	return fmt.Errorf("item não encontrado nos equipamentos")
 }
 
-// AddStatus adiciona um status ao personagem
+// EquippedIn retorna o item equipado no slot ou nil se o slot estiver livre
+func (c *Character) EquippedIn(slot gamedata.EquipmentSlot) *Item {
+	for i := range c.Equipment {
+		if c.Equipment[i].Slot == slot {
+			return &c.Equipment[i]
+		}
+	}
+	return nil
+}
+
+// freeSlotFor escolhe o slot que receberá um item: o primeiro slot compatível
+// livre ou, se todos estiverem ocupados, o preferido
+func (c *Character) freeSlotFor(slot gamedata.EquipmentSlot) gamedata.EquipmentSlot {
+	slots := gamedata.CompatibleSlots(slot)
+	for _, s := range slots {
+		if c.EquippedIn(s) == nil {
+			return s
+		}
+	}
+	return slots[0]
+}
+
+// slotsToFree retorna os slots ocupados que precisam ser liberados para equipar
+// um item no slot informado
+func (c *Character) slotsToFree(slot gamedata.EquipmentSlot, twoHanded bool) []gamedata.EquipmentSlot {
+	var slots []gamedata.EquipmentSlot
+	if c.EquippedIn(slot) != nil {
+		slots = append(slots, slot)
+	}
+	if twoHanded && c.EquippedIn(gamedata.OffHand) != nil {
+		slots = append(slots, gamedata.OffHand)
+	}
+	// Uma arma de duas mãos também bloqueia a mão secundária
+	if slot == gamedata.OffHand {
+		if main := c.EquippedIn(gamedata.MainHand); main != nil && main.TwoHanded {
+			slots = append(slots, gamedata.MainHand)
+		}
+	}
+	return slots
+}
+
+// unequipSlot devolve ao inventário o item equipado no slot
+func (c *Character) unequipSlot(slot gamedata.EquipmentSlot) {
+	for i, item := range c.Equipment {
+		if item.Slot == slot {
+			c.unequipAt(i)
+			return
+		}
+	}
+}
+
+// unequipAt devolve ao inventário o item na posição informada dos equipamentos
+func (c *Character) unequipAt(index int) {
+	item := c.Equipment[index]
+	item.IsEquipped = false
+	c.Inventory = append(c.Inventory, item)
+	c.Equipment = append(c.Equipment[:index], c.Equipment[index+1:]...)
+}
+
+// DerivedStats reúne as estatísticas de combate do personagem somadas às dos itens equipados
+type DerivedStats struct {
+	Armor      int                // Classe de armadura somada à defesa dos equipamentos e às condições
+	Attack     int                // Bônus de ataque somado ao ataque dos equipamentos e às condições
+	SpellPower int                // Poder mágico somado ao dos equipamentos
+	Equipment  gamedata.ItemStats // Soma dos atributos dos itens equipados
+}
+
+// EquipmentStats soma os atributos de todos os itens equipados
+func (c *Character) EquipmentStats() gamedata.ItemStats {
+	var total gamedata.ItemStats
+	for _, item := range c.Equipment {
+		total = total.Add(item.Stats)
+	}
+	return total
+}
+
+// DerivedStats calcula as estatísticas de combate com os bônus dos equipamentos
+func (c *Character) DerivedStats() DerivedStats {
+	equipment := c.EquipmentStats()
+	ruleset := c.Ruleset()
+	attributes := c.EffectiveAttributes()
+
+	armor := c.Combat.Armor
+	if armor == 0 {
+		armor = 10 + c.getDexterityModifier()
+	}
+	// Bônus temporários de destreza também mudam a armadura
+	armor += c.getAttributeModifier(attributes.Dexterity) - c.getDexterityModifier()
+
+	conditions := c.ConditionModifiers(time.Now())
+
+	return DerivedStats{
+		Armor:      armor + equipment.Defense + conditions.Armor,
+		Attack:     gamedata.AttackBonus(ruleset, c.Class, c.Level, &attributes) + equipment.Attack + conditions.Roll,
+		SpellPower: gamedata.SpellPower(ruleset, c.Class, c.Level, &attributes) + equipment.MagicPower,
+		Equipment:  equipment,
+	}
+}
+
+// AddStatus adiciona um status ao personagem. Estados que correspondem a uma
+// condição do catálogo são aplicados como condição, com duração e efeitos
 func (c *Character) AddStatus(status string) {
+	if condition, ok := gamedata.FindCondition(status); ok {
+		_ = c.ApplyCondition(condition.ID, time.Now())
+		return
+	}
+
 	// Verifica se o status já existe
 	for _, s := range c.Status {
 		if s == status {
@@ -260,54 +598,79 @@ func (c *Character) AddStatus(status string) {
 	c.Status = append(c.Status, status)
 }
 
-// RemoveStatus remove um status do personagem
+// RemoveStatus remove um status do personagem, seja ele uma condição do catálogo ou um estado livre
 func (c *Character) RemoveStatus(status string) bool {
+	removed := false
+	if condition, ok := gamedata.FindCondition(status); ok {
+		removed = c.RemoveCondition(condition.ID)
+	}
 	for i, s := range c.Status {
 		if s == status {
 			c.Status = append(c.Status[:i], c.Status[i+1:]...)
 			return true
 		}
 	}
-	return false
+	return removed
 }
 
-// TakeDamage aplica dano ao personagem
+// TakeDamage aplica dano ao personagem. Ao chegar a 0 de vida o personagem cai
+// e passa a fazer testes contra a morte; dano sofrido enquanto caído desfaz a estabilização
 func (c *Character) TakeDamage(damage int) bool {
+	wasUp := c.Combat.Health > 0
 	c.Combat.Health -= damage
 	if c.Combat.Health < 0 {
 		c.Combat.Health = 0
 	}
+	if c.Combat.Health == 0 && damage > 0 {
+		if wasUp {
+			c.Combat.DeathSaves = DeathSaves{}
+		}
+		c.Combat.Stable = false
+	}
 	return c.Combat.Health == 0
 }
 
-// Heal cura o personagem
+// Heal cura o personagem. Qualquer cura levanta um personagem caído, mas não um morto
 func (c *Character) Heal(amount int) {
+	if c.Combat.Dead || amount <= 0 {
+		return
+	}
 	c.Combat.Health += amount
 	if c.Combat.Health > c.Combat.MaxHealth {
 		c.Combat.Health = c.Combat.MaxHealth
 	}
+	if c.Combat.Health > 0 {
+		c.Combat.DeathSaves = DeathSaves{}
+		c.Combat.Stable = false
+	}
 }
 
 // CalculateMaxHealth calcula a vida máxima do personagem
 func (c *Character) CalculateMaxHealth() int {
-	return gamedata.CalculateMaxHealth(c.Level, c.Attributes.Constitution)
+	return c.Ruleset().MaxHealth(c.Class, c.Level, c.Attributes.Constitution)
 }
 
-// InitializeSkills inicializa as perícias do personagem com base na classe
+// InitializeSkills inicializa as perícias do personagem sem proficiências. O
+// jogador escolhe StartingSkillPicks perícias da classe na criação e as demais
+// ao subir de nível
 func (c *Character) InitializeSkills() {
-	// Obter perícias disponíveis para a classe
-	classSkills := gamedata.GetSkillsForClass(c.Class)
+	c.Skills = make([]gamedata.SkillProficiency, 0, len(gamedata.GetSkillsForClass(c.Class)))
+}
 
-	// Inicializar slice de perícias
-	c.Skills = make([]gamedata.SkillProficiency, 0, len(classSkills))
+// StartingSkillPicks retorna quantas perícias de classe são escolhidas na criação
+func (c *Character) StartingSkillPicks() int {
+	return min(gamedata.StartingSkillPicks, len(gamedata.GetSkillsForClass(c.Class)))
+}
 
-	// Adicionar cada perícia com proficiência
-	for _, skill := range classSkills {
-		c.Skills = append(c.Skills, gamedata.SkillProficiency{
-			Skill:        skill,
-			IsProficient: true,
-		})
+// ProficientSkillCount retorna em quantas perícias o personagem tem proficiência
+func (c *Character) ProficientSkillCount() int {
+	count := 0
+	for _, p := range c.Skills {
+		if p.IsProficient {
+			count++
+		}
 	}
+	return count
 }
 
 // GetSkillModifier retorna o modificador total de uma perícia
@@ -321,7 +684,40 @@ func (c *Character) GetSkillModifier(skill gamedata.Skill) int {
 		}
 	}
 
-	return gamedata.CalculateSkillModifier(skill, &c.Attributes, proficiency, c.Level)
+	attributes := c.EffectiveAttributes()
+	return c.Ruleset().SkillModifier(skill, &attributes, proficiency, c.Level) + c.ConditionModifiers(time.Now()).Roll
+}
+
+// GetAttributeCheckModifier retorna o modificador total de um teste de atributo
+func (c *Character) GetAttributeCheckModifier(attribute string) int {
+	attributes := c.EffectiveAttributes()
+	return gamedata.AttributeCheckModifier(attribute, &attributes) + c.ConditionModifiers(time.Now()).Roll
+}
+
+// GetSavingThrowModifier retorna o modificador total de uma salvaguarda, com o
+// bônus de proficiência se a classe for proficiente no atributo
+func (c *Character) GetSavingThrowModifier(attribute string) int {
+	attributes := c.EffectiveAttributes()
+	return c.Ruleset().SavingThrowModifier(c.Class, attribute, &attributes, c.Level) + c.ConditionModifiers(time.Now()).Roll
+}
+
+// HasSavingThrowProficiency verifica se o personagem tem proficiência na salvaguarda do atributo
+func (c *Character) HasSavingThrowProficiency(attribute string) bool {
+	return gamedata.HasSavingThrowProficiency(c.Class, attribute)
+}
+
+// ResolveReference resolve as referências usadas em expressões de dados: um
+// atributo (FOR, DES, sabedoria...) vira o modificador do atributo e uma perícia
+// (stealth, arcana...) vira o modificador da perícia
+func (c *Character) ResolveReference(name string) (int, bool) {
+	if attribute, ok := gamedata.FindAttribute(name); ok {
+		attributes := c.EffectiveAttributes()
+		return gamedata.AttributeModifier(attributes.GetValue(attribute)), true
+	}
+	if skill, ok := gamedata.FindSkill(name); ok {
+		return c.GetSkillModifier(skill), true
+	}
+	return 0, false
 }
 
 // AddSkillProficiency adiciona proficiência em uma perícia
@@ -2,10 +2,12 @@ package entities
 
 import (
 	"testing"
+	"time"
 
 	"sirdraith/internal/domain/gamedata"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewCharacter(t *testing.T) {
@@ -234,6 +236,7 @@ func TestCharacter_EquipItem(t *testing.T) {
 				c.Inventory = []Item{{
 					Name:     "Test Sword",
 					Type:     gamedata.Weapon,
+					Slot:     gamedata.MainHand,
 					Quantity: 1,
 				}}
 				c.Equipment = make([]Item, 0)
@@ -261,9 +264,8 @@ func TestCharacter_EquipItem(t *testing.T) {
 			},
 		},
 		{
-			name: "should fail when equipment slots are full",
+			name: "should fail when item has no slot",
 			setup: func(c *Character) {
-				c.Equipment = make([]Item, gamedata.MaxEquipmentSize)
 				c.Inventory = []Item{{
 					Name:     "Test Item",
 					Type:     gamedata.Weapon,
@@ -274,14 +276,155 @@ func TestCharacter_EquipItem(t *testing.T) {
 			wantErr:  true,
 			assert: func(t *testing.T, c *Character, err error) {
 				assert.Error(t, err)
-				assert.Contains(t, err.Error(), "limite de equipamentos atingido")
+				assert.Contains(t, err.Error(), "slot de equipamento inválido")
+				assert.Empty(t, c.Equipment)
+			},
+		},
+		{
+			name: "should swap the item occupying the slot back to inventory",
+			setup: func(c *Character) {
+				c.Equipment = []Item{{Name: "Old Helmet", Type: gamedata.Armor, Slot: gamedata.Head, Quantity: 1, IsEquipped: true}}
+				c.Inventory = []Item{{Name: "New Helmet", Type: gamedata.Armor, Slot: gamedata.Head, Quantity: 1}}
+			},
+			itemName: "New Helmet",
+			assert: func(t *testing.T, c *Character, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, c.Equipment, 1)
+				assert.Equal(t, "New Helmet", c.EquippedIn(gamedata.Head).Name)
+				assert.Len(t, c.Inventory, 1)
+				assert.Equal(t, "Old Helmet", c.Inventory[0].Name)
+				assert.False(t, c.Inventory[0].IsEquipped)
+			},
+		},
+		{
+			name: "should equip one unit of a stack",
+			setup: func(c *Character) {
+				c.Inventory = []Item{{Name: "Helmet", Type: gamedata.Armor, Slot: gamedata.Head, Quantity: 5}}
+			},
+			itemName: "Helmet",
+			assert: func(t *testing.T, c *Character, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, c.Equipment, 1)
+				assert.Equal(t, 1, c.Equipment[0].Quantity)
+				assert.Equal(t, 4, c.Inventory[0].Quantity)
+			},
+		},
+		{
+			name: "should use the second ring slot when the first is taken",
+			setup: func(c *Character) {
+				c.Class = gamedata.Warrior
+				c.Equipment = []Item{{Name: "Gold Ring", Type: gamedata.Accessory, Slot: gamedata.Ring1, Quantity: 1, IsEquipped: true}}
+				c.Inventory = []Item{{Name: "Silver Ring", Type: gamedata.Accessory, Slot: gamedata.Ring1, Quantity: 1}}
+			},
+			itemName: "Silver Ring",
+			assert: func(t *testing.T, c *Character, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, c.Equipment, 2)
+				assert.Equal(t, "Gold Ring", c.EquippedIn(gamedata.Ring1).Name)
+				assert.Equal(t, "Silver Ring", c.EquippedIn(gamedata.Ring2).Name)
+				assert.Empty(t, c.Inventory)
+			},
+		},
+		{
+			name: "should free both hands for a two-handed weapon",
+			setup: func(c *Character) {
+				c.Equipment = []Item{
+					{Name: "Sword", Type: gamedata.Weapon, Slot: gamedata.MainHand, Quantity: 1, IsEquipped: true},
+					{Name: "Shield", Type: gamedata.Armor, Slot: gamedata.OffHand, Quantity: 1, IsEquipped: true},
+				}
+				c.Inventory = []Item{{Name: "Greatsword", Type: gamedata.Weapon, Slot: gamedata.MainHand, TwoHanded: true, Quantity: 1}}
+			},
+			itemName: "Greatsword",
+			assert: func(t *testing.T, c *Character, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, c.Equipment, 1)
+				assert.Equal(t, "Greatsword", c.EquippedIn(gamedata.MainHand).Name)
+				assert.Nil(t, c.EquippedIn(gamedata.OffHand))
+				assert.Len(t, c.Inventory, 2)
+			},
+		},
+		{
+			name: "should unequip a two-handed weapon when equipping the off hand",
+			setup: func(c *Character) {
+				c.Equipment = []Item{{Name: "Greatsword", Type: gamedata.Weapon, Slot: gamedata.MainHand, TwoHanded: true, Quantity: 1, IsEquipped: true}}
+				c.Inventory = []Item{{Name: "Shield", Type: gamedata.Armor, Slot: gamedata.OffHand, Quantity: 1}}
+			},
+			itemName: "Shield",
+			assert: func(t *testing.T, c *Character, err error) {
+				assert.NoError(t, err)
+				assert.Nil(t, c.EquippedIn(gamedata.MainHand))
+				assert.Equal(t, "Shield", c.EquippedIn(gamedata.OffHand).Name)
+				assert.Equal(t, "Greatsword", c.Inventory[0].Name)
+			},
+		},
+		{
+			name: "should fail when a two-handed weapon is not for the main hand",
+			setup: func(c *Character) {
+				c.Inventory = []Item{{Name: "Odd Bow", Type: gamedata.Weapon, Slot: gamedata.OffHand, TwoHanded: true, Quantity: 1}}
+			},
+			itemName: "Odd Bow",
+			wantErr:  true,
+			assert: func(t *testing.T, c *Character, err error) {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "duas mãos")
+			},
+		},
+		{
+			name: "should fail when class cannot use the item type",
+			setup: func(c *Character) {
+				c.Class = gamedata.Mage
+				c.Inventory = []Item{{Name: "Robe of Iron", Type: gamedata.Armor, Slot: gamedata.Chest, Quantity: 1}}
+			},
+			itemName: "Robe of Iron",
+			wantErr:  true,
+			assert: func(t *testing.T, c *Character, err error) {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "não pode usar equipamentos do tipo armor")
+				assert.Empty(t, c.Equipment)
+			},
+		},
+		{
+			name: "should fail when class lacks the proficiency tier",
+			setup: func(c *Character) {
+				c.Class = gamedata.Rogue
+				c.Inventory = []Item{{Name: "Plate", Type: gamedata.Armor, Slot: gamedata.Chest, Proficiency: gamedata.HeavyArmor, Quantity: 1}}
+			},
+			itemName: "Plate",
+			wantErr:  true,
+			assert: func(t *testing.T, c *Character, err error) {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "não tem proficiência em armaduras pesadas")
+			},
+		},
+		{
+			name: "should equip when class has the proficiency tier",
+			setup: func(c *Character) {
+				c.Class = gamedata.Rogue
+				c.Inventory = []Item{{Name: "Leather", Type: gamedata.Armor, Slot: gamedata.Chest, Proficiency: gamedata.LightArmor, Quantity: 1}}
+			},
+			itemName: "Leather",
+			assert: func(t *testing.T, c *Character, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "Leather", c.EquippedIn(gamedata.Chest).Name)
+			},
+		},
+		{
+			name: "should fail when item is restricted to other classes",
+			setup: func(c *Character) {
+				c.Inventory = []Item{{Name: "Holy Mace", Type: gamedata.Weapon, Slot: gamedata.MainHand, RequiredClasses: []gamedata.CharacterClass{gamedata.Cleric}, Quantity: 1}}
+			},
+			itemName: "Holy Mace",
+			wantErr:  true,
+			assert: func(t *testing.T, c *Character, err error) {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "não pode equipar este item")
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			char := &Character{}
+			char := &Character{Level: 1, Class: gamedata.Warrior}
 			if tt.setup != nil {
 				tt.setup(char)
 			}
@@ -347,6 +490,30 @@ func TestCharacter_UnequipItem(t *testing.T) {
 	}
 }
 
+func TestCharacter_DerivedStats(t *testing.T) {
+	char := NewCharacter("user", "guild", "Merlin", string(gamedata.Mage))
+	char.Attributes = gamedata.Attributes{Strength: 8, Dexterity: 14, Constitution: 10, Intelligence: 16, Wisdom: 12, Charisma: 10}
+	char.refreshCombat()
+
+	base := char.DerivedStats()
+	assert.Equal(t, 12, base.Armor, "10 plus dexterity 14")
+	assert.Equal(t, 2+3, base.Attack, "proficiency 2 plus intelligence 16")
+	assert.Equal(t, 2+3, base.SpellPower, "proficiency 2 plus intelligence 16")
+	assert.Equal(t, gamedata.ItemStats{}, base.Equipment)
+
+	char.Equipment = []Item{
+		{Name: "Cajado", Type: gamedata.Weapon, Slot: gamedata.MainHand, Stats: gamedata.ItemStats{Attack: 1, MagicPower: 3}, IsEquipped: true},
+		{Name: "Manto", Type: gamedata.Armor, Slot: gamedata.Chest, Stats: gamedata.ItemStats{Defense: 2}, IsEquipped: true},
+		{Name: "Anel", Type: gamedata.Accessory, Slot: gamedata.Ring1, Stats: gamedata.ItemStats{Defense: 1, MagicPower: 1}, IsEquipped: true},
+	}
+
+	stats := char.DerivedStats()
+	assert.Equal(t, gamedata.ItemStats{Attack: 1, Defense: 3, MagicPower: 4}, stats.Equipment)
+	assert.Equal(t, base.Armor+3, stats.Armor)
+	assert.Equal(t, base.Attack+1, stats.Attack)
+	assert.Equal(t, base.SpellPower+4, stats.SpellPower)
+}
+
 func TestCharacter_Combat(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -412,22 +579,22 @@ func TestCharacter_Status(t *testing.T) {
 			},
 			assert: func(t *testing.T, c *Character) {
 				// Add status
-				c.AddStatus("Envenenado")
-				assert.Contains(t, c.Status, "Envenenado")
+				c.AddStatus("Faminto")
+				assert.Contains(t, c.Status, "Faminto")
 				assert.Len(t, c.Status, 1)
 
 				// Add duplicate status
-				c.AddStatus("Envenenado")
+				c.AddStatus("Faminto")
 				assert.Len(t, c.Status, 1)
 
 				// Add different status
-				c.AddStatus("Atordoado")
+				c.AddStatus("Amaldiçoado")
 				assert.Len(t, c.Status, 2)
 
 				// Remove status
-				removed := c.RemoveStatus("Envenenado")
+				removed := c.RemoveStatus("Faminto")
 				assert.True(t, removed)
-				assert.NotContains(t, c.Status, "Envenenado")
+				assert.NotContains(t, c.Status, "Faminto")
 				assert.Len(t, c.Status, 1)
 
 				// Remove nonexistent status
@@ -436,6 +603,24 @@ func TestCharacter_Status(t *testing.T) {
 				assert.Len(t, c.Status, 1)
 			},
 		},
+		{
+			name: "should apply catalog statuses as conditions",
+			setup: func(c *Character) {
+				c.Status = make([]string, 0)
+			},
+			assert: func(t *testing.T, c *Character) {
+				c.AddStatus("Envenenado")
+				assert.Empty(t, c.Status)
+				assert.True(t, c.HasCondition(gamedata.Poisoned, time.Now()))
+
+				c.AddStatus("stunned")
+				assert.Len(t, c.Conditions, 2)
+
+				assert.True(t, c.RemoveStatus("envenenado"))
+				assert.False(t, c.HasCondition(gamedata.Poisoned, time.Now()))
+				assert.False(t, c.RemoveStatus("Envenenado"))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -490,3 +675,345 @@ func TestCharacter_AttributeModifiers(t *testing.T) {
 		})
 	}
 }
+
+func TestCharacter_LevelUpChoices(t *testing.T) {
+	tests := []struct {
+		name       string
+		skills     []gamedata.Skill
+		level      int
+		exp        int
+		wantPoints int
+		wantPicks  int
+	}{
+		{
+			name:       "should grant nothing on regular levels",
+			skills:     []gamedata.Skill{gamedata.Athletics},
+			level:      1,
+			exp:        100,
+			wantPoints: 0,
+			wantPicks:  0,
+		},
+		{
+			name:       "should grant a skill pick at level 3",
+			skills:     []gamedata.Skill{gamedata.Athletics},
+			level:      2,
+			exp:        300,
+			wantPoints: 0,
+			wantPicks:  1,
+		},
+		{
+			name:       "should grant ability points at level 4",
+			skills:     []gamedata.Skill{gamedata.Athletics},
+			level:      3,
+			exp:        400,
+			wantPoints: gamedata.AbilityPointsPerImprovement,
+			wantPicks:  0,
+		},
+		{
+			name:       "should accumulate choices across several levels",
+			skills:     []gamedata.Skill{gamedata.Athletics},
+			level:      2,
+			exp:        400,
+			wantPoints: gamedata.AbilityPointsPerImprovement,
+			wantPicks:  1,
+		},
+		{
+			name:       "should skip skill picks when every class skill is known",
+			skills:     gamedata.GetSkillsForClass(gamedata.Warrior),
+			level:      2,
+			exp:        300,
+			wantPoints: 0,
+			wantPicks:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("user", "guild", "Sir Test", string(gamedata.Warrior))
+			c.Level = tt.level
+			c.Experience = c.Ruleset().ExpForLevel(tt.level)
+			c.Skills = nil
+			for _, skill := range tt.skills {
+				assert.NoError(t, c.AddSkillProficiency(skill))
+			}
+
+			assert.NoError(t, c.AddExperience(tt.exp-c.Experience))
+			assert.Equal(t, tt.wantPoints, c.PendingAbilityPoints())
+			assert.Equal(t, tt.wantPicks, c.PendingSkillPicks())
+			assert.Equal(t, tt.wantPoints > 0 || tt.wantPicks > 0, c.HasPendingChoices())
+		})
+	}
+}
+
+func TestCharacter_InitializeSkills(t *testing.T) {
+	c := NewCharacter("user", "guild", "Sir Test", string(gamedata.Warrior))
+	c.InitializeSkills()
+	assert.Zero(t, c.ProficientSkillCount())
+	assert.Equal(t, gamedata.StartingSkillPicks, c.StartingSkillPicks())
+
+	classSkills := gamedata.GetSkillsForClass(gamedata.Warrior)
+	for _, skill := range classSkills[:c.StartingSkillPicks()] {
+		assert.NoError(t, c.AddSkillProficiency(skill))
+	}
+
+	// As perícias não escolhidas na criação ficam para a evolução de nível
+	assert.NoError(t, c.AddExperience(c.Ruleset().ExpForLevel(3)))
+	assert.Equal(t, 1, c.PendingSkillPicks())
+	assert.Len(t, c.AvailableSkillChoices(), len(classSkills)-gamedata.StartingSkillPicks)
+}
+
+func TestCharacter_SpendAbilityPoint(t *testing.T) {
+	tests := []struct {
+		name       string
+		attribute  string
+		value      int
+		points     int
+		wantErr    bool
+		wantValue  int
+		wantPoints int
+	}{
+		{
+			name:       "should raise the attribute and consume the point",
+			attribute:  "strength",
+			value:      14,
+			points:     2,
+			wantValue:  15,
+			wantPoints: 1,
+		},
+		{
+			name:       "should remove the choice when the last point is spent",
+			attribute:  "strength",
+			value:      14,
+			points:     1,
+			wantValue:  15,
+			wantPoints: 0,
+		},
+		{
+			name:       "should fail without pending points",
+			attribute:  "strength",
+			value:      14,
+			points:     0,
+			wantErr:    true,
+			wantValue:  14,
+			wantPoints: 0,
+		},
+		{
+			name:       "should fail above the maximum value",
+			attribute:  "strength",
+			value:      gamedata.MaxAttributeValue,
+			points:     2,
+			wantErr:    true,
+			wantValue:  gamedata.MaxAttributeValue,
+			wantPoints: 2,
+		},
+		{
+			name:       "should fail for unknown attributes",
+			attribute:  "luck",
+			value:      14,
+			points:     2,
+			wantErr:    true,
+			wantValue:  14,
+			wantPoints: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("user", "guild", "Sir Test", string(gamedata.Warrior))
+			c.Attributes.Strength = tt.value
+			if tt.points > 0 {
+				c.PendingChoices = []LevelUpChoice{{Level: 4, AbilityPoints: tt.points}}
+			}
+
+			err := c.SpendAbilityPoint(tt.attribute)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantValue, c.Attributes.Strength)
+			assert.Equal(t, tt.wantPoints, c.PendingAbilityPoints())
+			assert.Equal(t, tt.wantPoints > 0, c.HasPendingChoices())
+		})
+	}
+}
+
+func TestCharacter_SpendAbilityPoint_KeepsDamage(t *testing.T) {
+	c := NewCharacter("user", "guild", "Sir Test", string(gamedata.Warrior))
+	c.Level = 4
+	c.Attributes.Constitution = 13
+	c.updateCombatConfig()
+	c.Combat.Health = c.Combat.MaxHealth - 5
+	c.PendingChoices = []LevelUpChoice{{Level: 4, AbilityPoints: 1}}
+
+	assert.NoError(t, c.SpendAbilityPoint("constitution"))
+	assert.Equal(t, c.Ruleset().MaxHealth(gamedata.Warrior, 4, 14), c.Combat.MaxHealth)
+	assert.Equal(t, c.Combat.MaxHealth-5, c.Combat.Health)
+}
+
+func TestCharacter_ChooseSkillProficiency(t *testing.T) {
+	tests := []struct {
+		name      string
+		skill     gamedata.Skill
+		picks     int
+		wantErr   bool
+		wantPicks int
+	}{
+		{
+			name:      "should add the class skill and consume the pick",
+			skill:     gamedata.Perception,
+			picks:     1,
+			wantPicks: 0,
+		},
+		{
+			name:      "should fail without pending picks",
+			skill:     gamedata.Perception,
+			picks:     0,
+			wantErr:   true,
+			wantPicks: 0,
+		},
+		{
+			name:      "should fail for skills of other classes",
+			skill:     gamedata.Arcana,
+			picks:     1,
+			wantErr:   true,
+			wantPicks: 1,
+		},
+		{
+			name:      "should fail for skills already known",
+			skill:     gamedata.Athletics,
+			picks:     1,
+			wantErr:   true,
+			wantPicks: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("user", "guild", "Sir Test", string(gamedata.Warrior))
+			c.Skills = []gamedata.SkillProficiency{{Skill: gamedata.Athletics, IsProficient: true}}
+			if tt.picks > 0 {
+				c.PendingChoices = []LevelUpChoice{{Level: 3, SkillPicks: tt.picks}}
+			}
+
+			err := c.ChooseSkillProficiency(tt.skill)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, c.isProficient(tt.skill))
+			}
+			assert.Equal(t, tt.wantPicks, c.PendingSkillPicks())
+		})
+	}
+}
+
+func TestCharacter_ApplyProgression(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(*Character)
+		ruleset     string
+		wantChanged bool
+		wantExp     int
+		wantHealth  int
+		wantMax     int
+	}{
+		{
+			name: "should keep a character already on the ruleset",
+			setup: func(c *Character) {
+				assert.NoError(t, c.AddExperience(250))
+			},
+			ruleset:     gamedata.DefaultProgressionID,
+			wantChanged: false,
+			wantExp:     250,
+			wantHealth:  16, // d10 + 6 at level 2
+			wantMax:     16,
+		},
+		{
+			name: "should keep the level and fit the experience into the new table",
+			setup: func(c *Character) {
+				assert.NoError(t, c.AddExperience(250))
+				c.Combat.Health -= 4
+			},
+			ruleset:     "epico",
+			wantChanged: true,
+			wantExp:     149, // level 3 starts at 150 on the epic table
+			wantHealth:  16,  // keeps the 4 damage taken
+			wantMax:     20,  // 2 * d10
+		},
+		{
+			name: "should heal characters without combat stats",
+			setup: func(c *Character) {
+				c.Combat = Combat{}
+			},
+			ruleset:     gamedata.DefaultProgressionID,
+			wantChanged: true,
+			wantExp:     0,
+			wantHealth:  10,
+			wantMax:     10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("user", "guild", "Sir Test", string(gamedata.Warrior))
+			c.Attributes = gamedata.Attributes{Strength: 10, Dexterity: 10, Constitution: 10, Intelligence: 10, Wisdom: 10, Charisma: 10}
+			c.ApplyProgression(gamedata.DefaultProgressionID)
+			tt.setup(c)
+			level := c.Level
+
+			assert.Equal(t, tt.wantChanged, c.ApplyProgression(tt.ruleset))
+			assert.Equal(t, tt.ruleset, c.Progression)
+			assert.Equal(t, level, c.Level)
+			assert.Equal(t, tt.wantExp, c.Experience)
+			assert.Equal(t, tt.wantHealth, c.Combat.Health)
+			assert.Equal(t, tt.wantMax, c.Combat.MaxHealth)
+		})
+	}
+}
+
+func TestCharacter_ResolveReference(t *testing.T) {
+	char := NewCharacter("user", "guild", "Aria", string(gamedata.Rogue))
+	char.Attributes = gamedata.Attributes{Strength: 8, Dexterity: 16, Constitution: 12, Intelligence: 10, Wisdom: 13, Charisma: 14}
+	char.Skills = []gamedata.SkillProficiency{{Skill: gamedata.Stealth, IsProficient: true}}
+
+	tests := []struct {
+		reference string
+		want      int
+		wantOK    bool
+	}{
+		{reference: "FOR", want: -1, wantOK: true},
+		{reference: "dex", want: 3, wantOK: true},
+		{reference: "Sabedoria", want: 1, wantOK: true},
+		{reference: "charisma", want: 2, wantOK: true},
+		{reference: "stealth", want: 3 + 2, wantOK: true},
+		{reference: "Arcana", want: 0, wantOK: true},
+		{reference: "magia", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			got, ok := char.ResolveReference(tt.reference)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCharacter_CheckAndSaveModifiers(t *testing.T) {
+	now := time.Now()
+	char := NewCharacter("user", "guild", "Aria", string(gamedata.Rogue))
+	char.Attributes = gamedata.Attributes{Strength: 8, Dexterity: 16, Constitution: 12, Intelligence: 14, Wisdom: 13, Charisma: 10}
+	char.Level = 5
+
+	assert.Equal(t, 3, char.GetAttributeCheckModifier("dexterity"))
+	assert.Equal(t, 3+3, char.GetSavingThrowModifier("dexterity"), "rogues are proficient in dexterity saves")
+	assert.Equal(t, 2+3, char.GetSavingThrowModifier("intelligence"))
+	assert.Equal(t, -1, char.GetSavingThrowModifier("strength"))
+	assert.True(t, char.HasSavingThrowProficiency("intelligence"))
+	assert.False(t, char.HasSavingThrowProficiency("wisdom"))
+
+	require.NoError(t, char.ApplyCondition(gamedata.Blessed, now))
+	assert.Equal(t, 3+2, char.GetAttributeCheckModifier("dexterity"), "conditions modify checks")
+	assert.Equal(t, 3+3+2, char.GetSavingThrowModifier("dexterity"), "conditions modify saves")
+}
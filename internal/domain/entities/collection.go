@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrCardNotOwned indicates a character does not own enough copies of a card
+var ErrCardNotOwned = errors.New("card not owned in sufficient quantity")
+
+// CardCollection represents the cards owned by a character
+type CardCollection struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	CharacterID string             `bson:"character_id"`
+	UserID      string             `bson:"user_id"`
+	GuildID     string             `bson:"guild_id"`
+	Cards       map[string]int     `bson:"cards"` // Map of card IDs to owned copies
+	CreatedAt   time.Time          `bson:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at"`
+}
+
+// NewCardCollection creates an empty collection for a character
+func NewCardCollection(character *Character) *CardCollection {
+	now := time.Now()
+	return &CardCollection{
+		ID:          primitive.NewObjectID(),
+		CharacterID: character.ID.Hex(),
+		UserID:      character.UserID,
+		GuildID:     character.GuildID,
+		Cards:       make(map[string]int),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// AddCards adds one copy of each given card to the collection
+func (c *CardCollection) AddCards(cards ...*Card) {
+	if c.Cards == nil {
+		c.Cards = make(map[string]int)
+	}
+	for _, card := range cards {
+		c.Cards[card.ID]++
+	}
+	c.UpdatedAt = time.Now()
+}
+
+// Owned returns how many copies of a card are in the collection
+func (c *CardCollection) Owned(cardID string) int {
+	if c == nil {
+		return 0
+	}
+	return c.Cards[cardID]
+}
+
+// CheckOwnership verifies the collection holds at least quantity copies of a card
+func (c *CardCollection) CheckOwnership(cardID string, quantity int) error {
+	if owned := c.Owned(cardID); owned < quantity {
+		return fmt.Errorf("%w: %s (%d/%d)", ErrCardNotOwned, cardID, owned, quantity)
+	}
+	return nil
+}
+
+// GetCardCount returns the total number of cards in the collection
+func (c *CardCollection) GetCardCount() int {
+	total := 0
+	for _, quantity := range c.Cards {
+		total += quantity
+	}
+	return total
+}
@@ -0,0 +1,76 @@
+package entities
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCardCollection_CheckOwnership(t *testing.T) {
+	character := NewCharacter("user", "guild", "Aria", "mage")
+	character.ID = primitive.NewObjectID()
+	collection := NewCardCollection(character)
+	soldier := testCard("soldado", CardRarityCommon, "")
+	collection.AddCards(soldier, soldier)
+
+	assert.Equal(t, character.ID.Hex(), collection.CharacterID)
+	assert.NoError(t, collection.CheckOwnership("soldado", 2))
+	assert.ErrorIs(t, collection.CheckOwnership("soldado", 3), ErrCardNotOwned)
+	assert.ErrorIs(t, collection.CheckOwnership("dragao", 1), ErrCardNotOwned)
+
+	var missing *CardCollection
+	assert.ErrorIs(t, missing.CheckOwnership("soldado", 1), ErrCardNotOwned)
+}
+
+func TestBoosterPack_Open(t *testing.T) {
+	pool := []*Card{
+		testCard("soldado", CardRarityCommon, ""),
+		testCard("arqueiro", CardRarityCommon, ""),
+		testCard("cavaleiro", CardRarityRare, ""),
+		testCard("dragao", CardRarityLegendary, ""),
+	}
+
+	t.Run("should draw pack size cards deterministically", func(t *testing.T) {
+		pack := BoosterPacks["basico"]
+		first, firstRolls, err := pack.Open(pool, rand.New(rand.NewSource(7)))
+		require.NoError(t, err)
+		second, secondRolls, err := pack.Open(pool, rand.New(rand.NewSource(7)))
+		require.NoError(t, err)
+
+		assert.Len(t, first, pack.Size)
+		assert.Len(t, firstRolls, pack.Size)
+		assert.Equal(t, first, second)
+		assert.Equal(t, firstRolls, secondRolls)
+	})
+
+	t.Run("should weight draws by rarity", func(t *testing.T) {
+		pack := &BoosterPack{Size: 10000, Weights: map[CardRarity]int{CardRarityCommon: 90, CardRarityLegendary: 10}}
+		cards, _, err := pack.Open(pool, rand.New(rand.NewSource(3)))
+		require.NoError(t, err)
+
+		counts := make(map[CardRarity]int)
+		for _, card := range cards {
+			counts[card.Rarity]++
+		}
+		assert.Zero(t, counts[CardRarityRare], "rarity without weight is never drawn")
+		assert.InDelta(t, 9000, counts[CardRarityCommon], 300)
+		assert.InDelta(t, 1000, counts[CardRarityLegendary], 300)
+	})
+
+	t.Run("should ignore rarities missing from the pool", func(t *testing.T) {
+		commons := pool[:2]
+		cards, _, err := BoosterPacks["premium"].Open(commons, rand.New(rand.NewSource(1)))
+		require.NoError(t, err)
+		for _, card := range cards {
+			assert.Equal(t, CardRarityCommon, card.Rarity)
+		}
+	})
+
+	t.Run("should fail with empty pool", func(t *testing.T) {
+		_, _, err := BoosterPacks["basico"].Open(nil, rand.New(rand.NewSource(1)))
+		assert.ErrorIs(t, err, ErrEmptyCardPool)
+	})
+}
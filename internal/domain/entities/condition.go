@@ -0,0 +1,193 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"sirdraith/internal/domain/gamedata"
+)
+
+// ActiveCondition é uma condição do catálogo aplicada ao personagem
+type ActiveCondition struct {
+	ID             gamedata.ConditionID `bson:"id"`
+	Stacks         int                  `bson:"stacks"`          // Acúmulos atuais
+	RemainingTurns int                  `bson:"remaining_turns"` // Turnos de combate restantes, 0 se não contar turnos
+	ExpiresAt      time.Time            `bson:"expires_at"`      // Momento em que a condição acaba
+}
+
+// Definition retorna a definição da condição no catálogo
+func (a ActiveCondition) Definition() *gamedata.Condition {
+	condition, _ := gamedata.GetCondition(a.ID)
+	return condition
+}
+
+// IsActive indica se a condição ainda vale no momento informado
+func (a ActiveCondition) IsActive(now time.Time) bool {
+	return a.Definition() != nil && now.Before(a.ExpiresAt)
+}
+
+// ConditionModifiers reúne os modificadores das condições ativas
+type ConditionModifiers struct {
+	Roll      int  // Somado aos testes de perícia e às rolagens de ataque
+	Armor     int  // Somado à classe de armadura
+	SkipsTurn bool // Se o personagem perde o turno
+}
+
+// ConditionTick descreve os efeitos das condições no início de um turno
+type ConditionTick struct {
+	Damage   int                // Dano sofrido
+	Healed   int                // Vida recuperada
+	Expired  []ActiveCondition  // Condições que acabaram
+	SkipTurn bool               // Se o personagem perde o turno
+	Defeated bool               // Se o dano derrubou o personagem
+	Modifier ConditionModifiers // Modificadores que valiam no início do turno
+}
+
+// ApplyCondition aplica uma condição do catálogo seguindo sua regra de acúmulo
+func (c *Character) ApplyCondition(id gamedata.ConditionID, now time.Time) error {
+	condition, ok := gamedata.GetCondition(id)
+	if !ok {
+		return fmt.Errorf("condição desconhecida: %s", id)
+	}
+
+	c.ExpireConditions(now)
+	applied := ActiveCondition{
+		ID:             id,
+		Stacks:         1,
+		RemainingTurns: condition.Turns,
+		ExpiresAt:      now.Add(condition.Duration),
+	}
+	for i, active := range c.Conditions {
+		if active.ID != id {
+			continue
+		}
+		if condition.Stacking == gamedata.StackIntensity {
+			applied.Stacks = min(active.Stacks+1, max(condition.MaxStacks, 1))
+		}
+		c.Conditions[i] = applied
+		return nil
+	}
+	c.Conditions = append(c.Conditions, applied)
+	return nil
+}
+
+// RemoveCondition remove a condição do personagem
+func (c *Character) RemoveCondition(id gamedata.ConditionID) bool {
+	for i, active := range c.Conditions {
+		if active.ID == id {
+			c.Conditions = append(c.Conditions[:i], c.Conditions[i+1:]...)
+			if len(c.Conditions) == 0 {
+				c.Conditions = nil
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// HasCondition indica se a condição está ativa no momento informado
+func (c *Character) HasCondition(id gamedata.ConditionID, now time.Time) bool {
+	for _, active := range c.Conditions {
+		if active.ID == id && active.IsActive(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// ActiveConditions retorna as condições que ainda valem no momento informado
+func (c *Character) ActiveConditions(now time.Time) []ActiveCondition {
+	var active []ActiveCondition
+	for _, condition := range c.Conditions {
+		if condition.IsActive(now) {
+			active = append(active, condition)
+		}
+	}
+	return active
+}
+
+// ExpireConditions remove as condições cujo tempo acabou e as retorna
+func (c *Character) ExpireConditions(now time.Time) []ActiveCondition {
+	var expired []ActiveCondition
+	remaining := c.Conditions[:0]
+	for _, condition := range c.Conditions {
+		if condition.IsActive(now) {
+			remaining = append(remaining, condition)
+		} else {
+			expired = append(expired, condition)
+		}
+	}
+	c.Conditions = remaining
+	if len(c.Conditions) == 0 {
+		c.Conditions = nil
+	}
+	return expired
+}
+
+// ConditionModifiers soma os modificadores das condições ativas no momento informado
+func (c *Character) ConditionModifiers(now time.Time) ConditionModifiers {
+	var modifiers ConditionModifiers
+	for _, active := range c.ActiveConditions(now) {
+		condition := active.Definition()
+		modifiers.Roll += condition.RollModifier * active.Stacks
+		modifiers.Armor += condition.ArmorModifier * active.Stacks
+		modifiers.SkipsTurn = modifiers.SkipsTurn || condition.SkipsTurn
+	}
+	return modifiers
+}
+
+// TickConditions aplica o dano e a cura das condições no início de um turno de
+// combate, consome um turno de cada condição e remove as que acabaram
+func (c *Character) TickConditions(now time.Time) ConditionTick {
+	tick := ConditionTick{Expired: c.ExpireConditions(now)}
+	tick.Modifier = c.ConditionModifiers(now)
+	tick.SkipTurn = tick.Modifier.SkipsTurn
+
+	damage, heal := 0, 0
+	remaining := c.Conditions[:0]
+	for _, active := range c.Conditions {
+		condition := active.Definition()
+		damage += condition.TickDamage * active.Stacks
+		heal += condition.TickHeal * active.Stacks
+
+		if active.RemainingTurns > 0 {
+			active.RemainingTurns--
+			if active.RemainingTurns == 0 {
+				tick.Expired = append(tick.Expired, active)
+				continue
+			}
+		}
+		remaining = append(remaining, active)
+	}
+	c.Conditions = remaining
+	if len(c.Conditions) == 0 {
+		c.Conditions = nil
+	}
+
+	if heal > 0 && c.Combat.Health > 0 {
+		before := c.Combat.Health
+		c.Heal(heal)
+		tick.Healed = c.Combat.Health - before
+	}
+	if damage > 0 {
+		before := c.Combat.Health
+		tick.Defeated = c.TakeDamage(damage)
+		tick.Damage = before - c.Combat.Health
+	}
+	return tick
+}
+
+// StatusNames lista os estados do personagem: as condições ativas, com seus
+// acúmulos, seguidas dos estados livres
+func (c *Character) StatusNames(now time.Time) []string {
+	var names []string
+	for _, active := range c.ActiveConditions(now) {
+		condition := active.Definition()
+		name := fmt.Sprintf("%s %s", condition.Emoji, condition.Name)
+		if active.Stacks > 1 {
+			name = fmt.Sprintf("%s x%d", name, active.Stacks)
+		}
+		names = append(names, name)
+	}
+	return append(names, c.Status...)
+}
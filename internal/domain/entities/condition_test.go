@@ -0,0 +1,133 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"sirdraith/internal/domain/gamedata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCharacter_ApplyCondition(t *testing.T) {
+	now := time.Now()
+
+	t.Run("intensity conditions stack up to the maximum", func(t *testing.T) {
+		char := newConditionTestCharacter()
+		for i := 0; i < 5; i++ {
+			require.NoError(t, char.ApplyCondition(gamedata.Poisoned, now))
+		}
+
+		require.Len(t, char.Conditions, 1)
+		assert.Equal(t, 3, char.Conditions[0].Stacks)
+		assert.Equal(t, -3, char.ConditionModifiers(now).Roll)
+	})
+
+	t.Run("refresh conditions renew the duration without stacking", func(t *testing.T) {
+		char := newConditionTestCharacter()
+		require.NoError(t, char.ApplyCondition(gamedata.Blessed, now))
+		require.NoError(t, char.ApplyCondition(gamedata.Blessed, now.Add(time.Minute)))
+
+		require.Len(t, char.Conditions, 1)
+		assert.Equal(t, 1, char.Conditions[0].Stacks)
+		assert.Equal(t, now.Add(time.Minute+time.Hour), char.Conditions[0].ExpiresAt)
+	})
+
+	t.Run("unknown conditions are rejected", func(t *testing.T) {
+		char := newConditionTestCharacter()
+		assert.Error(t, char.ApplyCondition("cursed", now))
+		assert.Empty(t, char.Conditions)
+	})
+
+	t.Run("conditions expire on a timer", func(t *testing.T) {
+		char := newConditionTestCharacter()
+		require.NoError(t, char.ApplyCondition(gamedata.Stunned, now))
+
+		assert.True(t, char.HasCondition(gamedata.Stunned, now))
+		assert.False(t, char.HasCondition(gamedata.Stunned, now.Add(time.Minute)))
+		expired := char.ExpireConditions(now.Add(time.Minute))
+		assert.Len(t, expired, 1)
+		assert.Nil(t, char.Conditions)
+	})
+}
+
+func TestCharacter_ConditionModifiers(t *testing.T) {
+	char := newConditionTestCharacter()
+	athletics := char.GetSkillModifier(gamedata.Athletics)
+	stats := char.DerivedStats()
+
+	now := time.Now()
+	require.NoError(t, char.ApplyCondition(gamedata.Blessed, now))
+	require.NoError(t, char.ApplyCondition(gamedata.Stunned, now))
+
+	assert.Equal(t, ConditionModifiers{Roll: 2, Armor: -2, SkipsTurn: true}, char.ConditionModifiers(now))
+	assert.Equal(t, athletics+2, char.GetSkillModifier(gamedata.Athletics))
+	assert.Equal(t, stats.Attack+2, char.DerivedStats().Attack)
+	assert.Equal(t, stats.Armor-2, char.DerivedStats().Armor)
+}
+
+func TestCharacter_TickConditions(t *testing.T) {
+	now := time.Now()
+
+	t.Run("applies damage per stack and counts turns", func(t *testing.T) {
+		char := newConditionTestCharacter()
+		health := char.Combat.Health
+		require.NoError(t, char.ApplyCondition(gamedata.Poisoned, now))
+		require.NoError(t, char.ApplyCondition(gamedata.Poisoned, now))
+
+		for turn := 1; turn <= 2; turn++ {
+			tick := char.TickConditions(now)
+			assert.Equal(t, 2, tick.Damage)
+			assert.Empty(t, tick.Expired)
+		}
+		tick := char.TickConditions(now)
+		assert.Equal(t, 2, tick.Damage)
+		require.Len(t, tick.Expired, 1)
+		assert.Equal(t, gamedata.Poisoned, tick.Expired[0].ID)
+		assert.Equal(t, health-6, char.Combat.Health)
+		assert.Nil(t, char.Conditions)
+	})
+
+	t.Run("heals and skips turns", func(t *testing.T) {
+		char := newConditionTestCharacter()
+		char.TakeDamage(5)
+		require.NoError(t, char.ApplyCondition(gamedata.Regenerating, now))
+		require.NoError(t, char.ApplyCondition(gamedata.Stunned, now))
+
+		tick := char.TickConditions(now)
+		assert.Equal(t, 2, tick.Healed)
+		assert.True(t, tick.SkipTurn)
+		assert.False(t, char.HasCondition(gamedata.Stunned, now), "stun lasts a single turn")
+
+		tick = char.TickConditions(now)
+		assert.False(t, tick.SkipTurn)
+	})
+
+	t.Run("reports when the damage defeats the character", func(t *testing.T) {
+		char := newConditionTestCharacter()
+		char.Combat.Health = 1
+		require.NoError(t, char.ApplyCondition(gamedata.Wounded, now))
+
+		tick := char.TickConditions(now)
+		assert.True(t, tick.Defeated)
+		assert.Equal(t, 1, tick.Damage)
+	})
+
+	t.Run("exhaustion does not count turns", func(t *testing.T) {
+		char := newConditionTestCharacter()
+		require.NoError(t, char.ApplyCondition(gamedata.Exhausted, now))
+		for i := 0; i < 20; i++ {
+			char.TickConditions(now)
+		}
+		assert.True(t, char.HasCondition(gamedata.Exhausted, now))
+	})
+}
+
+// newConditionTestCharacter cria um guerreiro com atributos base e vida cheia
+func newConditionTestCharacter() *Character {
+	char := NewCharacter("user", "guild", "Aria", string(gamedata.Warrior))
+	char.Attributes = gamedata.GetBaseAttributesForClass(gamedata.Warrior)
+	char.updateCombatConfig()
+	return char
+}
@@ -0,0 +1,232 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"sirdraith/internal/domain/gamedata"
+)
+
+// Tipos de efeito de itens consumíveis
+const (
+	ConsumableHeal = "heal" // Cura pontos de vida: heal:<valor>
+	ConsumableCure = "cure" // Remove um estado do personagem: cure:<estado>
+	ConsumableBuff = "buff" // Bônus temporário de atributo: buff:<atributo>:<valor>:<duração>
+)
+
+// MaxBuffDuration é a maior duração aceita para um bônus temporário
+const MaxBuffDuration = 24 * time.Hour
+
+// ErrInvalidConsumableEffect indica um efeito de consumível mal formado
+var ErrInvalidConsumableEffect = errors.New("efeito de consumível inválido")
+
+// ConsumableEffect é um efeito aplicado ao usar um item consumível
+type ConsumableEffect struct {
+	Type      string
+	Value     int           // Pontos de vida curados ou bônus de atributo
+	Status    string        // Estado removido pelo efeito de cura
+	Attribute string        // Atributo que recebe o bônus
+	Duration  time.Duration // Duração do bônus
+}
+
+// AttributeBuff é um bônus temporário de atributo ativo no personagem
+type AttributeBuff struct {
+	Source    string    `bson:"source"`     // Item que concedeu o bônus
+	Attribute string    `bson:"attribute"`  // Atributo afetado
+	Value     int       `bson:"value"`      // Bônus somado ao atributo
+	ExpiresAt time.Time `bson:"expires_at"` // Momento em que o bônus acaba
+}
+
+// IsActive indica se o bônus ainda vale no momento informado
+func (b AttributeBuff) IsActive(now time.Time) bool {
+	return now.Before(b.ExpiresAt)
+}
+
+// ItemUseResult descreve o que aconteceu ao usar um consumível
+type ItemUseResult struct {
+	Item      Item
+	Healed    int             // Pontos de vida efetivamente recuperados
+	Cured     []string        // Estados removidos
+	NotCured  []string        // Estados que o item cura, mas o personagem não tinha
+	Buffs     []AttributeBuff // Bônus aplicados ou renovados
+	Remaining int             // Unidades do item que sobraram no inventário
+}
+
+// ParseConsumableEffects interpreta os efeitos de um consumível, separados por ";".
+// Ex: "heal:10; cure:Envenenado; buff:strength:2:10m"
+func ParseConsumableEffects(s string) ([]ConsumableEffect, error) {
+	var effects []ConsumableEffect
+	for _, raw := range strings.Split(s, ";") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		effect, err := parseConsumableEffect(raw)
+		if err != nil {
+			return nil, err
+		}
+		effects = append(effects, effect)
+	}
+	if len(effects) == 0 {
+		return nil, fmt.Errorf("%w: o item não tem efeitos", ErrInvalidConsumableEffect)
+	}
+	return effects, nil
+}
+
+// parseConsumableEffect interpreta um único efeito no formato "tipo:parâmetros"
+func parseConsumableEffect(s string) (ConsumableEffect, error) {
+	parts := strings.Split(s, ":")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+
+	switch strings.ToLower(parts[0]) {
+	case ConsumableHeal:
+		if len(parts) != 2 {
+			return ConsumableEffect{}, fmt.Errorf("%w: use heal:<valor> em %q", ErrInvalidConsumableEffect, s)
+		}
+		value, err := strconv.Atoi(parts[1])
+		if err != nil || value <= 0 {
+			return ConsumableEffect{}, fmt.Errorf("%w: cura inválida em %q", ErrInvalidConsumableEffect, s)
+		}
+		return ConsumableEffect{Type: ConsumableHeal, Value: value}, nil
+
+	case ConsumableCure:
+		if len(parts) != 2 || parts[1] == "" {
+			return ConsumableEffect{}, fmt.Errorf("%w: use cure:<estado> em %q", ErrInvalidConsumableEffect, s)
+		}
+		return ConsumableEffect{Type: ConsumableCure, Status: parts[1]}, nil
+
+	case ConsumableBuff:
+		if len(parts) != 4 {
+			return ConsumableEffect{}, fmt.Errorf("%w: use buff:<atributo>:<valor>:<duração> em %q", ErrInvalidConsumableEffect, s)
+		}
+		attribute := strings.ToLower(parts[1])
+		if !gamedata.IsValidAttribute(attribute) {
+			return ConsumableEffect{}, fmt.Errorf("%w: atributo desconhecido em %q", ErrInvalidConsumableEffect, s)
+		}
+		value, err := strconv.Atoi(parts[2])
+		if err != nil || value == 0 {
+			return ConsumableEffect{}, fmt.Errorf("%w: bônus inválido em %q", ErrInvalidConsumableEffect, s)
+		}
+		duration, err := time.ParseDuration(parts[3])
+		if err != nil || duration <= 0 || duration > MaxBuffDuration {
+			return ConsumableEffect{}, fmt.Errorf("%w: duração inválida em %q", ErrInvalidConsumableEffect, s)
+		}
+		return ConsumableEffect{Type: ConsumableBuff, Attribute: attribute, Value: value, Duration: duration}, nil
+	}
+
+	return ConsumableEffect{}, fmt.Errorf("%w: tipo desconhecido em %q", ErrInvalidConsumableEffect, s)
+}
+
+// UseItem consome uma unidade de um item consumível do inventário e aplica seus
+// efeitos. Personagens mortos não usam itens
+func (c *Character) UseItem(itemName string, now time.Time) (*ItemUseResult, error) {
+	if c.HealthState() == Dead {
+		return nil, ErrCharacterDead
+	}
+
+	var item *Item
+	for i := range c.Inventory {
+		if strings.EqualFold(c.Inventory[i].Name, itemName) && !c.Inventory[i].IsEquipped {
+			item = &c.Inventory[i]
+			break
+		}
+	}
+	if item == nil {
+		return nil, fmt.Errorf("item não encontrado no inventário")
+	}
+	if item.Type != gamedata.Consumable {
+		return nil, fmt.Errorf("%s não é um item consumível", item.Name)
+	}
+
+	effects, err := ParseConsumableEffects(item.Effects)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ItemUseResult{Item: *item, Remaining: item.Quantity - 1}
+	if err := c.RemoveItem(item.Name, 1); err != nil {
+		return nil, err
+	}
+
+	c.ExpireBuffs(now)
+	for _, effect := range effects {
+		switch effect.Type {
+		case ConsumableHeal:
+			before := c.Combat.Health
+			c.Heal(effect.Value)
+			result.Healed += c.Combat.Health - before
+		case ConsumableCure:
+			if c.RemoveStatus(effect.Status) {
+				result.Cured = append(result.Cured, effect.Status)
+			} else {
+				result.NotCured = append(result.NotCured, effect.Status)
+			}
+		case ConsumableBuff:
+			buff := AttributeBuff{
+				Source:    result.Item.Name,
+				Attribute: effect.Attribute,
+				Value:     effect.Value,
+				ExpiresAt: now.Add(effect.Duration),
+			}
+			c.addBuff(buff)
+			result.Buffs = append(result.Buffs, buff)
+		}
+	}
+
+	return result, nil
+}
+
+// addBuff adiciona um bônus temporário. Usar de novo o mesmo item renova o
+// bônus no atributo em vez de acumulá-lo
+func (c *Character) addBuff(buff AttributeBuff) {
+	for i, b := range c.Buffs {
+		if b.Source == buff.Source && b.Attribute == buff.Attribute {
+			c.Buffs[i] = buff
+			return
+		}
+	}
+	c.Buffs = append(c.Buffs, buff)
+}
+
+// ExpireBuffs remove os bônus temporários encerrados e os retorna
+func (c *Character) ExpireBuffs(now time.Time) []AttributeBuff {
+	var expired []AttributeBuff
+	active := c.Buffs[:0]
+	for _, buff := range c.Buffs {
+		if buff.IsActive(now) {
+			active = append(active, buff)
+		} else {
+			expired = append(expired, buff)
+		}
+	}
+	c.Buffs = active
+	if len(c.Buffs) == 0 {
+		c.Buffs = nil
+	}
+	return expired
+}
+
+// ActiveBuffs retorna os bônus temporários que ainda valem no momento informado
+func (c *Character) ActiveBuffs(now time.Time) []AttributeBuff {
+	var active []AttributeBuff
+	for _, buff := range c.Buffs {
+		if buff.IsActive(now) {
+			active = append(active, buff)
+		}
+	}
+	return active
+}
+
+// EffectiveAttributes retorna os atributos do personagem somados aos bônus temporários ativos
+func (c *Character) EffectiveAttributes() gamedata.Attributes {
+	attributes := c.Attributes
+	for _, buff := range c.ActiveBuffs(time.Now()) {
+		attributes.SetValue(buff.Attribute, attributes.GetValue(buff.Attribute)+buff.Value)
+	}
+	return attributes
+}
@@ -0,0 +1,146 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"sirdraith/internal/domain/gamedata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConsumableEffects(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []ConsumableEffect
+		wantErr bool
+	}{
+		{
+			name:  "heal",
+			input: "heal:10",
+			want:  []ConsumableEffect{{Type: ConsumableHeal, Value: 10}},
+		},
+		{
+			name:  "several effects",
+			input: "heal:5; cure:Envenenado ; buff:Strength:2:10m",
+			want: []ConsumableEffect{
+				{Type: ConsumableHeal, Value: 5},
+				{Type: ConsumableCure, Status: "Envenenado"},
+				{Type: ConsumableBuff, Attribute: "strength", Value: 2, Duration: 10 * time.Minute},
+			},
+		},
+		{name: "empty", input: " ", wantErr: true},
+		{name: "free text", input: "Cura um pouco", wantErr: true},
+		{name: "negative heal", input: "heal:-3", wantErr: true},
+		{name: "cure without status", input: "cure:", wantErr: true},
+		{name: "unknown attribute", input: "buff:luck:2:1m", wantErr: true},
+		{name: "buff without duration", input: "buff:strength:2", wantErr: true},
+		{name: "buff too long", input: "buff:strength:2:48h", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseConsumableEffects(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidConsumableEffect)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCharacter_UseItem(t *testing.T) {
+	now := time.Now()
+
+	t.Run("heals, cures and consumes one unit", func(t *testing.T) {
+		char := NewCharacter("user", "guild", "Aria", string(gamedata.Warrior))
+		char.TakeDamage(5)
+		char.AddStatus("Envenenado")
+		require.NoError(t, char.AddItem(Item{Name: "Elixir", Type: gamedata.Consumable, Quantity: 2, Effects: "heal:3;cure:Envenenado;cure:Atordoado"}))
+		health := char.Combat.Health
+
+		result, err := char.UseItem("elixir", now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.Healed)
+		assert.Equal(t, health+3, char.Combat.Health)
+		assert.Equal(t, []string{"Envenenado"}, result.Cured)
+		assert.Equal(t, []string{"Atordoado"}, result.NotCured)
+		assert.Empty(t, char.Status)
+		assert.Equal(t, 1, result.Remaining)
+		assert.Equal(t, 1, char.Inventory[0].Quantity)
+	})
+
+	t.Run("dead characters keep their items", func(t *testing.T) {
+		char := NewCharacter("user", "guild", "Aria", string(gamedata.Warrior))
+		char.TakeDamage(char.Combat.MaxHealth)
+		char.Combat.Dead = true
+		require.NoError(t, char.AddItem(Item{Name: "Poção", Type: gamedata.Consumable, Quantity: 1, Effects: "heal:5"}))
+
+		_, err := char.UseItem("Poção", now)
+
+		assert.ErrorIs(t, err, ErrCharacterDead)
+		assert.Equal(t, 1, char.Inventory[0].Quantity)
+	})
+
+	t.Run("healing is capped at max health", func(t *testing.T) {
+		char := NewCharacter("user", "guild", "Aria", string(gamedata.Warrior))
+		char.TakeDamage(1)
+		require.NoError(t, char.AddItem(Item{Name: "Poção", Type: gamedata.Consumable, Quantity: 1, Effects: "heal:50"}))
+
+		result, err := char.UseItem("Poção", now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Healed)
+		assert.Equal(t, char.Combat.MaxHealth, char.Combat.Health)
+		assert.Empty(t, char.Inventory)
+	})
+
+	t.Run("buffs attributes until they expire", func(t *testing.T) {
+		char := NewCharacter("user", "guild", "Aria", string(gamedata.Warrior))
+		char.Attributes.Strength = 14
+		require.NoError(t, char.AddItem(Item{Name: "Força do Touro", Type: gamedata.Consumable, Quantity: 2, Effects: "buff:strength:4:10m"}))
+		athletics := char.GetSkillModifier(gamedata.Athletics)
+
+		_, err := char.UseItem("Força do Touro", now)
+		require.NoError(t, err)
+		assert.Equal(t, 18, char.EffectiveAttributes().Strength)
+		assert.Equal(t, 14, char.Attributes.Strength, "base attributes are not changed")
+		assert.Equal(t, athletics+2, char.GetSkillModifier(gamedata.Athletics))
+
+		// Usar de novo renova o bônus em vez de acumular
+		_, err = char.UseItem("Força do Touro", now.Add(5*time.Minute))
+		require.NoError(t, err)
+		require.Len(t, char.Buffs, 1)
+		assert.Equal(t, now.Add(15*time.Minute), char.Buffs[0].ExpiresAt)
+
+		assert.Empty(t, char.ExpireBuffs(now.Add(10*time.Minute)))
+		expired := char.ExpireBuffs(now.Add(15 * time.Minute))
+		assert.Len(t, expired, 1)
+		assert.Nil(t, char.Buffs)
+	})
+
+	t.Run("rejects items that are not consumable", func(t *testing.T) {
+		char := NewCharacter("user", "guild", "Aria", string(gamedata.Warrior))
+		require.NoError(t, char.AddItem(Item{Name: "Espada", Type: gamedata.Weapon, Quantity: 1, Effects: "heal:5"}))
+
+		_, err := char.UseItem("Espada", now)
+
+		assert.ErrorContains(t, err, "não é um item consumível")
+		assert.Len(t, char.Inventory, 1)
+	})
+
+	t.Run("keeps the item when its effects are invalid", func(t *testing.T) {
+		char := NewCharacter("user", "guild", "Aria", string(gamedata.Warrior))
+		require.NoError(t, char.AddItem(Item{Name: "Frasco Misterioso", Type: gamedata.Consumable, Quantity: 1, Effects: "tem gosto de morango"}))
+
+		_, err := char.UseItem("Frasco Misterioso", now)
+
+		assert.ErrorIs(t, err, ErrInvalidConsumableEffect)
+		assert.Len(t, char.Inventory, 1)
+	})
+}
@@ -2,6 +2,8 @@ package entities
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -18,23 +20,75 @@ var (
 	ErrDeckCardLimit = errors.New("deck card limit reached")
 	// ErrCardNotFound indicates a card was not found in the deck
 	ErrCardNotFound = errors.New("card not found in deck")
+	// ErrCardBanned indicates a card is banned by the deck rules
+	ErrCardBanned = errors.New("card is banned")
+	// ErrRarityLimit indicates the deck has reached the limit for a rarity
+	ErrRarityLimit = errors.New("rarity limit reached")
+	// ErrClassRestricted indicates a class card does not match the deck class
+	ErrClassRestricted = errors.New("card class does not match deck class")
+	// ErrClassLimit indicates the deck has reached the limit for a card class
+	ErrClassLimit = errors.New("class card limit reached")
 )
 
 // DeckConfig defines configuration rules for a deck
 type DeckConfig struct {
-	MaxCards    int            // Maximum number of cards allowed in the deck
-	MaxPerCard  int            // Maximum number of copies of a single card
-	CardLimits  map[string]int // Special limits for specific cards
-	ClassLimits map[string]int // Limits for cards of specific classes
+	MaxCards      int                `bson:"max_cards"`               // Maximum number of cards allowed in the deck
+	MaxPerCard    int                `bson:"max_per_card"`            // Maximum number of copies of a single card
+	CardLimits    map[string]int     `bson:"card_limits,omitempty"`   // Special limits for specific cards, 0 bans the card
+	ClassLimits   map[string]int     `bson:"class_limits,omitempty"`  // Limits for cards of specific classes, 0 bans the class
+	RarityLimits  map[CardRarity]int `bson:"rarity_limits,omitempty"` // Limits for cards of specific rarities, 0 bans the rarity
+	RestrictClass bool               `bson:"restrict_class"`          // Class cards may only be used in decks of the same class
 }
 
 // DefaultDeckConfig returns the default deck configuration
 func DefaultDeckConfig() *DeckConfig {
 	return &DeckConfig{
-		MaxCards:    30,
-		MaxPerCard:  2,
-		CardLimits:  make(map[string]int),
-		ClassLimits: make(map[string]int),
+		MaxCards:      30,
+		MaxPerCard:    2,
+		CardLimits:    make(map[string]int),
+		ClassLimits:   make(map[string]int),
+		RarityLimits:  make(map[CardRarity]int),
+		RestrictClass: true,
+	}
+}
+
+// CopyLimit returns the maximum number of copies allowed for a card
+func (c *DeckConfig) CopyLimit(cardID string) int {
+	if limit, ok := c.CardLimits[cardID]; ok {
+		return limit
+	}
+	return c.MaxPerCard
+}
+
+// ClassLimit returns the limit for cards of a class. Classes are compared
+// case-insensitively, as card classes and stored limits may differ in case
+func (c *DeckConfig) ClassLimit(class string) (int, bool) {
+	if limit, ok := c.ClassLimits[strings.ToLower(class)]; ok {
+		return limit, true
+	}
+	for key, limit := range c.ClassLimits {
+		if strings.EqualFold(key, class) {
+			return limit, true
+		}
+	}
+	return 0, false
+}
+
+// SetClassLimit sets the limit for cards of a class
+func (c *DeckConfig) SetClassLimit(class string, limit int) {
+	c.RemoveClassLimit(class)
+	if c.ClassLimits == nil {
+		c.ClassLimits = make(map[string]int)
+	}
+	c.ClassLimits[strings.ToLower(class)] = limit
+}
+
+// RemoveClassLimit removes the limit for cards of a class
+func (c *DeckConfig) RemoveClassLimit(class string) {
+	for key := range c.ClassLimits {
+		if strings.EqualFold(key, class) {
+			delete(c.ClassLimits, key)
+		}
 	}
 }
 
@@ -43,6 +97,7 @@ type Deck struct {
 	ID          primitive.ObjectID `bson:"_id,omitempty"`
 	UserID      string             `bson:"user_id"`
 	GuildID     string             `bson:"guild_id"`
+	CharacterID string             `bson:"character_id,omitempty"` // Owning character, empty for decks created before decks belonged to characters
 	Name        string             `bson:"name"`
 	Description string             `bson:"description"`
 	Class       string             `bson:"class"`
@@ -67,8 +122,10 @@ func NewDeck(userID, guildID, name, description, class string) *Deck {
 	}
 }
 
-// Validate checks if the deck is valid according to the configuration
-func (d *Deck) Validate(config *DeckConfig) error {
+// Validate checks if the deck is valid according to the configuration.
+// Cards are looked up in the catalog to enforce rarity and class rules;
+// cards missing from the catalog are only checked against copy limits.
+func (d *Deck) Validate(config *DeckConfig, catalog map[string]*Card) error {
 	if d.ID.IsZero() {
 		return ErrInvalidDeckID
 	}
@@ -79,38 +136,113 @@ func (d *Deck) Validate(config *DeckConfig) error {
 		return ErrInvalidDeckClass
 	}
 
-	totalCards := 0
-	for _, quantity := range d.Cards {
-		totalCards += quantity
+	if d.GetCardCount() > config.MaxCards {
+		return ErrDeckCardLimit
 	}
 
-	if totalCards > config.MaxCards {
-		return ErrDeckCardLimit
+	rarities := make(map[CardRarity]int)
+	classes := make(map[string]int)
+	for cardID, quantity := range d.Cards {
+		if err := config.checkCopies(cardID, quantity); err != nil {
+			return err
+		}
+
+		card, ok := catalog[cardID]
+		if !ok || card == nil {
+			continue
+		}
+		if err := d.checkClass(card, config); err != nil {
+			return err
+		}
+		rarities[card.Rarity] += quantity
+		if class := card.Class(); class != "" {
+			classes[strings.ToLower(class)] += quantity
+		}
+	}
+
+	for rarity, count := range rarities {
+		if limit, ok := config.RarityLimits[rarity]; ok && count > limit {
+			return fmt.Errorf("%w: %d/%d %s", ErrRarityLimit, count, limit, rarity)
+		}
+	}
+	for class, count := range classes {
+		if limit, ok := config.ClassLimit(class); ok && count > limit {
+			return fmt.Errorf("%w: %d/%d %s", ErrClassLimit, count, limit, class)
+		}
 	}
 
 	return nil
 }
 
-// AddCard adds a card to the deck
-func (d *Deck) AddCard(cardID string, config *DeckConfig) error {
-	currentQuantity := d.Cards[cardID]
-	if currentQuantity >= config.MaxPerCard {
+// AddCard adds a card to the deck. The catalog holds the cards already in the
+// deck and is used to count rarities and classes.
+func (d *Deck) AddCard(card *Card, config *DeckConfig, catalog map[string]*Card) error {
+	currentQuantity := d.Cards[card.ID]
+	if err := config.checkCopies(card.ID, currentQuantity+1); err != nil {
+		return err
+	}
+
+	if d.GetCardCount() >= config.MaxCards {
 		return ErrDeckCardLimit
 	}
 
-	totalCards := 0
-	for _, quantity := range d.Cards {
-		totalCards += quantity
+	if err := d.checkClass(card, config); err != nil {
+		return err
 	}
-	if totalCards >= config.MaxCards {
-		return ErrDeckCardLimit
+
+	if limit, ok := config.RarityLimits[card.Rarity]; ok {
+		count := d.countMatching(catalog, func(c *Card) bool { return c.Rarity == card.Rarity })
+		if count+1 > limit {
+			return fmt.Errorf("%w: %d/%d %s", ErrRarityLimit, count, limit, card.Rarity)
+		}
+	}
+
+	if class := card.Class(); class != "" {
+		if limit, ok := config.ClassLimit(class); ok {
+			count := d.countMatching(catalog, func(c *Card) bool { return strings.EqualFold(c.Class(), class) })
+			if count+1 > limit {
+				return fmt.Errorf("%w: %d/%d %s", ErrClassLimit, count, limit, class)
+			}
+		}
 	}
 
-	d.Cards[cardID] = currentQuantity + 1
+	d.Cards[card.ID] = currentQuantity + 1
 	d.UpdatedAt = time.Now()
 	return nil
 }
 
+// checkCopies verifies the number of copies of a card against the limits
+func (c *DeckConfig) checkCopies(cardID string, quantity int) error {
+	limit := c.CopyLimit(cardID)
+	if limit == 0 {
+		return fmt.Errorf("%w: %s", ErrCardBanned, cardID)
+	}
+	if quantity > limit {
+		return fmt.Errorf("%w: %s allows %d copies", ErrDeckCardLimit, cardID, limit)
+	}
+	return nil
+}
+
+// checkClass verifies a class card can be used in this deck
+func (d *Deck) checkClass(card *Card, config *DeckConfig) error {
+	class := card.Class()
+	if class == "" || !config.RestrictClass || strings.EqualFold(class, d.Class) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s requires %s", ErrClassRestricted, card.ID, class)
+}
+
+// countMatching counts the copies in the deck whose card matches the predicate
+func (d *Deck) countMatching(catalog map[string]*Card, match func(*Card) bool) int {
+	count := 0
+	for cardID, quantity := range d.Cards {
+		if card, ok := catalog[cardID]; ok && card != nil && match(card) {
+			count += quantity
+		}
+	}
+	return count
+}
+
 // RemoveCard removes a card from the deck
 func (d *Deck) RemoveCard(cardID string) error {
 	quantity, exists := d.Cards[cardID]
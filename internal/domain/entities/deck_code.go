@@ -0,0 +1,135 @@
+package entities
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"sort"
+)
+
+// DeckCodeVersion is the current version of the deck share code format
+const DeckCodeVersion byte = 1
+
+var (
+	// ErrInvalidDeckCode indicates a deck code that cannot be decoded
+	ErrInvalidDeckCode = errors.New("invalid deck code")
+	// ErrDeckCodeChecksum indicates a deck code that was mistyped or truncated
+	ErrDeckCodeChecksum = errors.New("deck code checksum mismatch")
+	// ErrDeckCodeVersion indicates a deck code written by an unknown format version
+	ErrDeckCodeVersion = errors.New("unsupported deck code version")
+)
+
+// DeckList is the shareable part of a deck: its class and card quantities
+type DeckList struct {
+	Class string
+	Cards map[string]int // Map of card IDs to quantity
+}
+
+// EncodeDeckCode encodes the class and cards of a deck into a share code.
+//
+// The code is URL-safe base64 of: version byte, class, number of cards and
+// each card ID with its quantity (sorted by ID, lengths and numbers as
+// uvarints), followed by a big-endian CRC32 of everything before it.
+func EncodeDeckCode(deck *Deck) (string, error) {
+	if deck.Class == "" {
+		return "", ErrInvalidDeckClass
+	}
+
+	ids := make([]string, 0, len(deck.Cards))
+	for cardID, quantity := range deck.Cards {
+		if quantity > 0 {
+			ids = append(ids, cardID)
+		}
+	}
+	sort.Strings(ids)
+
+	var buf bytes.Buffer
+	buf.WriteByte(DeckCodeVersion)
+	writeString(&buf, deck.Class)
+	writeUvarint(&buf, uint64(len(ids)))
+	for _, cardID := range ids {
+		writeString(&buf, cardID)
+		writeUvarint(&buf, uint64(deck.Cards[cardID]))
+	}
+
+	checksum := make([]byte, 4)
+	binary.BigEndian.PutUint32(checksum, crc32.ChecksumIEEE(buf.Bytes()))
+	buf.Write(checksum)
+
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// DecodeDeckCode decodes a share code created by EncodeDeckCode
+func DecodeDeckCode(code string) (*DeckList, error) {
+	data, err := base64.RawURLEncoding.DecodeString(code)
+	if err != nil || len(data) < 5 {
+		return nil, ErrInvalidDeckCode
+	}
+
+	payload, checksum := data[:len(data)-4], data[len(data)-4:]
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(checksum) {
+		return nil, ErrDeckCodeChecksum
+	}
+	if payload[0] != DeckCodeVersion {
+		return nil, fmt.Errorf("%w: %d", ErrDeckCodeVersion, payload[0])
+	}
+
+	r := bytes.NewReader(payload[1:])
+	class, err := readString(r)
+	if err != nil || class == "" {
+		return nil, ErrInvalidDeckCode
+	}
+	count, err := binary.ReadUvarint(r)
+	if err != nil || count > uint64(r.Len()) {
+		return nil, ErrInvalidDeckCode
+	}
+
+	list := &DeckList{Class: class, Cards: make(map[string]int, count)}
+	for i := uint64(0); i < count; i++ {
+		cardID, err := readString(r)
+		if err != nil || cardID == "" {
+			return nil, ErrInvalidDeckCode
+		}
+		quantity, err := binary.ReadUvarint(r)
+		if err != nil || quantity == 0 || quantity > 255 {
+			return nil, ErrInvalidDeckCode
+		}
+		if _, dup := list.Cards[cardID]; dup {
+			return nil, ErrInvalidDeckCode
+		}
+		list.Cards[cardID] = int(quantity)
+	}
+	if r.Len() != 0 {
+		return nil, ErrInvalidDeckCode
+	}
+
+	return list, nil
+}
+
+func writeUvarint(buf *bytes.Buffer, n uint64) {
+	var tmp [binary.MaxVarintLen64]byte
+	buf.Write(tmp[:binary.PutUvarint(tmp[:], n)])
+}
+
+func writeString(buf *bytes.Buffer, s string) {
+	writeUvarint(buf, uint64(len(s)))
+	buf.WriteString(s)
+}
+
+func readString(r *bytes.Reader) (string, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", err
+	}
+	if length > uint64(r.Len()) {
+		return "", ErrInvalidDeckCode
+	}
+	s := make([]byte, length)
+	if _, err := r.Read(s); err != nil {
+		return "", err
+	}
+	return string(s), nil
+}
@@ -0,0 +1,82 @@
+package entities
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeckCode_RoundTrip(t *testing.T) {
+	deck := NewDeck("user", "guild", "Fogo", "", "mage")
+	deck.Cards = map[string]int{"bola-de-fogo": 2, "soldado": 1, "dragao-ancestral": 1}
+
+	code, err := EncodeDeckCode(deck)
+	require.NoError(t, err)
+
+	again, err := EncodeDeckCode(deck)
+	require.NoError(t, err)
+	assert.Equal(t, code, again, "encoding is deterministic")
+
+	list, err := DecodeDeckCode(code)
+	require.NoError(t, err)
+	assert.Equal(t, "mage", list.Class)
+	assert.Equal(t, deck.Cards, list.Cards)
+}
+
+func TestDecodeDeckCode_Errors(t *testing.T) {
+	deck := NewDeck("user", "guild", "Fogo", "", "mage")
+	deck.Cards = map[string]int{"soldado": 2}
+	code, err := EncodeDeckCode(deck)
+	require.NoError(t, err)
+	data, err := base64.RawURLEncoding.DecodeString(code)
+	require.NoError(t, err)
+
+	tampered := append([]byte(nil), data...)
+	tampered[3] ^= 0xff
+
+	tests := []struct {
+		name    string
+		code    string
+		wantErr error
+	}{
+		{
+			name:    "should reject invalid base64",
+			code:    "não é um código",
+			wantErr: ErrInvalidDeckCode,
+		},
+		{
+			name:    "should reject truncated code",
+			code:    code[:len(code)-3],
+			wantErr: ErrDeckCodeChecksum,
+		},
+		{
+			name:    "should reject tampered code",
+			code:    base64.RawURLEncoding.EncodeToString(tampered),
+			wantErr: ErrDeckCodeChecksum,
+		},
+		{
+			name:    "should reject unknown version",
+			code:    reencode(t, append([]byte{DeckCodeVersion + 1}, data[1:len(data)-4]...)),
+			wantErr: ErrDeckCodeVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeDeckCode(tt.code)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+// reencode builds a code with a valid checksum around the payload
+func reencode(t *testing.T, payload []byte) string {
+	t.Helper()
+	checksum := make([]byte, 4)
+	binary.BigEndian.PutUint32(checksum, crc32.ChecksumIEEE(payload))
+	return base64.RawURLEncoding.EncodeToString(append(payload, checksum...))
+}
@@ -0,0 +1,81 @@
+package entities
+
+import "sort"
+
+// DeckStats summarizes the composition of a deck
+type DeckStats struct {
+	TotalCards     int                // Total number of copies
+	DistinctCards  int                // Number of different cards
+	CostCurve      map[int]int        // Copies per mana cost
+	Types          map[CardType]int   // Copies per card type
+	Rarities       map[CardRarity]int // Copies per rarity
+	Keywords       map[string]int     // Copies carrying each keyword
+	AverageCost    float64            // Average cost of the resolved copies
+	AverageAttack  float64            // Average attack of the creature copies
+	AverageDefense float64            // Average defense of the creature copies
+	Missing        []string           // Card IDs not found in the catalog
+}
+
+// AnalyzeDeck computes the statistics of a deck. Cards are resolved in the
+// catalog; cards missing from it only count towards the totals.
+func AnalyzeDeck(deck *Deck, catalog map[string]*Card) *DeckStats {
+	stats := &DeckStats{
+		CostCurve: make(map[int]int),
+		Types:     make(map[CardType]int),
+		Rarities:  make(map[CardRarity]int),
+		Keywords:  make(map[string]int),
+	}
+
+	resolved, creatures := 0, 0
+	totalCost, totalAttack, totalDefense := 0, 0, 0
+	for cardID, quantity := range deck.Cards {
+		if quantity <= 0 {
+			continue
+		}
+		stats.TotalCards += quantity
+		stats.DistinctCards++
+
+		card, ok := catalog[cardID]
+		if !ok || card == nil {
+			stats.Missing = append(stats.Missing, cardID)
+			continue
+		}
+
+		resolved += quantity
+		totalCost += card.Cost * quantity
+		stats.CostCurve[card.Cost] += quantity
+		stats.Types[card.Type] += quantity
+		stats.Rarities[card.Rarity] += quantity
+		for _, keyword := range card.Keywords {
+			stats.Keywords[keyword] += quantity
+		}
+
+		if card.Type == CardTypeCreature {
+			creatures += quantity
+			totalAttack += card.Attack * quantity
+			totalDefense += card.Defense * quantity
+		}
+	}
+
+	if resolved > 0 {
+		stats.AverageCost = float64(totalCost) / float64(resolved)
+	}
+	if creatures > 0 {
+		stats.AverageAttack = float64(totalAttack) / float64(creatures)
+		stats.AverageDefense = float64(totalDefense) / float64(creatures)
+	}
+	sort.Strings(stats.Missing)
+
+	return stats
+}
+
+// MaxCost returns the highest cost present in the cost curve
+func (s *DeckStats) MaxCost() int {
+	highest := 0
+	for cost := range s.CostCurve {
+		if cost > highest {
+			highest = cost
+		}
+	}
+	return highest
+}
@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyzeDeck(t *testing.T) {
+	soldier := NewCard("soldado", "Soldado", CardTypeCreature, CardRarityCommon, "", 1, 1, 2, nil, []string{"guarda"}, "")
+	ogre := NewCard("ogro", "Ogro", CardTypeCreature, CardRarityRare, "", 4, 5, 4, nil, []string{"guarda", "furia"}, "")
+	fireball := NewCard("bola-de-fogo", "Bola de Fogo", CardTypeSpell, CardRarityCommon, "", 3, 0, 0, nil, nil, "")
+
+	deck := NewDeck("user", "guild", "Teste", "", "warrior")
+	deck.Cards = map[string]int{"soldado": 2, "ogro": 1, "bola-de-fogo": 2, "sumida": 1}
+	catalog := map[string]*Card{"soldado": soldier, "ogro": ogre, "bola-de-fogo": fireball}
+
+	stats := AnalyzeDeck(deck, catalog)
+
+	assert.Equal(t, 6, stats.TotalCards)
+	assert.Equal(t, 4, stats.DistinctCards)
+	assert.Equal(t, map[int]int{1: 2, 3: 2, 4: 1}, stats.CostCurve)
+	assert.Equal(t, map[CardType]int{CardTypeCreature: 3, CardTypeSpell: 2}, stats.Types)
+	assert.Equal(t, map[CardRarity]int{CardRarityCommon: 4, CardRarityRare: 1}, stats.Rarities)
+	assert.Equal(t, map[string]int{"guarda": 3, "furia": 1}, stats.Keywords)
+	assert.InDelta(t, 12.0/5.0, stats.AverageCost, 0.001)
+	assert.InDelta(t, 7.0/3.0, stats.AverageAttack, 0.001)
+	assert.InDelta(t, 8.0/3.0, stats.AverageDefense, 0.001)
+	assert.Equal(t, []string{"sumida"}, stats.Missing)
+	assert.Equal(t, 4, stats.MaxCost())
+}
+
+func TestAnalyzeDeck_Empty(t *testing.T) {
+	stats := AnalyzeDeck(NewDeck("user", "guild", "Vazio", "", "mage"), nil)
+
+	assert.Zero(t, stats.TotalCards)
+	assert.Zero(t, stats.AverageCost)
+	assert.Zero(t, stats.AverageAttack)
+	assert.Empty(t, stats.Missing)
+}
@@ -0,0 +1,149 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCard(id string, rarity CardRarity, class string) *Card {
+	card := NewCard(id, id, CardTypeCreature, rarity, "", 1, 1, 1, nil, nil, "")
+	if class != "" {
+		card.Requirements = &Requirements{Class: class}
+	}
+	return card
+}
+
+func TestDeck_AddCard(t *testing.T) {
+	legendary := testCard("dragao", CardRarityLegendary, "")
+	otherLegendary := testCard("fenix", CardRarityLegendary, "")
+	common := testCard("soldado", CardRarityCommon, "")
+	mageCard := testCard("bola-de-fogo", CardRarityCommon, "mage")
+	warriorCard := testCard("golpe", CardRarityCommon, "warrior")
+
+	tests := []struct {
+		name    string
+		setup   func(d *Deck)
+		config  func(c *DeckConfig)
+		card    *Card
+		wantErr error
+	}{
+		{
+			name: "should add card within limits",
+			card: common,
+		},
+		{
+			name:    "should respect max copies per card",
+			setup:   func(d *Deck) { d.Cards[common.ID] = 2 },
+			card:    common,
+			wantErr: ErrDeckCardLimit,
+		},
+		{
+			name:    "should reject banned card",
+			config:  func(c *DeckConfig) { c.CardLimits[common.ID] = 0 },
+			card:    common,
+			wantErr: ErrCardBanned,
+		},
+		{
+			name:   "should allow card limit above default",
+			setup:  func(d *Deck) { d.Cards[common.ID] = 2 },
+			config: func(c *DeckConfig) { c.CardLimits[common.ID] = 3 },
+			card:   common,
+		},
+		{
+			name:    "should cap rarity across different cards",
+			setup:   func(d *Deck) { d.Cards[legendary.ID] = 1 },
+			config:  func(c *DeckConfig) { c.RarityLimits[CardRarityLegendary] = 1 },
+			card:    otherLegendary,
+			wantErr: ErrRarityLimit,
+		},
+		{
+			name: "should accept class card in matching deck",
+			card: mageCard,
+		},
+		{
+			name:    "should reject class card in another class deck",
+			card:    warriorCard,
+			wantErr: ErrClassRestricted,
+		},
+		{
+			name:   "should accept any class when restriction is disabled",
+			config: func(c *DeckConfig) { c.RestrictClass = false },
+			card:   warriorCard,
+		},
+		{
+			name:    "should enforce class limits",
+			config:  func(c *DeckConfig) { c.ClassLimits["mage"] = 0 },
+			card:    mageCard,
+			wantErr: ErrClassLimit,
+		},
+		{
+			name:    "should enforce class limits regardless of case",
+			config:  func(c *DeckConfig) { c.SetClassLimit("mage", 0) },
+			card:    testCard("raio", CardRarityCommon, "Mage"),
+			wantErr: ErrClassLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deck := NewDeck("user", "guild", "Arcano", "", "mage")
+			config := DefaultDeckConfig()
+			if tt.setup != nil {
+				tt.setup(deck)
+			}
+			if tt.config != nil {
+				tt.config(config)
+			}
+			catalog := map[string]*Card{legendary.ID: legendary, common.ID: common, mageCard.ID: mageCard}
+
+			err := deck.AddCard(tt.card, config, catalog)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Positive(t, deck.GetCardQuantity(tt.card.ID))
+		})
+	}
+}
+
+func TestDeck_Validate(t *testing.T) {
+	legendary := testCard("dragao", CardRarityLegendary, "")
+	otherLegendary := testCard("fenix", CardRarityLegendary, "")
+	catalog := map[string]*Card{legendary.ID: legendary, otherLegendary.ID: otherLegendary}
+
+	t.Run("should accept deck within the rules", func(t *testing.T) {
+		deck := NewDeck("user", "guild", "Lendas", "", "mage")
+		deck.Cards[legendary.ID] = 1
+		deck.Cards[otherLegendary.ID] = 1
+		assert.NoError(t, deck.Validate(DefaultDeckConfig(), catalog))
+	})
+
+	t.Run("should reject deck breaking rules changed after building", func(t *testing.T) {
+		deck := NewDeck("user", "guild", "Lendas", "", "mage")
+		deck.Cards[legendary.ID] = 1
+		deck.Cards[otherLegendary.ID] = 1
+
+		config := DefaultDeckConfig()
+		config.RarityLimits[CardRarityLegendary] = 1
+		assert.ErrorIs(t, deck.Validate(config, catalog), ErrRarityLimit)
+
+		config = DefaultDeckConfig()
+		config.CardLimits[legendary.ID] = 0
+		assert.ErrorIs(t, deck.Validate(config, catalog), ErrCardBanned)
+	})
+
+	t.Run("should match class limits regardless of case", func(t *testing.T) {
+		mageCard := testCard("raio", CardRarityCommon, "Mage")
+		deck := NewDeck("user", "guild", "Arcano", "", "mage")
+		deck.Cards[mageCard.ID] = 2
+
+		config := DefaultDeckConfig()
+		config.ClassLimits["MAGE"] = 3
+		config.SetClassLimit("mage", 1)
+		assert.Len(t, config.ClassLimits, 1)
+		assert.ErrorIs(t, deck.Validate(config, map[string]*Card{mageCard.ID: mageCard}), ErrClassLimit)
+	})
+}
@@ -0,0 +1,247 @@
+package entities
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
+)
+
+// Effect types understood by the card effect interpreter
+const (
+	EffectDamage = "damage" // Deals damage to the target
+	EffectHeal   = "heal"   // Heals the target
+	EffectBuff   = "buff"   // Raises attack and defense of creatures
+	EffectDebuff = "debuff" // Lowers attack of creatures
+	EffectDraw   = "draw"   // Draws cards
+	EffectText   = "text"   // Flavor text without mechanical effect
+)
+
+// Effect targets
+const (
+	EffectTargetSelf           = "self"            // Hero of the player who played the card
+	EffectTargetOpponent       = "opponent"        // Enemy hero
+	EffectTargetChosen         = "target"          // Target chosen when the card is played
+	EffectTargetSource         = "source"          // The creature that produced the effect
+	EffectTargetAllyCreatures  = "ally_creatures"  // Every allied creature
+	EffectTargetEnemyCreatures = "enemy_creatures" // Every enemy creature
+	EffectTargetAllCreatures   = "all_creatures"   // Every creature on the board
+)
+
+// Conditions evaluated at resolution time, written as "name:value"
+const (
+	ConditionCasterHealthBelow     = "caster_health_below"      // Caster hero health below value
+	ConditionOpponentHealthBelow   = "opponent_health_below"    // Enemy hero health below value
+	ConditionAllyCreaturesAtLeast  = "ally_creatures_at_least"  // Allied creatures on the board
+	ConditionEnemyCreaturesAtLeast = "enemy_creatures_at_least" // Enemy creatures on the board
+	ConditionHandAtLeast           = "hand_at_least"            // Cards in the caster hand
+	ConditionTurnAtLeast           = "turn_at_least"            // Current duel turn
+)
+
+// ErrInvalidEffect indicates a malformed effect
+var ErrInvalidEffect = errors.New("invalid card effect")
+
+var validEffectTypes = map[string]bool{
+	EffectDamage: true,
+	EffectHeal:   true,
+	EffectBuff:   true,
+	EffectDebuff: true,
+	EffectDraw:   true,
+	EffectText:   true,
+}
+
+var validEffectTargets = map[string]bool{
+	EffectTargetSelf:           true,
+	EffectTargetOpponent:       true,
+	EffectTargetChosen:         true,
+	EffectTargetSource:         true,
+	EffectTargetAllyCreatures:  true,
+	EffectTargetEnemyCreatures: true,
+	EffectTargetAllCreatures:   true,
+}
+
+var validConditions = map[string]bool{
+	ConditionCasterHealthBelow:     true,
+	ConditionOpponentHealthBelow:   true,
+	ConditionAllyCreaturesAtLeast:  true,
+	ConditionEnemyCreaturesAtLeast: true,
+	ConditionHandAtLeast:           true,
+	ConditionTurnAtLeast:           true,
+}
+
+// ParseCondition splits a "name:value" condition
+func ParseCondition(s string) (string, int, error) {
+	name, raw, found := strings.Cut(strings.TrimSpace(s), ":")
+	if !found || !validConditions[name] {
+		return "", 0, fmt.Errorf("%w: unknown condition %q", ErrInvalidEffect, s)
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: invalid condition value %q", ErrInvalidEffect, s)
+	}
+	return name, value, nil
+}
+
+// Validate checks that the effect can be executed
+func (e Effect) Validate() error {
+	if !validEffectTypes[e.Type] {
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidEffect, e.Type)
+	}
+	if e.Type == EffectText {
+		return nil
+	}
+	if e.Value < 0 {
+		return fmt.Errorf("%w: value cannot be negative", ErrInvalidEffect)
+	}
+	if e.Duration < 0 {
+		return fmt.Errorf("%w: duration cannot be negative", ErrInvalidEffect)
+	}
+	if e.Target != "" && !validEffectTargets[e.Target] {
+		return fmt.Errorf("%w: unknown target %q", ErrInvalidEffect, e.Target)
+	}
+	for _, condition := range e.Conditions {
+		if _, _, err := ParseCondition(condition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsExecutable reports whether the effect has a mechanical resolution
+func (e Effect) IsExecutable() bool {
+	return e.Type != EffectText
+}
+
+// ParseEffect converts a legacy "type:value[:target[:duration]]" string.
+// Anything that does not follow the format is kept as a text effect.
+func ParseEffect(s string) Effect {
+	effect, err := parseEffectParts(strings.Split(strings.TrimSpace(s), ":"))
+	if err != nil {
+		return Effect{Type: EffectText, Properties: s}
+	}
+	return effect
+}
+
+// parseStrictEffect converts a legacy string like ParseEffect, but rejects
+// strings that start with an effect type and do not follow the format, so
+// that typos in imported files are not kept as text
+func parseStrictEffect(s string) (Effect, error) {
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	effect, err := parseEffectParts(parts)
+	if err == nil {
+		return effect, nil
+	}
+	if !validEffectTypes[parts[0]] || parts[0] == EffectText {
+		return Effect{Type: EffectText, Properties: s}, nil
+	}
+	return Effect{}, fmt.Errorf("%w: malformed effect %q", ErrInvalidEffect, s)
+}
+
+// parseEffectParts builds an effect from the parts of a legacy string
+func parseEffectParts(parts []string) (Effect, error) {
+	if len(parts) < 2 || !validEffectTypes[parts[0]] {
+		return Effect{}, ErrInvalidEffect
+	}
+
+	value, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return Effect{}, ErrInvalidEffect
+	}
+
+	effect := Effect{Type: parts[0], Value: value}
+	if len(parts) > 2 {
+		effect.Target = parts[2]
+	}
+	if len(parts) > 3 {
+		if effect.Duration, err = strconv.Atoi(parts[3]); err != nil {
+			return Effect{}, ErrInvalidEffect
+		}
+	}
+	if err := effect.Validate(); err != nil {
+		return Effect{}, err
+	}
+	return effect, nil
+}
+
+// effectDocument avoids recursion when decoding an effect document
+type effectDocument Effect
+
+// UnmarshalBSONValue accepts both structured effects and the legacy
+// format where each effect was stored as free text
+func (e *Effect) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if t == bsontype.String {
+		raw := bson.RawValue{Type: t, Value: data}
+		s, ok := raw.StringValueOK()
+		if !ok {
+			return fmt.Errorf("%w: malformed legacy effect", ErrInvalidEffect)
+		}
+		*e = ParseEffect(s)
+		return nil
+	}
+
+	var doc effectDocument
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	*e = Effect(doc)
+	return nil
+}
+
+// UnmarshalJSON accepts both structured effects and the legacy text format.
+// JSON only comes from imported files, so unknown fields and malformed legacy
+// effects are rejected
+func (e *Effect) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		effect, err := parseStrictEffect(s)
+		if err != nil {
+			return err
+		}
+		*e = effect
+		return nil
+	}
+
+	var doc effectDocument
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&doc); err != nil {
+		return err
+	}
+	*e = Effect(doc)
+	return nil
+}
+
+// UnmarshalYAML accepts both structured effects and the legacy text format.
+// YAML only comes from imported files, so unknown fields and malformed legacy
+// effects are rejected
+func (e *Effect) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		effect, err := parseStrictEffect(value.Value)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", value.Line, err)
+		}
+		*e = effect
+		return nil
+	}
+
+	// value.Decode ignores the decoder options, so the node is decoded again
+	// by a decoder that rejects unknown fields
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return err
+	}
+	var doc effectDocument
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&doc); err != nil {
+		return fmt.Errorf("line %d: %w", value.Line, err)
+	}
+	*e = Effect(doc)
+	return nil
+}
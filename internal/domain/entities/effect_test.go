@@ -0,0 +1,119 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestParseEffect(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Effect
+	}{
+		{
+			name:  "should parse type and value",
+			input: "damage:3",
+			want:  Effect{Type: EffectDamage, Value: 3},
+		},
+		{
+			name:  "should parse target and duration",
+			input: "heal:2:self:3",
+			want:  Effect{Type: EffectHeal, Value: 2, Target: EffectTargetSelf, Duration: 3},
+		},
+		{
+			name:  "should keep free text as text effect",
+			input: "Causa medo nos inimigos",
+			want:  Effect{Type: EffectText, Properties: "Causa medo nos inimigos"},
+		},
+		{
+			name:  "should keep unknown target as text effect",
+			input: "damage:3:moon",
+			want:  Effect{Type: EffectText, Properties: "damage:3:moon"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseEffect(tt.input))
+		})
+	}
+}
+
+func TestEffect_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		effect  Effect
+		wantErr bool
+	}{
+		{
+			name:   "should accept valid effect",
+			effect: Effect{Type: EffectBuff, Value: 2, Duration: 1, Target: EffectTargetChosen},
+		},
+		{
+			name:   "should accept known condition",
+			effect: Effect{Type: EffectDraw, Value: 1, Conditions: []string{"hand_at_least:2"}},
+		},
+		{
+			name:    "should reject unknown type",
+			effect:  Effect{Type: "teleport", Value: 1},
+			wantErr: true,
+		},
+		{
+			name:    "should reject negative value",
+			effect:  Effect{Type: EffectDamage, Value: -1},
+			wantErr: true,
+		},
+		{
+			name:    "should reject unknown condition",
+			effect:  Effect{Type: EffectDamage, Value: 1, Conditions: []string{"full_moon:1"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.effect.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidEffect)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCard_UnmarshalEffects(t *testing.T) {
+	t.Run("should load legacy string effects", func(t *testing.T) {
+		data, err := bson.Marshal(bson.M{
+			"_id":     "bola-de-fogo",
+			"name":    "Bola de Fogo",
+			"type":    "spell",
+			"rarity":  "common",
+			"effects": []string{"damage:4:opponent", "Ilumina o campo de batalha"},
+		})
+		require.NoError(t, err)
+
+		var card Card
+		require.NoError(t, bson.Unmarshal(data, &card))
+		require.Len(t, card.Effects, 2)
+		assert.Equal(t, Effect{Type: EffectDamage, Value: 4, Target: EffectTargetOpponent}, card.Effects[0])
+		assert.Equal(t, EffectText, card.Effects[1].Type)
+	})
+
+	t.Run("should round trip structured effects", func(t *testing.T) {
+		card := NewCard("escudo", "Escudo", CardTypeEnchant, CardRarityRare, "", 2, 0, 2,
+			[]Effect{{Type: EffectBuff, Value: 1, Duration: 2, Target: EffectTargetChosen, Conditions: []string{"turn_at_least:3"}}},
+			nil, "")
+
+		data, err := bson.Marshal(card)
+		require.NoError(t, err)
+
+		var loaded Card
+		require.NoError(t, bson.Unmarshal(data, &loaded))
+		assert.Equal(t, card.Effects, loaded.Effects)
+	})
+}
@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"sirdraith/internal/domain/gamedata"
+)
+
+// ErrInvalidMonster indicates a monster with invalid data
+var ErrInvalidMonster = errors.New("invalid monster")
+
+// MonsterAttack represents an attack a monster can make
+type MonsterAttack struct {
+	Name        string `bson:"name"`
+	Bonus       int    `bson:"bonus"`        // Added to the attack roll
+	DamageDice  int    `bson:"damage_dice"`  // Number of damage dice
+	DamageDie   int    `bson:"damage_die"`   // Sides of each damage die
+	DamageBonus int    `bson:"damage_bonus"` // Added to the damage roll
+
+	Condition gamedata.ConditionID `bson:"condition,omitempty"` // Condition applied to the target on a hit
+}
+
+// RollDamage rolls the damage of the attack, never below 1
+func (a MonsterAttack) RollDamage(rng *rand.Rand) int {
+	damage := a.DamageBonus
+	for i := 0; i < a.DamageDice; i++ {
+		if a.DamageDie > 0 {
+			damage += rng.Intn(a.DamageDie) + 1
+		}
+	}
+	if damage < 1 {
+		return 1
+	}
+	return damage
+}
+
+// LootEntry is an item a monster may drop when defeated
+type LootEntry struct {
+	Item   Item `bson:"item"`
+	Chance int  `bson:"chance"` // Drop chance in percent
+}
+
+// Monster represents a creature template from the bestiary
+type Monster struct {
+	ID              string              `bson:"_id"`
+	Name            string              `bson:"name"`
+	Description     string              `bson:"description"`
+	ChallengeRating float64             `bson:"challenge_rating"`
+	Experience      int                 `bson:"experience"` // Experience granted when defeated
+	Gold            int                 `bson:"gold"`       // Gold dropped when defeated
+	MaxHealth       int                 `bson:"max_health"`
+	Armor           int                 `bson:"armor"`
+	Initiative      int                 `bson:"initiative"`
+	Attributes      gamedata.Attributes `bson:"attributes"`
+	Attacks         []MonsterAttack     `bson:"attacks"`
+	Loot            []LootEntry         `bson:"loot,omitempty"`
+}
+
+// Validate checks if the monster can be used in combat
+func (m *Monster) Validate() error {
+	if m.ID == "" || m.Name == "" {
+		return fmt.Errorf("%w: missing id or name", ErrInvalidMonster)
+	}
+	if m.MaxHealth <= 0 {
+		return fmt.Errorf("%w: %s must have health", ErrInvalidMonster, m.ID)
+	}
+	if len(m.Attacks) == 0 {
+		return fmt.Errorf("%w: %s must have at least one attack", ErrInvalidMonster, m.ID)
+	}
+	if m.Experience < 0 || m.Gold < 0 {
+		return fmt.Errorf("%w: %s has negative rewards", ErrInvalidMonster, m.ID)
+	}
+	for _, attack := range m.Attacks {
+		if _, ok := gamedata.GetCondition(attack.Condition); attack.Condition != "" && !ok {
+			return fmt.Errorf("%w: %s has unknown condition %q", ErrInvalidMonster, m.ID, attack.Condition)
+		}
+	}
+	for _, entry := range m.Loot {
+		if entry.Chance < 0 || entry.Chance > 100 {
+			return fmt.Errorf("%w: %s has loot chance outside 0-100", ErrInvalidMonster, m.ID)
+		}
+	}
+	return nil
+}
+
+// RollLoot returns the items dropped by the monster and the d100 rolled for
+// each loot entry, in order. An entry drops when its roll is at most its chance
+func (m *Monster) RollLoot(rng *rand.Rand) ([]Item, []int) {
+	var items []Item
+	rolls := make([]int, 0, len(m.Loot))
+	for _, entry := range m.Loot {
+		roll := rng.Intn(100) + 1
+		rolls = append(rolls, roll)
+		if roll <= entry.Chance {
+			items = append(items, entry.Item)
+		}
+	}
+	return items, rolls
+}
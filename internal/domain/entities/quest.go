@@ -0,0 +1,330 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"sirdraith/internal/domain/gamedata"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Regras das missões
+const (
+	DefaultQuestDuration = 72 * time.Hour // Prazo padrão para concluir uma missão aceita
+	SkillCheckCooldown   = time.Hour      // Espera entre tentativas de um teste de perícia
+	MaxActiveQuests      = 3              // Missões simultâneas por personagem
+)
+
+var (
+	// ErrQuestNotComplete indica que os objetivos da missão não foram cumpridos
+	ErrQuestNotComplete = errors.New("os objetivos da missão ainda não foram cumpridos")
+	// ErrQuestNotActive indica uma missão que já foi concluída ou expirou
+	ErrQuestNotActive = errors.New("a missão não está ativa")
+	// ErrQuestLevel indica que o personagem não tem nível para a missão
+	ErrQuestLevel = errors.New("nível insuficiente para a missão")
+)
+
+// ObjectiveType representa o tipo de um objetivo de missão
+type ObjectiveType string
+
+const (
+	ObjectiveDefeat     ObjectiveType = "defeat"      // Derrotar monstros
+	ObjectiveCollect    ObjectiveType = "collect"     // Ter itens no inventário
+	ObjectiveSkillCheck ObjectiveType = "skill_check" // Passar em um teste de perícia
+)
+
+// QuestCadence representa a frequência com que as missões de um servidor são renovadas
+type QuestCadence string
+
+const (
+	CadenceDaily  QuestCadence = "daily"  // Renovada todo dia à meia-noite (UTC)
+	CadenceWeekly QuestCadence = "weekly" // Renovada toda segunda-feira à meia-noite (UTC)
+)
+
+// Cadences lista as frequências de renovação das missões
+var Cadences = []QuestCadence{CadenceDaily, CadenceWeekly}
+
+// PeriodStart retorna o início do período da frequência que contém o momento informado
+func (c QuestCadence) PeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if c == CadenceWeekly {
+		// Semanas começam na segunda-feira
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	}
+	return day
+}
+
+// PeriodEnd retorna o fim do período da frequência que contém o momento informado
+func (c QuestCadence) PeriodEnd(t time.Time) time.Time {
+	if c == CadenceWeekly {
+		return c.PeriodStart(t).AddDate(0, 0, 7)
+	}
+	return c.PeriodStart(t).AddDate(0, 0, 1)
+}
+
+// QuestSchedule guarda o estado da renovação de missões de um servidor,
+// evitando renovações e anúncios repetidos entre reinícios do bot
+type QuestSchedule struct {
+	ID        string       `bson:"_id"` // Servidor e frequência, ver QuestScheduleID
+	GuildID   string       `bson:"guild_id"`
+	Cadence   QuestCadence `bson:"cadence"`
+	Period    time.Time    `bson:"period"`               // Início do último período reivindicado
+	RotatedAt *time.Time   `bson:"rotated_at,omitempty"` // Quando as missões do período foram publicadas
+	PostedAt  *time.Time   `bson:"posted_at,omitempty"`  // Quando o quadro do período foi anunciado
+	UpdatedAt time.Time    `bson:"updated_at"`
+}
+
+// QuestScheduleID retorna o identificador do estado de renovação de um servidor
+func QuestScheduleID(guildID string, cadence QuestCadence) string {
+	return guildID + ":" + string(cadence)
+}
+
+// QuestObjective representa um objetivo de missão
+type QuestObjective struct {
+	Type       ObjectiveType `bson:"type"`
+	Target     string        `bson:"target,omitempty"`     // ID do monstro (vazio para qualquer um), nome do item ou perícia
+	Amount     int           `bson:"amount"`               // Quantidade de monstros ou itens
+	Difficulty int           `bson:"difficulty,omitempty"` // Classe de dificuldade do teste de perícia
+}
+
+// Describe descreve o objetivo para o jogador
+func (o QuestObjective) Describe() string {
+	switch o.Type {
+	case ObjectiveDefeat:
+		if o.Target == "" {
+			return fmt.Sprintf("Derrotar %d monstro(s)", o.Amount)
+		}
+		return fmt.Sprintf("Derrotar %d %s", o.Amount, o.Target)
+	case ObjectiveCollect:
+		return fmt.Sprintf("Coletar %d %s", o.Amount, o.Target)
+	case ObjectiveSkillCheck:
+		return fmt.Sprintf("Passar em um teste de %s (CD %d)", o.Target, o.Difficulty)
+	}
+	return string(o.Type)
+}
+
+// QuestReward representa as recompensas de uma missão
+type QuestReward struct {
+	Experience int    `bson:"experience"`
+	Gold       int    `bson:"gold"`
+	Items      []Item `bson:"items,omitempty"`
+}
+
+// Quest representa uma missão disponível em um servidor
+type Quest struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	GuildID     string             `bson:"guild_id"`
+	Title       string             `bson:"title"`
+	Description string             `bson:"description"`
+	Objectives  []QuestObjective   `bson:"objectives"`
+	Reward      QuestReward        `bson:"reward"`
+	MinLevel    int                `bson:"min_level"`
+	Cadence     QuestCadence       `bson:"cadence,omitempty"`    // Frequência de renovação, vazia para missões fixas
+	Duration    time.Duration      `bson:"duration"`             // Prazo para concluir depois de aceita
+	ExpiresAt   *time.Time         `bson:"expires_at,omitempty"` // Quando deixa de ser oferecida, nil para sempre
+	CreatedAt   time.Time          `bson:"created_at"`
+}
+
+// IsAvailable indica se a missão ainda é oferecida
+func (q *Quest) IsAvailable(now time.Time) bool {
+	return q.ExpiresAt == nil || now.Before(*q.ExpiresAt)
+}
+
+// QuestStatus representa a situação de uma missão aceita
+type QuestStatus string
+
+const (
+	QuestActive    QuestStatus = "active"    // Em andamento
+	QuestCompleted QuestStatus = "completed" // Concluída com recompensas entregues
+	QuestExpired   QuestStatus = "expired"   // Prazo encerrado antes da conclusão
+)
+
+// QuestProgress representa o progresso de um personagem em uma missão
+type QuestProgress struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	QuestID     string             `bson:"quest_id"`
+	CharacterID string             `bson:"character_id"`
+	UserID      string             `bson:"user_id"`
+	GuildID     string             `bson:"guild_id"`
+	Quest       Quest              `bson:"quest"`    // Cópia da missão no momento em que foi aceita
+	Progress    []int              `bson:"progress"` // Progresso de cada objetivo
+	Status      QuestStatus        `bson:"status"`
+	LastCheckAt *time.Time         `bson:"last_check_at,omitempty"` // Última tentativa de teste de perícia
+	AcceptedAt  time.Time          `bson:"accepted_at"`
+	Deadline    time.Time          `bson:"deadline"`
+	CompletedAt *time.Time         `bson:"completed_at,omitempty"`
+}
+
+// NewQuestProgress registra que o personagem aceitou a missão
+func NewQuestProgress(quest *Quest, character *Character, now time.Time) (*QuestProgress, error) {
+	if character.Level < quest.MinLevel {
+		return nil, fmt.Errorf("%w: requer nível %d", ErrQuestLevel, quest.MinLevel)
+	}
+
+	duration := quest.Duration
+	if duration <= 0 {
+		duration = DefaultQuestDuration
+	}
+	deadline := now.Add(duration)
+	if quest.ExpiresAt != nil && quest.ExpiresAt.Before(deadline) {
+		// Missões renovadas precisam ser concluídas antes de saírem do quadro
+		deadline = *quest.ExpiresAt
+	}
+
+	return &QuestProgress{
+		ID:          primitive.NewObjectID(),
+		QuestID:     quest.ID.Hex(),
+		CharacterID: character.ID.Hex(),
+		UserID:      character.UserID,
+		GuildID:     character.GuildID,
+		Quest:       *quest,
+		Progress:    make([]int, len(quest.Objectives)),
+		Status:      QuestActive,
+		AcceptedAt:  now,
+		Deadline:    deadline,
+	}, nil
+}
+
+// Expire marca a missão como expirada se o prazo acabou. Retorna se expirou agora
+func (p *QuestProgress) Expire(now time.Time) bool {
+	if p.Status != QuestActive || now.Before(p.Deadline) {
+		return false
+	}
+	p.Status = QuestExpired
+	return true
+}
+
+// RecordDefeat registra a derrota de um monstro nos objetivos correspondentes.
+// Retorna se algum objetivo avançou
+func (p *QuestProgress) RecordDefeat(monsterID string) bool {
+	if p.Status != QuestActive {
+		return false
+	}
+
+	advanced := false
+	for i, objective := range p.Quest.Objectives {
+		if objective.Type != ObjectiveDefeat || p.Progress[i] >= objective.Amount {
+			continue
+		}
+		if objective.Target == "" || objective.Target == monsterID {
+			p.Progress[i]++
+			advanced = true
+		}
+	}
+	return advanced
+}
+
+// ObjectiveProgress retorna o progresso atual de um objetivo e a quantidade necessária
+func (p *QuestProgress) ObjectiveProgress(i int, character *Character) (int, int) {
+	objective := p.Quest.Objectives[i]
+	switch objective.Type {
+	case ObjectiveCollect:
+		return countItems(character, objective.Target), objective.Amount
+	case ObjectiveSkillCheck:
+		return p.Progress[i], 1
+	}
+	return p.Progress[i], objective.Amount
+}
+
+// ObjectiveDone indica se um objetivo foi cumprido
+func (p *QuestProgress) ObjectiveDone(i int, character *Character) bool {
+	current, required := p.ObjectiveProgress(i, character)
+	return current >= required
+}
+
+// SkillCheckResult representa uma tentativa de teste de perícia de uma missão
+type SkillCheckResult struct {
+	Objective QuestObjective
+	Roll      int // Resultado natural do d20
+	Modifier  int
+	Passed    bool
+}
+
+// AttemptSkillChecks rola os testes de perícia pendentes, respeitando a espera
+// entre tentativas. A rolagem do d20 é recebida para permitir resultados controlados
+func (p *QuestProgress) AttemptSkillChecks(character *Character, d20 func() int, now time.Time) ([]SkillCheckResult, error) {
+	var pending []int
+	for i, objective := range p.Quest.Objectives {
+		if objective.Type == ObjectiveSkillCheck && p.Progress[i] == 0 {
+			pending = append(pending, i)
+		}
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	if p.LastCheckAt != nil && now.Sub(*p.LastCheckAt) < SkillCheckCooldown {
+		wait := SkillCheckCooldown - now.Sub(*p.LastCheckAt)
+		return nil, fmt.Errorf("aguarde %d minuto(s) para tentar os testes de perícia novamente", int(wait.Minutes())+1)
+	}
+	p.LastCheckAt = &now
+
+	results := make([]SkillCheckResult, 0, len(pending))
+	for _, i := range pending {
+		objective := p.Quest.Objectives[i]
+		result := SkillCheckResult{
+			Objective: objective,
+			Roll:      d20(),
+			Modifier:  character.GetSkillModifier(gamedata.Skill(objective.Target)),
+		}
+		result.Passed = result.Roll+result.Modifier >= objective.Difficulty
+		if result.Passed {
+			p.Progress[i] = 1
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Complete conclui a missão: consome os itens coletados e entrega as recompensas
+func (p *QuestProgress) Complete(character *Character, now time.Time) error {
+	if p.Status != QuestActive {
+		return ErrQuestNotActive
+	}
+	for i := range p.Quest.Objectives {
+		if !p.ObjectiveDone(i, character) {
+			return ErrQuestNotComplete
+		}
+	}
+
+	if len(character.Inventory)+len(p.Quest.Reward.Items) > gamedata.MaxInventorySize {
+		return fmt.Errorf("inventário cheio para receber as recompensas (limite de %d itens)", gamedata.MaxInventorySize)
+	}
+
+	for _, objective := range p.Quest.Objectives {
+		if objective.Type == ObjectiveCollect {
+			if err := character.RemoveItem(objective.Target, objective.Amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := character.AddExperience(p.Quest.Reward.Experience); err != nil {
+		return err
+	}
+	character.Gold += p.Quest.Reward.Gold
+	for _, item := range p.Quest.Reward.Items {
+		if err := character.AddItem(item); err != nil {
+			return fmt.Errorf("não foi possível receber %s: %w", item.Name, err)
+		}
+	}
+
+	p.Status = QuestCompleted
+	p.CompletedAt = &now
+	return nil
+}
+
+// countItems conta as unidades de um item no inventário, fora os equipados
+func countItems(character *Character, name string) int {
+	total := 0
+	for _, item := range character.Inventory {
+		if item.Name == name && !item.IsEquipped {
+			total += item.Quantity
+		}
+	}
+	return total
+}
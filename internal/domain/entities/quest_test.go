@@ -0,0 +1,244 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"sirdraith/internal/domain/gamedata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func newTestQuest(objectives ...QuestObjective) *Quest {
+	return &Quest{
+		ID:         primitive.NewObjectID(),
+		GuildID:    "987654321",
+		Title:      "Missão de Teste",
+		Objectives: objectives,
+		Reward:     QuestReward{Experience: 50, Gold: 30, Items: []Item{{Name: "Poção", Quantity: 1}}},
+		MinLevel:   1,
+	}
+}
+
+func TestNewQuestProgress(t *testing.T) {
+	now := time.Now()
+	character := NewCharacter("123456789", "987654321", "Sir Test", string(gamedata.Warrior))
+
+	tests := []struct {
+		name         string
+		quest        *Quest
+		wantErr      error
+		wantDeadline time.Time
+	}{
+		{
+			name:         "should use default duration",
+			quest:        newTestQuest(QuestObjective{Type: ObjectiveDefeat, Amount: 1}),
+			wantDeadline: now.Add(DefaultQuestDuration),
+		},
+		{
+			name: "should use quest duration",
+			quest: func() *Quest {
+				q := newTestQuest(QuestObjective{Type: ObjectiveDefeat, Amount: 1})
+				q.Duration = time.Hour
+				return q
+			}(),
+			wantDeadline: now.Add(time.Hour),
+		},
+		{
+			name: "should reject character below minimum level",
+			quest: func() *Quest {
+				q := newTestQuest(QuestObjective{Type: ObjectiveDefeat, Amount: 1})
+				q.MinLevel = 3
+				return q
+			}(),
+			wantErr: ErrQuestLevel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progress, err := NewQuestProgress(tt.quest, character, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, QuestActive, progress.Status)
+			assert.Equal(t, tt.wantDeadline, progress.Deadline)
+			assert.Len(t, progress.Progress, len(tt.quest.Objectives))
+		})
+	}
+}
+
+func TestQuestProgress_RecordDefeat(t *testing.T) {
+	character := NewCharacter("123456789", "987654321", "Sir Test", string(gamedata.Warrior))
+	quest := newTestQuest(
+		QuestObjective{Type: ObjectiveDefeat, Target: "goblin", Amount: 2},
+		QuestObjective{Type: ObjectiveDefeat, Amount: 3},
+	)
+	progress, err := NewQuestProgress(quest, character, time.Now())
+	require.NoError(t, err)
+
+	assert.True(t, progress.RecordDefeat("goblin"))
+	assert.True(t, progress.RecordDefeat("lobo"))
+	assert.True(t, progress.RecordDefeat("goblin"))
+	assert.Equal(t, []int{2, 3}, progress.Progress)
+
+	// Objetivos cumpridos não avançam além da quantidade exigida
+	assert.False(t, progress.RecordDefeat("goblin"))
+	assert.Equal(t, []int{2, 3}, progress.Progress)
+}
+
+func TestQuestProgress_Expire(t *testing.T) {
+	now := time.Now()
+	character := NewCharacter("123456789", "987654321", "Sir Test", string(gamedata.Warrior))
+	progress, err := NewQuestProgress(newTestQuest(QuestObjective{Type: ObjectiveDefeat, Amount: 1}), character, now)
+	require.NoError(t, err)
+
+	assert.False(t, progress.Expire(now))
+	assert.True(t, progress.Expire(progress.Deadline))
+	assert.Equal(t, QuestExpired, progress.Status)
+	assert.False(t, progress.RecordDefeat("goblin"))
+	assert.ErrorIs(t, progress.Complete(character, now), ErrQuestNotActive)
+}
+
+func TestQuestProgress_Complete(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		setup      func(*Character, *QuestProgress)
+		wantErr    error
+		wantGold   int
+		wantPelts  int
+		wantPotion bool
+	}{
+		{
+			name:      "should fail while objectives are pending",
+			setup:     func(c *Character, p *QuestProgress) {},
+			wantErr:   ErrQuestNotComplete,
+			wantGold:  gamedata.StartingGold,
+			wantPelts: 1,
+		},
+		{
+			name: "should consume collected items and grant rewards",
+			setup: func(c *Character, p *QuestProgress) {
+				_ = c.AddItem(Item{Name: "Pele de Lobo", Quantity: 2})
+				p.RecordDefeat("lobo")
+			},
+			wantGold:   gamedata.StartingGold + 30,
+			wantPelts:  1,
+			wantPotion: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := NewCharacter("123456789", "987654321", "Sir Test", string(gamedata.Warrior))
+			require.NoError(t, character.AddItem(Item{Name: "Pele de Lobo", Quantity: 1}))
+			quest := newTestQuest(
+				QuestObjective{Type: ObjectiveDefeat, Target: "lobo", Amount: 1},
+				QuestObjective{Type: ObjectiveCollect, Target: "Pele de Lobo", Amount: 2},
+			)
+			progress, err := NewQuestProgress(quest, character, now)
+			require.NoError(t, err)
+
+			tt.setup(character, progress)
+			err = progress.Complete(character, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, QuestActive, progress.Status)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, QuestCompleted, progress.Status)
+				assert.NotNil(t, progress.CompletedAt)
+				assert.Equal(t, 50, character.Experience)
+			}
+			assert.Equal(t, tt.wantGold, character.Gold)
+			assert.Equal(t, tt.wantPelts, countItems(character, "Pele de Lobo"))
+			assert.Equal(t, tt.wantPotion, countItems(character, "Poção") == 1)
+		})
+	}
+}
+
+func TestQuestProgress_AttemptSkillChecks(t *testing.T) {
+	now := time.Now()
+	character := NewCharacter("123456789", "987654321", "Sir Test", string(gamedata.Warrior))
+	quest := newTestQuest(QuestObjective{Type: ObjectiveSkillCheck, Target: string(gamedata.Investigation), Difficulty: 12})
+	progress, err := NewQuestProgress(quest, character, now)
+	require.NoError(t, err)
+	modifier := character.GetSkillModifier(gamedata.Investigation)
+
+	// Falha no teste
+	results, err := progress.AttemptSkillChecks(character, func() int { return 1 }, now)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.False(t, results[0].Passed)
+	assert.Equal(t, modifier, results[0].Modifier)
+	assert.False(t, progress.ObjectiveDone(0, character))
+
+	// Nova tentativa antes da espera
+	_, err = progress.AttemptSkillChecks(character, func() int { return 20 }, now.Add(time.Minute))
+	assert.Error(t, err)
+
+	// Sucesso depois da espera
+	results, err = progress.AttemptSkillChecks(character, func() int { return 20 }, now.Add(SkillCheckCooldown))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.True(t, results[0].Passed)
+	assert.True(t, progress.ObjectiveDone(0, character))
+
+	// Testes já aprovados não são rolados novamente
+	results, err = progress.AttemptSkillChecks(character, func() int { return 1 }, now.Add(2*SkillCheckCooldown))
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.NoError(t, progress.Complete(character, now))
+}
+
+func TestQuestCadence_Period(t *testing.T) {
+	// Quinta-feira, 16 de outubro de 2025, 15h30 em São Paulo (18h30 UTC)
+	now := time.Date(2025, time.October, 16, 15, 30, 0, 0, time.FixedZone("BRT", -3*60*60))
+
+	tests := []struct {
+		name      string
+		cadence   QuestCadence
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "daily period starts at UTC midnight",
+			cadence:   CadenceDaily,
+			wantStart: time.Date(2025, time.October, 16, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, time.October, 17, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "weekly period starts on Monday",
+			cadence:   CadenceWeekly,
+			wantStart: time.Date(2025, time.October, 13, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, time.October, 20, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantStart, tt.cadence.PeriodStart(now))
+			assert.Equal(t, tt.wantEnd, tt.cadence.PeriodEnd(now))
+			assert.Equal(t, tt.wantStart, tt.cadence.PeriodStart(tt.wantStart))
+			assert.Equal(t, tt.wantEnd, tt.cadence.PeriodStart(tt.wantEnd))
+		})
+	}
+}
+
+func TestNewQuestProgress_DeadlineCappedByExpiration(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(2 * time.Hour)
+	character := NewCharacter("123456789", "987654321", "Sir Test", string(gamedata.Warrior))
+	quest := newTestQuest(QuestObjective{Type: ObjectiveDefeat, Amount: 1})
+	quest.ExpiresAt = &expiresAt
+
+	progress, err := NewQuestProgress(quest, character, now)
+	require.NoError(t, err)
+	assert.Equal(t, expiresAt, progress.Deadline)
+}
@@ -0,0 +1,237 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"sirdraith/internal/domain/gamedata"
+)
+
+var (
+	// ErrRestCooldown indica um descanso feito antes do intervalo mínimo
+	ErrRestCooldown = errors.New("o personagem ainda não pode descansar")
+	// ErrCharacterDead indica uma ação de um personagem morto
+	ErrCharacterDead = errors.New("o personagem está morto")
+	// ErrNotDying indica um teste contra a morte de um personagem que não está morrendo
+	ErrNotDying = errors.New("o personagem não está morrendo")
+	// ErrCharacterInCombat indica uma alteração de um personagem que está em uma caçada
+	ErrCharacterInCombat = errors.New("o personagem está em combate")
+)
+
+// HealthState é o estado de saúde do personagem
+type HealthState string
+
+const (
+	Conscious HealthState = "conscious" // Com vida acima de 0
+	Dying     HealthState = "dying"     // Caído, fazendo testes contra a morte
+	Stable    HealthState = "stable"    // Caído, mas fora de perigo
+	Dead      HealthState = "dead"      // Morto de vez
+)
+
+// healthStateNames são os nomes dos estados de saúde apresentados aos jogadores
+var healthStateNames = map[HealthState]string{
+	Conscious: "❤️ Consciente",
+	Dying:     "🩸 Morrendo",
+	Stable:    "🛌 Caído, estável",
+	Dead:      "💀 Morto",
+}
+
+// Name retorna o nome do estado de saúde
+func (s HealthState) Name() string {
+	return healthStateNames[s]
+}
+
+// DeathSaves conta os testes contra a morte de um personagem caído
+type DeathSaves struct {
+	Successes int `bson:"successes"`
+	Failures  int `bson:"failures"`
+}
+
+// DeathSaveResult descreve um teste contra a morte
+type DeathSaveResult struct {
+	Roll    int         // Resultado do d20
+	Success bool        // Se o teste foi um sucesso
+	Saves   DeathSaves  // Contagem depois do teste
+	State   HealthState // Estado de saúde depois do teste
+	Revived bool        // Se um 20 natural levantou o personagem com 1 de vida
+	Spared  bool        // Se o personagem escapou da morte por não haver morte permanente
+}
+
+// RestResult descreve o que um descanso recuperou
+type RestResult struct {
+	Type             gamedata.RestType
+	Healed           int      // Pontos de vida recuperados
+	HitDiceRolls     []int    // Resultado de cada dado de vida gasto, já com a constituição
+	HitDiceRecovered int      // Dados de vida devolvidos pelo descanso longo
+	HitDiceAvailable int      // Dados de vida disponíveis depois do descanso
+	Removed          []string // Condições encerradas pelo descanso
+}
+
+// HealthState retorna o estado de saúde do personagem
+func (c *Character) HealthState() HealthState {
+	switch {
+	case c.Combat.Dead:
+		return Dead
+	case c.Combat.Health > 0:
+		return Conscious
+	case c.Combat.Stable:
+		return Stable
+	default:
+		return Dying
+	}
+}
+
+// HitDiceAvailable retorna quantos dados de vida o personagem ainda pode gastar.
+// O personagem tem um dado de vida por nível
+func (c *Character) HitDiceAvailable() int {
+	return max(c.Level-c.Combat.HitDiceSpent, 0)
+}
+
+// RollDeathSave faz um teste contra a morte: 10 ou mais no d20 é um sucesso, um
+// 20 natural levanta o personagem com 1 de vida e um 1 natural conta duas falhas.
+// Três sucessos estabilizam o personagem e três falhas o matam. Sem morte
+// permanente, o personagem que falharia escapa estabilizado, mas exausto
+func (c *Character) RollDeathSave(rng *rand.Rand, permadeath bool, now time.Time) (*DeathSaveResult, error) {
+	if c.HealthState() != Dying {
+		if c.Combat.Dead {
+			return nil, ErrCharacterDead
+		}
+		return nil, ErrNotDying
+	}
+
+	result := &DeathSaveResult{Roll: rng.Intn(20) + 1}
+	switch {
+	case result.Roll == 20:
+		result.Success = true
+		result.Revived = true
+		c.Heal(1)
+	case result.Roll == 1:
+		c.Combat.DeathSaves.Failures += 2
+	case result.Roll >= gamedata.DeathSaveDC:
+		result.Success = true
+		c.Combat.DeathSaves.Successes++
+	default:
+		c.Combat.DeathSaves.Failures++
+	}
+	result.Saves = c.Combat.DeathSaves
+
+	switch {
+	case c.Combat.DeathSaves.Failures >= gamedata.DeathSavesToDie:
+		if permadeath {
+			c.Combat.Dead = true
+		} else {
+			result.Spared = true
+			c.stabilize()
+			_ = c.ApplyCondition(gamedata.Exhausted, now)
+		}
+	case c.Combat.DeathSaves.Successes >= gamedata.DeathSavesToRecover:
+		c.stabilize()
+	}
+
+	result.State = c.HealthState()
+	return result, nil
+}
+
+// stabilize deixa o personagem caído fora de perigo
+func (c *Character) stabilize() {
+	c.Combat.Stable = true
+	c.Combat.DeathSaves = DeathSaves{}
+}
+
+// NextRest retorna a partir de quando o personagem pode fazer o descanso informado
+func (c *Character) NextRest(rest gamedata.RestType) time.Time {
+	last, ok := c.LastRests[rest]
+	if !ok {
+		return time.Time{}
+	}
+	return last.Add(gamedata.RestCooldown(rest))
+}
+
+// ShortRest gasta até dice dados de vida para recuperar vida. Cada dado rola o
+// dado de vida da classe somado ao modificador de constituição, recuperando pelo
+// menos 1 ponto. Para de gastar dados quando a vida enche
+func (c *Character) ShortRest(rng *rand.Rand, dice int, now time.Time) (*RestResult, error) {
+	if err := c.checkRest(gamedata.ShortRest, now); err != nil {
+		return nil, err
+	}
+	if dice < 1 {
+		return nil, fmt.Errorf("gaste pelo menos um dado de vida")
+	}
+	if c.HitDiceAvailable() == 0 {
+		return nil, fmt.Errorf("o personagem não tem dados de vida disponíveis; faça um descanso longo")
+	}
+	if c.Combat.Health >= c.Combat.MaxHealth {
+		return nil, fmt.Errorf("o personagem já está com a vida cheia")
+	}
+
+	result := &RestResult{Type: gamedata.ShortRest}
+	die := c.Ruleset().HitDie(c.Class)
+	before := c.Combat.Health
+	for spent := 0; spent < dice && c.HitDiceAvailable() > 0; spent++ {
+		if c.Combat.Health >= c.Combat.MaxHealth {
+			break
+		}
+		heal := max(rng.Intn(die)+1+c.getConstitutionModifier(), 1)
+		result.HitDiceRolls = append(result.HitDiceRolls, heal)
+		c.Combat.HitDiceSpent++
+		c.Heal(heal)
+	}
+	result.Healed = c.Combat.Health - before
+	result.HitDiceAvailable = c.HitDiceAvailable()
+
+	c.markRest(gamedata.ShortRest, now)
+	return result, nil
+}
+
+// LongRest recupera toda a vida, devolve metade dos dados de vida gastos e
+// encerra a exaustão. Um personagem caído só descansa depois de estabilizado
+func (c *Character) LongRest(now time.Time) (*RestResult, error) {
+	if err := c.checkRest(gamedata.LongRest, now); err != nil {
+		return nil, err
+	}
+
+	result := &RestResult{Type: gamedata.LongRest}
+	before := c.Combat.Health
+	c.Heal(c.Combat.MaxHealth)
+	result.Healed = c.Combat.Health - before
+
+	spent := c.Combat.HitDiceSpent
+	c.Combat.HitDiceSpent = max(spent-gamedata.HitDiceRecovered(c.Level), 0)
+	result.HitDiceRecovered = spent - c.Combat.HitDiceSpent
+	result.HitDiceAvailable = c.HitDiceAvailable()
+
+	if c.RemoveCondition(gamedata.Exhausted) {
+		condition, _ := gamedata.GetCondition(gamedata.Exhausted)
+		result.Removed = append(result.Removed, condition.Name)
+	}
+
+	c.markRest(gamedata.LongRest, now)
+	return result, nil
+}
+
+// checkRest verifica se o personagem pode fazer o descanso informado agora
+func (c *Character) checkRest(rest gamedata.RestType, now time.Time) error {
+	switch c.HealthState() {
+	case Dead:
+		return ErrCharacterDead
+	case Dying:
+		return fmt.Errorf("o personagem está morrendo; faça testes contra a morte até estabilizar")
+	}
+	if c.Combat.IsInCombat {
+		return fmt.Errorf("não é possível descansar em combate")
+	}
+	if next := c.NextRest(rest); now.Before(next) {
+		return fmt.Errorf("%w: %s disponível em %s", ErrRestCooldown, gamedata.RestTypeNames[rest], next.Sub(now).Round(time.Minute))
+	}
+	return nil
+}
+
+// markRest registra o momento do descanso
+func (c *Character) markRest(rest gamedata.RestType, now time.Time) {
+	if c.LastRests == nil {
+		c.LastRests = make(map[gamedata.RestType]time.Time)
+	}
+	c.LastRests[rest] = now
+}
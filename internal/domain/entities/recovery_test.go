@@ -0,0 +1,235 @@
+package entities
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"sirdraith/internal/domain/gamedata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rollSource é uma fonte aleatória que faz rng.Intn(n) retornar os valores
+// informados, em ordem, para qualquer n maior que eles
+type rollSource []int64
+
+func (s *rollSource) Int63() int64 {
+	v := (*s)[0]
+	*s = (*s)[1:]
+	return v << 32
+}
+
+func (s *rollSource) Seed(int64) {}
+
+// rolls cria um gerador cujos dados saem com os resultados informados, a partir de 1
+func rolls(results ...int) *rand.Rand {
+	source := make(rollSource, len(results))
+	for i, r := range results {
+		source[i] = int64(r - 1)
+	}
+	return rand.New(&source)
+}
+
+// newDownedCharacter cria um personagem caído com 0 de vida
+func newDownedCharacter() *Character {
+	char := newConditionTestCharacter()
+	char.TakeDamage(char.Combat.MaxHealth)
+	return char
+}
+
+func TestCharacter_TakeDamage_Downed(t *testing.T) {
+	char := newConditionTestCharacter()
+	assert.Equal(t, Conscious, char.HealthState())
+
+	char.Combat.DeathSaves = DeathSaves{Successes: 2, Failures: 2}
+	assert.True(t, char.TakeDamage(100))
+	assert.Equal(t, Dying, char.HealthState())
+	assert.Equal(t, DeathSaves{}, char.Combat.DeathSaves, "falling resets the death saves")
+
+	char.Combat.Stable = true
+	char.TakeDamage(1)
+	assert.Equal(t, Dying, char.HealthState(), "damage while down undoes the stabilization")
+
+	char.Heal(3)
+	assert.Equal(t, Conscious, char.HealthState())
+	assert.Equal(t, 3, char.Combat.Health)
+
+	char.Combat.Dead = true
+	char.Heal(10)
+	assert.Equal(t, Dead, char.HealthState(), "the dead cannot be healed")
+}
+
+func TestCharacter_RollDeathSave(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		rolls      []int
+		permadeath bool
+		want       HealthState
+		wantSaves  DeathSaves
+		check      func(t *testing.T, char *Character, last *DeathSaveResult)
+	}{
+		{name: "three successes stabilize", rolls: []int{10, 15, 19}, want: Stable},
+		{name: "mixed results keep dying", rolls: []int{12, 9, 3}, want: Dying, wantSaves: DeathSaves{Successes: 1, Failures: 2}},
+		{
+			name: "natural 20 revives with 1 health", rolls: []int{5, 20}, want: Conscious,
+			check: func(t *testing.T, char *Character, last *DeathSaveResult) {
+				assert.True(t, last.Revived)
+				assert.Equal(t, 1, char.Combat.Health)
+			},
+		},
+		{name: "natural 1 counts two failures", rolls: []int{1}, want: Dying, wantSaves: DeathSaves{Failures: 2}},
+		{name: "three failures kill with permadeath", rolls: []int{1, 4}, permadeath: true, want: Dead, wantSaves: DeathSaves{Failures: 3}},
+		{
+			name: "three failures spare without permadeath", rolls: []int{2, 3, 9}, want: Stable,
+			check: func(t *testing.T, char *Character, last *DeathSaveResult) {
+				assert.True(t, last.Spared)
+				assert.True(t, char.HasCondition(gamedata.Exhausted, now))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			char := newDownedCharacter()
+			rng := rolls(tt.rolls...)
+
+			var last *DeathSaveResult
+			for range tt.rolls {
+				result, err := char.RollDeathSave(rng, tt.permadeath, now)
+				require.NoError(t, err)
+				last = result
+			}
+
+			assert.Equal(t, tt.want, char.HealthState())
+			assert.Equal(t, tt.want, last.State)
+			assert.Equal(t, tt.wantSaves, char.Combat.DeathSaves)
+			if tt.check != nil {
+				tt.check(t, char, last)
+			}
+		})
+	}
+
+	t.Run("only dying characters roll", func(t *testing.T) {
+		char := newConditionTestCharacter()
+		_, err := char.RollDeathSave(rolls(10), false, now)
+		assert.ErrorIs(t, err, ErrNotDying)
+
+		char.Combat.Dead = true
+		_, err = char.RollDeathSave(rolls(10), false, now)
+		assert.ErrorIs(t, err, ErrCharacterDead)
+	})
+}
+
+func TestCharacter_ShortRest(t *testing.T) {
+	now := time.Now()
+
+	t.Run("spends hit dice with the constitution modifier", func(t *testing.T) {
+		char := newConditionTestCharacter()
+		char.Level = 3
+		char.updateCombatConfig()
+		char.TakeDamage(15)
+
+		result, err := char.ShortRest(rolls(4, 6), 2, now)
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{4 + 2, 6 + 2}, result.HitDiceRolls)
+		assert.Equal(t, 14, result.Healed)
+		assert.Equal(t, 1, result.HitDiceAvailable)
+		assert.Equal(t, now.Add(time.Hour), char.NextRest(gamedata.ShortRest))
+	})
+
+	t.Run("stops spending dice when health is full", func(t *testing.T) {
+		char := newConditionTestCharacter()
+		char.Level = 3
+		char.updateCombatConfig()
+		char.TakeDamage(3)
+
+		result, err := char.ShortRest(rolls(8, 8, 8), 3, now)
+		require.NoError(t, err)
+
+		assert.Len(t, result.HitDiceRolls, 1)
+		assert.Equal(t, 3, result.Healed)
+		assert.Equal(t, char.Combat.MaxHealth, char.Combat.Health)
+		assert.Equal(t, 2, char.HitDiceAvailable())
+	})
+
+	t.Run("wakes a stable character", func(t *testing.T) {
+		char := newDownedCharacter()
+		char.Combat.Stable = true
+
+		_, err := char.ShortRest(rolls(1), 1, now)
+		require.NoError(t, err)
+		assert.Equal(t, Conscious, char.HealthState())
+		assert.Equal(t, 3, char.Combat.Health)
+	})
+
+	t.Run("enforces the cooldown", func(t *testing.T) {
+		char := newConditionTestCharacter()
+		char.Level = 2
+		char.TakeDamage(5)
+		_, err := char.ShortRest(rolls(1), 1, now)
+		require.NoError(t, err)
+
+		_, err = char.ShortRest(rolls(1), 1, now.Add(30*time.Minute))
+		assert.ErrorIs(t, err, ErrRestCooldown)
+
+		_, err = char.ShortRest(rolls(1), 1, now.Add(time.Hour))
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejected rests", func(t *testing.T) {
+		full := newConditionTestCharacter()
+		_, err := full.ShortRest(rolls(1), 1, now)
+		assert.ErrorContains(t, err, "vida cheia")
+
+		noDice := newConditionTestCharacter()
+		noDice.TakeDamage(1)
+		noDice.Combat.HitDiceSpent = 1
+		_, err = noDice.ShortRest(rolls(1), 1, now)
+		assert.ErrorContains(t, err, "dados de vida")
+
+		dying := newDownedCharacter()
+		_, err = dying.ShortRest(rolls(1), 1, now)
+		assert.ErrorContains(t, err, "morrendo")
+
+		fighting := newConditionTestCharacter()
+		fighting.TakeDamage(1)
+		fighting.Combat.IsInCombat = true
+		_, err = fighting.ShortRest(rolls(1), 1, now)
+		assert.ErrorContains(t, err, "em combate")
+	})
+}
+
+func TestCharacter_LongRest(t *testing.T) {
+	now := time.Now()
+
+	char := newConditionTestCharacter()
+	char.Level = 5
+	char.updateCombatConfig()
+	char.Combat.HitDiceSpent = 5
+	char.TakeDamage(20)
+	require.NoError(t, char.ApplyCondition(gamedata.Exhausted, now))
+	require.NoError(t, char.ApplyCondition(gamedata.Blessed, now))
+
+	result, err := char.LongRest(now)
+	require.NoError(t, err)
+
+	assert.Equal(t, 20, result.Healed)
+	assert.Equal(t, char.Combat.MaxHealth, char.Combat.Health)
+	assert.Equal(t, 2, result.HitDiceRecovered)
+	assert.Equal(t, 2, char.HitDiceAvailable())
+	assert.Equal(t, []string{"Exausto"}, result.Removed)
+	assert.True(t, char.HasCondition(gamedata.Blessed, now), "other conditions are kept")
+
+	_, err = char.LongRest(now.Add(23 * time.Hour))
+	assert.ErrorIs(t, err, ErrRestCooldown)
+
+	dead := newDownedCharacter()
+	dead.Combat.Dead = true
+	_, err = dead.LongRest(now)
+	assert.ErrorIs(t, err, ErrCharacterDead)
+}
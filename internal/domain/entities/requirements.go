@@ -0,0 +1,103 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrRequirementsNotMet indicates a character does not meet a card's requirements
+var ErrRequirementsNotMet = errors.New("card requirements not met")
+
+// requirementAttributeNames maps attribute keys to the names shown to players
+var requirementAttributeNames = map[string]string{
+	"strength":     "Força",
+	"dexterity":    "Destreza",
+	"constitution": "Constituição",
+	"intelligence": "Inteligência",
+	"wisdom":       "Sabedoria",
+	"charisma":     "Carisma",
+}
+
+// RequirementsError lists every requirement a character failed to meet
+type RequirementsError struct {
+	CardID   string
+	Failures []string
+}
+
+func (e *RequirementsError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrRequirementsNotMet, strings.Join(e.Failures, "; "))
+}
+
+// Unwrap allows errors.Is to match ErrRequirementsNotMet
+func (e *RequirementsError) Unwrap() error {
+	return ErrRequirementsNotMet
+}
+
+// IsEmpty reports whether the requirements impose no restriction on characters
+func (r *Requirements) IsEmpty() bool {
+	return r == nil || (r.Level <= 0 && r.Class == "" && len(r.Attributes) == 0 && len(r.Skills) == 0)
+}
+
+// Check validates the requirements against a character and returns every failure.
+// A nil character fails any non-empty requirement. Resources are spent during play
+// and are not checked here.
+func (r *Requirements) Check(character *Character) []string {
+	if r.IsEmpty() {
+		return nil
+	}
+	if character == nil {
+		return []string{"é necessário ter um personagem neste servidor"}
+	}
+
+	var failures []string
+	if character.Level < r.Level {
+		failures = append(failures, fmt.Sprintf("nível %d (atual: %d)", r.Level, character.Level))
+	}
+	if r.Class != "" && !strings.EqualFold(string(character.Class), r.Class) {
+		failures = append(failures, fmt.Sprintf("classe %s (atual: %s)", r.Class, character.Class))
+	}
+
+	attributes := make([]string, 0, len(r.Attributes))
+	for attr := range r.Attributes {
+		attributes = append(attributes, attr)
+	}
+	sort.Strings(attributes)
+	for _, attr := range attributes {
+		required := r.Attributes[attr]
+		if current := character.Attributes.GetValue(attr); current < required {
+			name, ok := requirementAttributeNames[attr]
+			if !ok {
+				name = attr
+			}
+			failures = append(failures, fmt.Sprintf("%s %d (atual: %d)", name, required, current))
+		}
+	}
+
+	for _, skill := range r.Skills {
+		if !character.hasSkillProficiency(skill) {
+			failures = append(failures, fmt.Sprintf("proficiência em %s", skill))
+		}
+	}
+	return failures
+}
+
+// CheckRequirements returns a RequirementsError when the character cannot use the card
+func (c *Card) CheckRequirements(character *Character) error {
+	failures := c.Requirements.Check(character)
+	if len(failures) == 0 {
+		return nil
+	}
+	return &RequirementsError{CardID: c.ID, Failures: failures}
+}
+
+// hasSkillProficiency reports whether the character is proficient in the skill
+func (c *Character) hasSkillProficiency(skill string) bool {
+	for _, s := range c.Skills {
+		if s.IsProficient && strings.EqualFold(string(s.Skill), skill) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package entities
+
+import (
+	"errors"
+	"testing"
+
+	"sirdraith/internal/domain/gamedata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCard_CheckRequirements(t *testing.T) {
+	character := NewCharacter("user", "guild", "Aria", string(gamedata.Rogue))
+	character.Level = 3
+	character.Attributes = gamedata.Attributes{Strength: 10, Dexterity: 16}
+	character.Skills = []gamedata.SkillProficiency{
+		{Skill: gamedata.Stealth, IsProficient: true},
+		{Skill: gamedata.Athletics, IsProficient: false},
+	}
+
+	tests := []struct {
+		name         string
+		requirements *Requirements
+		character    *Character
+		wantFailures []string
+	}{
+		{
+			name:      "should accept card without requirements",
+			character: character,
+		},
+		{
+			name: "should accept character meeting every requirement",
+			requirements: &Requirements{
+				Level:      3,
+				Class:      "Rogue",
+				Attributes: map[string]int{"dexterity": 14},
+				Skills:     []string{"stealth"},
+			},
+			character: character,
+		},
+		{
+			name: "should list every failed requirement",
+			requirements: &Requirements{
+				Level:      5,
+				Class:      "mage",
+				Attributes: map[string]int{"strength": 14, "dexterity": 18},
+				Skills:     []string{"athletics"},
+			},
+			character: character,
+			wantFailures: []string{
+				"nível 5 (atual: 3)",
+				"classe mage (atual: rogue)",
+				"Destreza 18 (atual: 16)",
+				"Força 14 (atual: 10)",
+				"proficiência em athletics",
+			},
+		},
+		{
+			name:         "should require a character",
+			requirements: &Requirements{Level: 1},
+			wantFailures: []string{"é necessário ter um personagem neste servidor"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card := &Card{ID: "lamina", Requirements: tt.requirements}
+			err := card.CheckRequirements(tt.character)
+			if tt.wantFailures == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var reqErr *RequirementsError
+			require.True(t, errors.As(err, &reqErr))
+			assert.ErrorIs(t, err, ErrRequirementsNotMet)
+			assert.Equal(t, tt.wantFailures, reqErr.Failures)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RollKind representa o tipo de uma rolagem registrada
+type RollKind string
+
+const (
+	RollExpression     RollKind = "expression"      // Expressão de dados livre
+	RollSkillCheck     RollKind = "skill"           // Teste de perícia
+	RollAttributeCheck RollKind = "attribute_check" // Teste de atributo
+	RollSavingThrow    RollKind = "saving_throw"    // Salvaguarda
+	RollDeathSave      RollKind = "death_save"      // Teste contra a morte
+	RollHitDice        RollKind = "hit_dice"        // Dados de vida gastos em um descanso curto
+	RollAttack         RollKind = "attack"          // Ataque em uma caçada
+	RollLoot           RollKind = "loot"            // Espólio dos monstros derrotados
+	RollBooster        RollKind = "booster"         // Sorteio das cartas de um pacote
+)
+
+// RollKindNames são os nomes das rolagens mostrados no histórico
+var RollKindNames = map[RollKind]string{
+	RollExpression:     "Rolagem",
+	RollSkillCheck:     "Teste de perícia",
+	RollAttributeCheck: "Teste de atributo",
+	RollSavingThrow:    "Salvaguarda",
+	RollDeathSave:      "Teste contra a morte",
+	RollHitDice:        "Dados de vida",
+	RollAttack:         "Ataque",
+	RollLoot:           "Espólio",
+	RollBooster:        "Pacote de cartas",
+}
+
+// RollRecord é o registro de uma rolagem na auditoria do servidor, para que os
+// mestres possam conferir resultados contestados
+type RollRecord struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	GuildID       string             `bson:"guild_id"`
+	UserID        string             `bson:"user_id"`
+	CharacterName string             `bson:"character_name,omitempty"`
+	Kind          RollKind           `bson:"kind"`
+	Expression    string             `bson:"expression"` // O que foi rolado, como "1d20+FOR (vantagem)"
+	Dice          []int              `bson:"dice"`       // Todos os dados rolados, em ordem, inclusive os descartados
+	Total         int                `bson:"total"`
+	CreatedAt     time.Time          `bson:"created_at"`
+}
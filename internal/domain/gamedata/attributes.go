@@ -1,5 +1,7 @@
 package gamedata
 
+import "strings"
+
 // GetValue retorna o valor de um atributo pelo nome
 func (a *Attributes) GetValue(attribute string) int {
 	switch attribute {
@@ -67,3 +69,30 @@ func (a *Attributes) GetWisdom() int {
 func (a *Attributes) GetCharisma() int {
 	return a.Charisma
 }
+
+// attributeAliases são os nomes e abreviações aceitos para cada atributo, em
+// português e em inglês
+var attributeAliases = map[string]string{
+	"for": "strength", "str": "strength", "forca": "strength", "força": "strength",
+	"des": "dexterity", "dex": "dexterity", "destreza": "dexterity",
+	"con": "constitution", "constituicao": "constitution", "constituição": "constitution",
+	"int": "intelligence", "inteligencia": "intelligence", "inteligência": "intelligence",
+	"sab": "wisdom", "wis": "wisdom", "sabedoria": "wisdom",
+	"car": "charisma", "cha": "charisma", "carisma": "charisma",
+}
+
+// FindAttribute busca um atributo pelo nome, em português ou inglês, ou pela
+// abreviação (FOR, DES, STR, DEX...), sem diferenciar maiúsculas
+func FindAttribute(name string) (string, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if IsValidAttribute(name) {
+		return name, true
+	}
+	attribute, ok := attributeAliases[name]
+	return attribute, ok
+}
+
+// AttributeModifier calcula o modificador de um valor de atributo
+func AttributeModifier(value int) int {
+	return (value - 10) / 2
+}
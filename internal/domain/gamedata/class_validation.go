@@ -54,20 +54,21 @@ var ClassRequirements = map[CharacterClass]Attributes{
 	},
 }
 
-// ClassEquipmentRestrictions define as restrições de equipamento para cada classe
+// ClassEquipmentRestrictions define as restrições de equipamento para cada classe.
+// Acessórios (anéis, colares e amuletos) são permitidos a todas as classes
 var ClassEquipmentRestrictions = map[CharacterClass][]ItemType{
-	Warrior:   {Weapon, Armor},
+	Warrior:   {Weapon, Armor, Accessory},
 	Mage:      {Weapon, Accessory},
-	Ranger:    {Weapon, Armor},
-	Cleric:    {Weapon, Armor},
-	Paladin:   {Weapon, Armor},
+	Ranger:    {Weapon, Armor, Accessory},
+	Cleric:    {Weapon, Armor, Accessory},
+	Paladin:   {Weapon, Armor, Accessory},
 	Druid:     {Weapon, Accessory},
-	Barbarian: {Weapon, Armor},
+	Barbarian: {Weapon, Armor, Accessory},
 	Monk:      {Weapon, Accessory},
 	Bard:      {Weapon, Accessory},
 	Warlock:   {Weapon, Accessory},
 	Sorcerer:  {Weapon, Accessory},
-	Rogue:     {Weapon, Armor},
+	Rogue:     {Weapon, Armor, Accessory},
 }
 
 // ValidateClassRequirements verifica se os atributos atendem aos requisitos da classe
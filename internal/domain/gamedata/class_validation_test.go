@@ -98,11 +98,10 @@ func TestValidateClassEquipment(t *testing.T) {
 			wantErr:  false,
 		},
 		{
-			name:        "warrior cannot use accessory",
-			class:       Warrior,
-			itemType:    Accessory,
-			wantErr:     true,
-			errContains: "não pode usar equipamentos",
+			name:     "warrior can use accessory",
+			class:    Warrior,
+			itemType: Accessory,
+			wantErr:  false,
 		},
 		{
 			name:     "mage can use weapon",
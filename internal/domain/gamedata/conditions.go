@@ -0,0 +1,129 @@
+package gamedata
+
+import (
+	"strings"
+	"time"
+)
+
+// ConditionID identifica uma condição do catálogo
+type ConditionID string
+
+const (
+	Poisoned     ConditionID = "poisoned"
+	Wounded      ConditionID = "wounded"
+	Stunned      ConditionID = "stunned"
+	Blessed      ConditionID = "blessed"
+	Exhausted    ConditionID = "exhausted"
+	Regenerating ConditionID = "regenerating"
+)
+
+// StackRule define o que acontece quando uma condição ativa é aplicada de novo
+type StackRule int
+
+const (
+	StackRefresh   StackRule = iota // Renova a duração sem acumular
+	StackIntensity                  // Acumula até o máximo e renova a duração
+)
+
+// Condition define os efeitos mecânicos de uma condição. Os efeitos por turno e
+// os modificadores valem para cada acúmulo
+type Condition struct {
+	ID            ConditionID
+	Name          string        // Nome apresentado aos jogadores
+	Emoji         string        // Emoji usado nas mensagens
+	Description   string        // Descrição dos efeitos
+	Turns         int           // Turnos de combate até acabar, 0 para não contar turnos
+	Duration      time.Duration // Tempo até acabar
+	Stacking      StackRule     // Regra ao ser aplicada de novo
+	MaxStacks     int           // Máximo de acúmulos para StackIntensity
+	TickDamage    int           // Dano no início de cada turno
+	TickHeal      int           // Cura no início de cada turno
+	RollModifier  int           // Somado aos testes de perícia e às rolagens de ataque
+	ArmorModifier int           // Somado à classe de armadura
+	SkipsTurn     bool          // Se o personagem perde o turno
+}
+
+// conditions é o catálogo de condições, por ID
+var conditions = map[ConditionID]*Condition{
+	Poisoned: {
+		ID:           Poisoned,
+		Name:         "Envenenado",
+		Emoji:        "🤢",
+		Description:  "Sofre 1 de dano por turno e -1 nas rolagens, por acúmulo",
+		Turns:        3,
+		Duration:     10 * time.Minute,
+		Stacking:     StackIntensity,
+		MaxStacks:    3,
+		TickDamage:   1,
+		RollModifier: -1,
+	},
+	Wounded: {
+		ID:          Wounded,
+		Name:        "Ferido",
+		Emoji:       "🩸",
+		Description: "Sangra 1 de vida por turno, por acúmulo",
+		Turns:       3,
+		Duration:    10 * time.Minute,
+		Stacking:    StackIntensity,
+		MaxStacks:   5,
+		TickDamage:  1,
+	},
+	Stunned: {
+		ID:            Stunned,
+		Name:          "Atordoado",
+		Emoji:         "💫",
+		Description:   "Perde o próximo turno e tem -2 na armadura",
+		Turns:         1,
+		Duration:      time.Minute,
+		Stacking:      StackRefresh,
+		ArmorModifier: -2,
+		SkipsTurn:     true,
+	},
+	Blessed: {
+		ID:           Blessed,
+		Name:         "Abençoado",
+		Emoji:        "😇",
+		Description:  "+2 nas rolagens",
+		Turns:        10,
+		Duration:     time.Hour,
+		Stacking:     StackRefresh,
+		RollModifier: 2,
+	},
+	Exhausted: {
+		ID:           Exhausted,
+		Name:         "Exausto",
+		Emoji:        "😩",
+		Description:  "-1 nas rolagens por acúmulo, até descansar",
+		Duration:     8 * time.Hour,
+		Stacking:     StackIntensity,
+		MaxStacks:    5,
+		RollModifier: -1,
+	},
+	Regenerating: {
+		ID:          Regenerating,
+		Name:        "Regenerando",
+		Emoji:       "💚",
+		Description: "Recupera 2 de vida por turno",
+		Turns:       5,
+		Duration:    10 * time.Minute,
+		Stacking:    StackRefresh,
+		TickHeal:    2,
+	},
+}
+
+// GetCondition retorna a condição do catálogo com o ID informado
+func GetCondition(id ConditionID) (*Condition, bool) {
+	condition, ok := conditions[id]
+	return condition, ok
+}
+
+// FindCondition busca uma condição pelo ID ou pelo nome, sem diferenciar maiúsculas
+func FindCondition(name string) (*Condition, bool) {
+	name = strings.TrimSpace(name)
+	for _, condition := range conditions {
+		if strings.EqualFold(string(condition.ID), name) || strings.EqualFold(condition.Name, name) {
+			return condition, true
+		}
+	}
+	return nil, false
+}
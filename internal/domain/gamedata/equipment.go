@@ -0,0 +1,185 @@
+package gamedata
+
+import "fmt"
+
+// EquipmentSlots lista os slots de equipamento na ordem em que são apresentados
+var EquipmentSlots = []EquipmentSlot{Head, Neck, Chest, Legs, Feet, MainHand, OffHand, Ring1, Ring2, Trinket1, Trinket2}
+
+// interchangeableSlots agrupa os slots que aceitam os mesmos itens, como os dois anéis
+var interchangeableSlots = map[EquipmentSlot][]EquipmentSlot{
+	Ring1:    {Ring1, Ring2},
+	Ring2:    {Ring1, Ring2},
+	Trinket1: {Trinket1, Trinket2},
+	Trinket2: {Trinket1, Trinket2},
+}
+
+// spellcastingClasses são as classes que conjuram magias e seu atributo de conjuração
+var spellcastingClasses = map[CharacterClass]string{
+	Mage:     "intelligence",
+	Cleric:   "wisdom",
+	Druid:    "wisdom",
+	Paladin:  "charisma",
+	Bard:     "charisma",
+	Warlock:  "charisma",
+	Sorcerer: "charisma",
+}
+
+// IsValidSlot verifica se o slot de equipamento existe
+func IsValidSlot(slot EquipmentSlot) bool {
+	for _, s := range EquipmentSlots {
+		if s == slot {
+			return true
+		}
+	}
+	return false
+}
+
+// CompatibleSlots retorna os slots que podem receber um item do slot informado,
+// em ordem de preferência
+func CompatibleSlots(slot EquipmentSlot) []EquipmentSlot {
+	if slots, ok := interchangeableSlots[slot]; ok {
+		return slots
+	}
+	if IsValidSlot(slot) {
+		return []EquipmentSlot{slot}
+	}
+	return nil
+}
+
+// Add retorna a soma dos atributos dos dois itens
+func (s ItemStats) Add(other ItemStats) ItemStats {
+	return ItemStats{
+		Attack:     s.Attack + other.Attack,
+		Defense:    s.Defense + other.Defense,
+		MagicPower: s.MagicPower + other.MagicPower,
+	}
+}
+
+// AttackBonus calcula o bônus de ataque baseado na classe, nos atributos e no
+// bônus de proficiência das regras de progressão
+func AttackBonus(ruleset *ProgressionRuleset, class CharacterClass, level int, attributes *Attributes) int {
+	if attributes == nil {
+		return 0
+	}
+
+	// Bônus de atributo baseado na classe
+	var attrBonus int
+	switch class {
+	case Warrior:
+		attrBonus = (attributes.Strength - 10) / 2
+	case Ranger:
+		attrBonus = (attributes.Dexterity - 10) / 2
+	case Mage:
+		attrBonus = (attributes.Intelligence - 10) / 2
+	default:
+		return 0
+	}
+
+	return ruleset.ProficiencyBonus(level) + attrBonus
+}
+
+// SpellPower calcula o poder mágico da classe: o bônus de proficiência somado ao
+// modificador do atributo de conjuração. Classes que não conjuram têm poder 0
+func SpellPower(ruleset *ProgressionRuleset, class CharacterClass, level int, attributes *Attributes) int {
+	attribute, ok := spellcastingClasses[class]
+	if !ok || attributes == nil {
+		return 0
+	}
+	return ruleset.ProficiencyBonus(level) + (attributes.GetValue(attribute)-10)/2
+}
+
+// ProficiencyTier representa a categoria de treinamento exigida para usar um equipamento
+type ProficiencyTier string
+
+const (
+	LightArmor     ProficiencyTier = "lightArmor"
+	MediumArmor    ProficiencyTier = "mediumArmor"
+	HeavyArmor     ProficiencyTier = "heavyArmor"
+	Shields        ProficiencyTier = "shield"
+	SimpleWeapons  ProficiencyTier = "simpleWeapon"
+	MartialWeapons ProficiencyTier = "martialWeapon"
+)
+
+// ProficiencyTierNames são os nomes das categorias apresentados aos jogadores
+var ProficiencyTierNames = map[ProficiencyTier]string{
+	LightArmor:     "armaduras leves",
+	MediumArmor:    "armaduras médias",
+	HeavyArmor:     "armaduras pesadas",
+	Shields:        "escudos",
+	SimpleWeapons:  "armas simples",
+	MartialWeapons: "armas marciais",
+}
+
+// proficiencyTierTypes indica o tipo de item de cada categoria
+var proficiencyTierTypes = map[ProficiencyTier]ItemType{
+	LightArmor:     Armor,
+	MediumArmor:    Armor,
+	HeavyArmor:     Armor,
+	Shields:        Armor,
+	SimpleWeapons:  Weapon,
+	MartialWeapons: Weapon,
+}
+
+// ClassEquipmentProficiencies define as categorias de equipamento em que cada classe é treinada.
+// Classes que não usam armaduras em ClassEquipmentRestrictions não têm proficiência em nenhuma
+var ClassEquipmentProficiencies = map[CharacterClass][]ProficiencyTier{
+	Warrior:   {LightArmor, MediumArmor, HeavyArmor, Shields, SimpleWeapons, MartialWeapons},
+	Paladin:   {LightArmor, MediumArmor, HeavyArmor, Shields, SimpleWeapons, MartialWeapons},
+	Barbarian: {LightArmor, MediumArmor, Shields, SimpleWeapons, MartialWeapons},
+	Ranger:    {LightArmor, MediumArmor, Shields, SimpleWeapons, MartialWeapons},
+	Cleric:    {LightArmor, MediumArmor, Shields, SimpleWeapons},
+	Rogue:     {LightArmor, SimpleWeapons, MartialWeapons},
+	Mage:      {SimpleWeapons},
+	Druid:     {SimpleWeapons},
+	Monk:      {SimpleWeapons},
+	Bard:      {SimpleWeapons},
+	Warlock:   {SimpleWeapons},
+	Sorcerer:  {SimpleWeapons},
+}
+
+// IsValidProficiencyTier verifica se a categoria existe
+func IsValidProficiencyTier(tier ProficiencyTier) bool {
+	_, ok := proficiencyTierTypes[tier]
+	return ok
+}
+
+// ValidateProficiencyTier verifica se a categoria pode ser usada em um item do tipo informado.
+// Itens sem categoria não exigem treinamento
+func ValidateProficiencyTier(itemType ItemType, tier ProficiencyTier) error {
+	if tier == "" {
+		return nil
+	}
+	tierType, ok := proficiencyTierTypes[tier]
+	if !ok {
+		return fmt.Errorf("categoria de equipamento inválida: %s", tier)
+	}
+	if tierType != itemType {
+		return fmt.Errorf("a categoria %s não se aplica a itens do tipo %s", ProficiencyTierNames[tier], itemType)
+	}
+	return nil
+}
+
+// HasEquipmentProficiency indica se a classe é treinada na categoria de equipamento
+func HasEquipmentProficiency(class CharacterClass, tier ProficiencyTier) bool {
+	for _, t := range ClassEquipmentProficiencies[class] {
+		if t == tier {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateClassItem verifica se a classe pode usar um item: o tipo precisa ser
+// permitido para a classe e, se o item tiver categoria, a classe precisa ser treinada nela
+func ValidateClassItem(class CharacterClass, itemType ItemType, tier ProficiencyTier) error {
+	if err := ValidateClassEquipment(class, itemType); err != nil {
+		return err
+	}
+	if err := ValidateProficiencyTier(itemType, tier); err != nil {
+		return err
+	}
+	if tier != "" && !HasEquipmentProficiency(class, tier) {
+		return fmt.Errorf("a classe %s não tem proficiência em %s", class, ProficiencyTierNames[tier])
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package gamedata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompatibleSlots(t *testing.T) {
+	tests := []struct {
+		name string
+		slot EquipmentSlot
+		want []EquipmentSlot
+	}{
+		{name: "single slot", slot: Head, want: []EquipmentSlot{Head}},
+		{name: "rings are interchangeable", slot: Ring2, want: []EquipmentSlot{Ring1, Ring2}},
+		{name: "trinkets are interchangeable", slot: Trinket1, want: []EquipmentSlot{Trinket1, Trinket2}},
+		{name: "unknown slot", slot: "tail", want: nil},
+		{name: "empty slot", slot: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CompatibleSlots(tt.slot))
+		})
+	}
+}
+
+func TestSpellPower(t *testing.T) {
+	ruleset := DefaultProgression()
+	attributes := &Attributes{Strength: 16, Intelligence: 16, Wisdom: 14, Charisma: 8}
+
+	assert.Equal(t, 2+3, SpellPower(ruleset, Mage, 1, attributes))
+	assert.Equal(t, 3+2, SpellPower(ruleset, Cleric, 5, attributes))
+	assert.Equal(t, 2-1, SpellPower(ruleset, Bard, 1, attributes))
+	assert.Zero(t, SpellPower(ruleset, Warrior, 1, attributes), "warriors do not cast spells")
+	assert.Zero(t, SpellPower(ruleset, Mage, 1, nil))
+}
+
+func TestValidateClassItem(t *testing.T) {
+	tests := []struct {
+		name        string
+		class       CharacterClass
+		itemType    ItemType
+		tier        ProficiencyTier
+		wantErr     bool
+		errContains string
+	}{
+		{name: "warrior wears heavy armor", class: Warrior, itemType: Armor, tier: HeavyArmor},
+		{name: "item without tier", class: Rogue, itemType: Armor},
+		{name: "rogue cannot wear heavy armor", class: Rogue, itemType: Armor, tier: HeavyArmor, wantErr: true, errContains: "não tem proficiência em armaduras pesadas"},
+		{name: "cleric cannot use martial weapons", class: Cleric, itemType: Weapon, tier: MartialWeapons, wantErr: true, errContains: "armas marciais"},
+		{name: "mage uses simple weapons", class: Mage, itemType: Weapon, tier: SimpleWeapons},
+		{name: "mage cannot wear armor", class: Mage, itemType: Armor, tier: LightArmor, wantErr: true, errContains: "não pode usar equipamentos do tipo armor"},
+		{name: "tier does not match item type", class: Warrior, itemType: Weapon, tier: Shields, wantErr: true, errContains: "não se aplica"},
+		{name: "unknown tier", class: Warrior, itemType: Weapon, tier: "exotic", wantErr: true, errContains: "categoria de equipamento inválida"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateClassItem(tt.class, tt.itemType, tt.tier)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package gamedata
+
+// Recompensas de evolução de nível
+const (
+	AbilityPointsPerImprovement = 2 // Pontos de atributo ganhos em cada nível de melhoria
+	SkillPicksPerLevel          = 1 // Perícias de classe escolhidas em cada nível de perícia
+	StartingSkillPicks          = 2 // Perícias de classe escolhidas na criação do personagem
+)
+
+// AttributeNames lista os atributos na ordem em que são apresentados
+var AttributeNames = []string{"strength", "dexterity", "constitution", "intelligence", "wisdom", "charisma"}
+
+// AbilityScoreImprovementLevels são os níveis em que o personagem ganha pontos de atributo
+var AbilityScoreImprovementLevels = []int{4, 8, 12, 16, 19}
+
+// SkillProficiencyLevels são os níveis em que o personagem escolhe uma nova perícia de classe
+var SkillProficiencyLevels = []int{3, 6, 9, 12, 15, 18}
+
+// AbilityPointsAtLevel retorna os pontos de atributo ganhos ao alcançar o nível
+func AbilityPointsAtLevel(level int) int {
+	if containsLevel(AbilityScoreImprovementLevels, level) {
+		return AbilityPointsPerImprovement
+	}
+	return 0
+}
+
+// SkillPicksAtLevel retorna quantas perícias de classe são escolhidas ao alcançar o nível
+func SkillPicksAtLevel(level int) int {
+	if containsLevel(SkillProficiencyLevels, level) {
+		return SkillPicksPerLevel
+	}
+	return 0
+}
+
+// IsValidAttribute verifica se o nome é de um atributo
+func IsValidAttribute(attribute string) bool {
+	for _, name := range AttributeNames {
+		if name == attribute {
+			return true
+		}
+	}
+	return false
+}
+
+func containsLevel(levels []int, level int) bool {
+	for _, l := range levels {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package gamedata
+
+import (
+	"math"
+	"sort"
+)
+
+// DefaultProgressionID é o conjunto de regras de progressão usado quando o servidor não escolhe outro
+const DefaultProgressionID = "padrao"
+
+// ProgressionRuleset define as fórmulas de progressão de personagens: a
+// experiência de cada nível, os dados de vida das classes e o bônus de proficiência
+type ProgressionRuleset struct {
+	ID            string
+	Name          string
+	Description   string
+	ExpTable      []int                  // Experiência total para alcançar cada nível, a partir do nível 1
+	HitDice       map[CharacterClass]int // Dado de vida de cada classe
+	DefaultHitDie int                    // Dado de vida de personagens sem classe conhecida
+	MaxHitPoints  bool                   // Se todo nível concede o valor máximo do dado, em vez da média
+	Proficiency   []int                  // Bônus de proficiência de cada nível, a partir do nível 1
+}
+
+// classHitDice são os dados de vida de cada classe
+var classHitDice = map[CharacterClass]int{
+	Barbarian: 12,
+	Warrior:   10,
+	Paladin:   10,
+	Ranger:    10,
+	Bard:      8,
+	Cleric:    8,
+	Druid:     8,
+	Monk:      8,
+	Rogue:     8,
+	Warlock:   8,
+	Mage:      6,
+	Sorcerer:  6,
+}
+
+// progressionRulesets são os conjuntos de regras disponíveis, por ID
+var progressionRulesets = map[string]*ProgressionRuleset{
+	DefaultProgressionID: {
+		ID:          DefaultProgressionID,
+		Name:        "Padrão",
+		Description: "Experiência linear (100 por nível) e vida pela média do dado de vida",
+		ExpTable: levelTable(func(level int) int {
+			return 100 * level
+		}),
+		HitDice:       classHitDice,
+		DefaultHitDie: 8,
+		Proficiency:   levelTable(standardProficiency),
+	},
+	"epico": {
+		ID:          "epico",
+		Name:        "Épico",
+		Description: "Experiência crescente (50% a mais por nível) e vida máxima do dado de vida em todo nível",
+		ExpTable: levelTable(func(level int) int {
+			// O nível 2 custa 100 de experiência
+			return int(100 * math.Pow(1.5, float64(level-2)))
+		}),
+		HitDice:       classHitDice,
+		DefaultHitDie: 8,
+		MaxHitPoints:  true,
+		Proficiency:   levelTable(standardProficiency),
+	},
+}
+
+// GetProgressionRuleset retorna o conjunto de regras de progressão com o ID informado
+func GetProgressionRuleset(id string) (*ProgressionRuleset, bool) {
+	ruleset, ok := progressionRulesets[id]
+	return ruleset, ok
+}
+
+// ProgressionRulesetOrDefault retorna o conjunto de regras com o ID informado ou o padrão
+func ProgressionRulesetOrDefault(id string) *ProgressionRuleset {
+	if ruleset, ok := progressionRulesets[id]; ok {
+		return ruleset
+	}
+	return progressionRulesets[DefaultProgressionID]
+}
+
+// DefaultProgression retorna o conjunto de regras de progressão padrão
+func DefaultProgression() *ProgressionRuleset {
+	return progressionRulesets[DefaultProgressionID]
+}
+
+// ProgressionRulesets lista os conjuntos de regras disponíveis, o padrão primeiro
+func ProgressionRulesets() []*ProgressionRuleset {
+	rulesets := make([]*ProgressionRuleset, 0, len(progressionRulesets))
+	for _, ruleset := range progressionRulesets {
+		rulesets = append(rulesets, ruleset)
+	}
+	sort.Slice(rulesets, func(i, j int) bool {
+		if rulesets[i].ID == DefaultProgressionID || rulesets[j].ID == DefaultProgressionID {
+			return rulesets[i].ID == DefaultProgressionID
+		}
+		return rulesets[i].ID < rulesets[j].ID
+	})
+	return rulesets
+}
+
+// ExpForLevel retorna a experiência total necessária para alcançar o nível.
+// Retorna -1 para níveis acima do máximo
+func (r *ProgressionRuleset) ExpForLevel(level int) int {
+	if level <= StartingLevel {
+		return 0
+	}
+	if level > MaxLevel {
+		return -1
+	}
+	return r.ExpTable[level-1]
+}
+
+// LevelForExp retorna o maior nível alcançado com a experiência informada
+func (r *ProgressionRuleset) LevelForExp(exp int) int {
+	level := StartingLevel
+	for level < MaxLevel && exp >= r.ExpForLevel(level+1) {
+		level++
+	}
+	return level
+}
+
+// HitDie retorna o dado de vida da classe
+func (r *ProgressionRuleset) HitDie(class CharacterClass) int {
+	if die, ok := r.HitDice[class]; ok {
+		return die
+	}
+	return r.DefaultHitDie
+}
+
+// MaxHealth calcula a vida máxima: o dado de vida completo no primeiro nível e,
+// a cada nível seguinte, a média ou o máximo do dado, somados ao modificador
+// de constituição. Cada nível concede pelo menos 1 ponto de vida
+func (r *ProgressionRuleset) MaxHealth(class CharacterClass, level, constitution int) int {
+	if level < StartingLevel || level > MaxLevel {
+		return 0
+	}
+
+	die := r.HitDie(class)
+	constitutionMod := (constitution - 10) / 2
+	perLevel := die/2 + 1
+	if r.MaxHitPoints {
+		perLevel = die
+	}
+
+	return max(die+constitutionMod, 1) + (level-1)*max(perLevel+constitutionMod, 1)
+}
+
+// ProficiencyBonus retorna o bônus de proficiência do nível
+func (r *ProgressionRuleset) ProficiencyBonus(level int) int {
+	level = min(max(level, StartingLevel), MaxLevel)
+	return r.Proficiency[level-1]
+}
+
+// levelTable monta uma tabela com um valor para cada nível, a partir do nível 1
+func levelTable(value func(level int) int) []int {
+	table := make([]int, MaxLevel)
+	for level := StartingLevel; level <= MaxLevel; level++ {
+		table[level-1] = value(level)
+	}
+	return table
+}
+
+// standardProficiency é o bônus de proficiência que começa em +2 e sobe a cada quatro níveis
+func standardProficiency(level int) int {
+	return 2 + (level-1)/4
+}
@@ -0,0 +1,205 @@
+package gamedata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressionRuleset_ExpForLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		ruleset string
+		level   int
+		wantExp int
+	}{
+		{
+			name:    "level 1 requires no exp",
+			ruleset: DefaultProgressionID,
+			level:   1,
+			wantExp: 0,
+		},
+		{
+			name:    "standard level 2 requires 200 exp",
+			ruleset: DefaultProgressionID,
+			level:   2,
+			wantExp: 200,
+		},
+		{
+			name:    "standard max level requires max exp",
+			ruleset: DefaultProgressionID,
+			level:   MaxLevel,
+			wantExp: MaxLevel * 100,
+		},
+		{
+			name:    "epic level 2 requires 100 exp",
+			ruleset: "epico",
+			level:   2,
+			wantExp: 100,
+		},
+		{
+			name:    "epic level 3 requires 150 exp",
+			ruleset: "epico",
+			level:   3,
+			wantExp: 150,
+		},
+		{
+			name:    "above max level returns -1",
+			ruleset: DefaultProgressionID,
+			level:   MaxLevel + 1,
+			wantExp: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleset, ok := GetProgressionRuleset(tt.ruleset)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantExp, ruleset.ExpForLevel(tt.level))
+		})
+	}
+}
+
+func TestProgressionRuleset_LevelForExp(t *testing.T) {
+	tests := []struct {
+		name      string
+		exp       int
+		wantLevel int
+	}{
+		{
+			name:      "no exp is level 1",
+			exp:       0,
+			wantLevel: 1,
+		},
+		{
+			name:      "just below a threshold keeps the level",
+			exp:       299,
+			wantLevel: 2,
+		},
+		{
+			name:      "exactly at a threshold reaches the level",
+			exp:       300,
+			wantLevel: 3,
+		},
+		{
+			name:      "exp beyond the table stops at max level",
+			exp:       1000000,
+			wantLevel: MaxLevel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantLevel, DefaultProgression().LevelForExp(tt.exp))
+		})
+	}
+}
+
+func TestProgressionRuleset_MaxHealth(t *testing.T) {
+	tests := []struct {
+		name         string
+		ruleset      string
+		class        CharacterClass
+		level        int
+		constitution int
+		wantHealth   int
+	}{
+		{
+			name:         "warrior level 1 with 10 constitution",
+			ruleset:      DefaultProgressionID,
+			class:        Warrior,
+			level:        1,
+			constitution: 10,
+			wantHealth:   10, // d10
+		},
+		{
+			name:         "warrior level 5 with 14 constitution",
+			ruleset:      DefaultProgressionID,
+			class:        Warrior,
+			level:        5,
+			constitution: 14,
+			wantHealth:   44, // 10+2 + 4*(6+2)
+		},
+		{
+			name:         "mage level 3 with minimum constitution",
+			ruleset:      DefaultProgressionID,
+			class:        Mage,
+			level:        3,
+			constitution: MinAttributeValue,
+			wantHealth:   11, // 6-1 + 2*(4-1)
+		},
+		{
+			name:         "unknown class uses the default hit die",
+			ruleset:      DefaultProgressionID,
+			class:        "",
+			level:        1,
+			constitution: 10,
+			wantHealth:   8,
+		},
+		{
+			name:         "epic barbarian gets the maximum of the die",
+			ruleset:      "epico",
+			class:        Barbarian,
+			level:        2,
+			constitution: 16,
+			wantHealth:   30, // 2*(12+3)
+		},
+		{
+			name:         "every level grants at least 1 health",
+			ruleset:      DefaultProgressionID,
+			class:        Mage,
+			level:        2,
+			constitution: -10,
+			wantHealth:   2,
+		},
+		{
+			name:         "invalid level returns 0",
+			ruleset:      DefaultProgressionID,
+			class:        Warrior,
+			level:        0,
+			constitution: 10,
+			wantHealth:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleset, ok := GetProgressionRuleset(tt.ruleset)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantHealth, ruleset.MaxHealth(tt.class, tt.level, tt.constitution))
+		})
+	}
+}
+
+func TestProgressionRuleset_ProficiencyBonus(t *testing.T) {
+	tests := []struct {
+		level int
+		want  int
+	}{
+		{level: 1, want: 2},
+		{level: 4, want: 2},
+		{level: 5, want: 3},
+		{level: 17, want: 6},
+		{level: MaxLevel, want: 6},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, DefaultProgression().ProficiencyBonus(tt.level), "level %d", tt.level)
+	}
+}
+
+func TestProgressionRulesets(t *testing.T) {
+	rulesets := ProgressionRulesets()
+	assert.NotEmpty(t, rulesets)
+	assert.Equal(t, DefaultProgressionID, rulesets[0].ID)
+
+	for _, ruleset := range rulesets {
+		assert.Len(t, ruleset.ExpTable, MaxLevel, ruleset.ID)
+		assert.Len(t, ruleset.Proficiency, MaxLevel, ruleset.ID)
+		for level := StartingLevel + 1; level <= MaxLevel; level++ {
+			assert.Greater(t, ruleset.ExpForLevel(level), ruleset.ExpForLevel(level-1), "%s level %d", ruleset.ID, level)
+		}
+	}
+
+	assert.Equal(t, DefaultProgression(), ProgressionRulesetOrDefault("desconhecido"))
+}
@@ -0,0 +1,46 @@
+package gamedata
+
+import "time"
+
+// RestType identifica um tipo de descanso
+type RestType string
+
+const (
+	ShortRest RestType = "curto" // Gasta dados de vida para recuperar vida
+	LongRest  RestType = "longo" // Recupera toda a vida e parte dos dados de vida
+)
+
+// RestTypeNames são os nomes dos descansos apresentados aos jogadores
+var RestTypeNames = map[RestType]string{
+	ShortRest: "Descanso curto",
+	LongRest:  "Descanso longo",
+}
+
+// restCooldowns é o intervalo mínimo entre dois descansos do mesmo tipo
+var restCooldowns = map[RestType]time.Duration{
+	ShortRest: time.Hour,
+	LongRest:  24 * time.Hour,
+}
+
+// IsValidRestType verifica se o tipo de descanso existe
+func IsValidRestType(rest RestType) bool {
+	_, ok := restCooldowns[rest]
+	return ok
+}
+
+// RestCooldown retorna o intervalo mínimo entre dois descansos do tipo informado
+func RestCooldown(rest RestType) time.Duration {
+	return restCooldowns[rest]
+}
+
+const (
+	DeathSaveDC         = 10 // Resultado mínimo do d20 para um sucesso no teste contra a morte
+	DeathSavesToRecover = 3  // Sucessos para estabilizar
+	DeathSavesToDie     = 3  // Falhas para morrer
+)
+
+// HitDiceRecovered retorna quantos dados de vida um descanso longo devolve:
+// metade do nível, no mínimo um
+func HitDiceRecovered(level int) int {
+	return max(level/2, 1)
+}
@@ -0,0 +1,60 @@
+package gamedata
+
+// ClassSavingThrows define os atributos em que cada classe tem proficiência nas salvaguardas
+var ClassSavingThrows = map[CharacterClass][]string{
+	Warrior:   {"strength", "constitution"},
+	Mage:      {"intelligence", "wisdom"},
+	Rogue:     {"dexterity", "intelligence"},
+	Cleric:    {"wisdom", "charisma"},
+	Ranger:    {"strength", "dexterity"},
+	Paladin:   {"wisdom", "charisma"},
+	Druid:     {"intelligence", "wisdom"},
+	Barbarian: {"strength", "constitution"},
+	Monk:      {"strength", "dexterity"},
+	Bard:      {"dexterity", "charisma"},
+	Warlock:   {"wisdom", "charisma"},
+	Sorcerer:  {"constitution", "charisma"},
+}
+
+// GetSavingThrowsForClass retorna os atributos em que a classe tem proficiência nas salvaguardas
+func GetSavingThrowsForClass(class CharacterClass) []string {
+	saves, exists := ClassSavingThrows[class]
+	if !exists {
+		return []string{}
+	}
+	return saves
+}
+
+// HasSavingThrowProficiency verifica se a classe tem proficiência na salvaguarda do atributo
+func HasSavingThrowProficiency(class CharacterClass, attribute string) bool {
+	for _, save := range GetSavingThrowsForClass(class) {
+		if save == attribute {
+			return true
+		}
+	}
+	return false
+}
+
+// AttributeCheckModifier calcula o modificador de um teste de atributo, que
+// não recebe bônus de proficiência
+func AttributeCheckModifier(attribute string, attributes *Attributes) int {
+	if !IsValidAttribute(attribute) {
+		return 0
+	}
+	return AttributeModifier(attributes.GetValue(attribute))
+}
+
+// CalculateSavingThrowModifier calcula o modificador de uma salvaguarda com as regras de progressão padrão
+func CalculateSavingThrowModifier(class CharacterClass, attribute string, attributes *Attributes, level int) int {
+	return DefaultProgression().SavingThrowModifier(class, attribute, attributes, level)
+}
+
+// SavingThrowModifier calcula o modificador de uma salvaguarda: o modificador do
+// atributo mais o bônus de proficiência das regras, se a classe for proficiente
+func (r *ProgressionRuleset) SavingThrowModifier(class CharacterClass, attribute string, attributes *Attributes, level int) int {
+	modifier := AttributeCheckModifier(attribute, attributes)
+	if IsValidAttribute(attribute) && HasSavingThrowProficiency(class, attribute) {
+		modifier += r.ProficiencyBonus(level)
+	}
+	return modifier
+}
@@ -0,0 +1,50 @@
+package gamedata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassSavingThrows(t *testing.T) {
+	for _, class := range []CharacterClass{Warrior, Mage, Rogue, Cleric, Ranger, Paladin, Druid, Barbarian, Monk, Bard, Warlock, Sorcerer} {
+		saves := GetSavingThrowsForClass(class)
+		assert.Len(t, saves, 2, "class %s", class)
+		for _, attribute := range saves {
+			assert.True(t, IsValidAttribute(attribute), "class %s has invalid save %q", class, attribute)
+		}
+	}
+
+	assert.Empty(t, GetSavingThrowsForClass("invalid"))
+	assert.True(t, HasSavingThrowProficiency(Rogue, "dexterity"))
+	assert.False(t, HasSavingThrowProficiency(Rogue, "strength"))
+}
+
+func TestCalculateSavingThrowModifier(t *testing.T) {
+	attributes := &Attributes{Strength: 16, Dexterity: 8, Wisdom: 13}
+
+	tests := []struct {
+		name      string
+		class     CharacterClass
+		attribute string
+		level     int
+		want      int
+	}{
+		{name: "proficient strength at level 1", class: Warrior, attribute: "strength", level: 1, want: 5}, // 3 + 2
+		{name: "proficient strength at level 5", class: Warrior, attribute: "strength", level: 5, want: 6}, // 3 + 3
+		{name: "not proficient", class: Mage, attribute: "strength", level: 5, want: 3},                    // 3
+		{name: "proficiency over a penalty", class: Monk, attribute: "dexterity", level: 1, want: 1},       // -1 + 2
+		{name: "odd attribute rounds down", class: Warrior, attribute: "wisdom", level: 1, want: 1},        // 13 -> 1
+		{name: "invalid attribute returns 0", class: Warrior, attribute: "luck", level: 1, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CalculateSavingThrowModifier(tt.class, tt.attribute, attributes, tt.level))
+		})
+	}
+
+	assert.Equal(t, 3, AttributeCheckModifier("strength", attributes))
+	assert.Equal(t, -1, AttributeCheckModifier("dexterity", attributes))
+	assert.Equal(t, 0, AttributeCheckModifier("luck", attributes))
+}
@@ -1,5 +1,7 @@
 package gamedata
 
+import "strings"
+
 // Skill representa uma perícia
 type Skill string
 
@@ -148,8 +150,13 @@ type SkillProficiency struct {
 	Bonus        int   `bson:"bonus" json:"bonus"`               // Bônus adicional
 }
 
-// CalculateSkillModifier calcula o modificador total de uma perícia
+// CalculateSkillModifier calcula o modificador total de uma perícia com as regras de progressão padrão
 func CalculateSkillModifier(skill Skill, attributes *Attributes, proficiency *SkillProficiency, level int) int {
+	return DefaultProgression().SkillModifier(skill, attributes, proficiency, level)
+}
+
+// SkillModifier calcula o modificador total de uma perícia com o bônus de proficiência das regras
+func (r *ProgressionRuleset) SkillModifier(skill Skill, attributes *Attributes, proficiency *SkillProficiency, level int) int {
 	// Obter o atributo base da perícia
 	baseAttr := SkillBaseAttribute[skill]
 	if baseAttr == "" {
@@ -157,14 +164,11 @@ func CalculateSkillModifier(skill Skill, attributes *Attributes, proficiency *Sk
 	}
 
 	// Calcular o modificador do atributo base
-	attrValue := attributes.GetValue(baseAttr)
-	attrMod := (attrValue - 10) / 2
+	attrMod := AttributeModifier(attributes.GetValue(baseAttr))
 
 	// Adicionar bônus de proficiência se aplicável
 	if proficiency != nil && proficiency.IsProficient {
-		// Bônus de proficiência base é 2 + (level / 4)
-		profBonus := 2 + (level / 4)
-		attrMod += profBonus
+		attrMod += r.ProficiencyBonus(level)
 	}
 
 	// Adicionar bônus extras da perícia
@@ -175,6 +179,17 @@ func CalculateSkillModifier(skill Skill, attributes *Attributes, proficiency *Sk
 	return attrMod
 }
 
+// FindSkill busca uma perícia pelo identificador, sem diferenciar maiúsculas
+func FindSkill(name string) (Skill, bool) {
+	name = strings.TrimSpace(name)
+	for skill := range SkillBaseAttribute {
+		if strings.EqualFold(string(skill), name) {
+			return skill, true
+		}
+	}
+	return "", false
+}
+
 // GetSkillsForClass retorna as perícias disponíveis para uma classe
 func GetSkillsForClass(class CharacterClass) []Skill {
 	skills, exists := ClassSkillProficiencies[class]
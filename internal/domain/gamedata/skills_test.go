@@ -53,7 +53,7 @@ func TestCalculateSkillModifier(t *testing.T) {
 				Bonus:        1, // Bônus adicional
 			},
 			level: 5,
-			want:  7, // (18-10)/2 + 3 (proficiency at level 5) + 1 (bonus)
+			want:  8, // (18-10)/2 + 3 (proficiency at level 5) + 1 (bonus)
 		},
 		{
 			name:  "arcana with intelligence 14, proficient",
@@ -1,6 +1,11 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/gamedata"
+)
 
 // GuildConfig representa as configurações específicas de um servidor
 type GuildConfig struct {
@@ -8,8 +13,15 @@ type GuildConfig struct {
 	Prefix         string    `bson:"prefix"`          // Prefixo de comandos personalizado
 	WelcomeChannel string    `bson:"welcome_channel"` // Canal para mensagens de boas-vindas
 	GoodbyeChannel string    `bson:"goodbye_channel"` // Canal para mensagens de despedida
+	MaxCharacters  int       `bson:"max_characters"`  // Personagens por usuário, 0 para o padrão
+	QuestChannel   string    `bson:"quest_channel"`   // Canal para o anúncio das missões renovadas
+	Progression    string    `bson:"progression"`     // Regras de progressão dos personagens, vazio para as padrão
+	Permadeath     bool      `bson:"permadeath"`      // Se personagens que falham nos testes contra a morte morrem de vez
+	RollAudit      bool      `bson:"roll_audit"`      // Se as rolagens dos jogadores são registradas para conferência
 	CreatedAt      time.Time `bson:"created_at"`      // Data de criação
 	UpdatedAt      time.Time `bson:"updated_at"`      // Data da última atualização
+
+	DeckRules *entities.DeckConfig `bson:"deck_rules,omitempty"` // Regras de montagem de decks
 }
 
 // NewGuildConfig cria uma nova configuração de servidor com valores padrão
@@ -24,3 +36,38 @@ func NewGuildConfig(guildID string) *GuildConfig {
 		UpdatedAt:      now,
 	}
 }
+
+// DefaultMaxCharacters é o limite padrão de personagens por usuário em um servidor
+const DefaultMaxCharacters = 3
+
+// GetMaxCharacters retorna o limite de personagens por usuário do servidor
+func (c *GuildConfig) GetMaxCharacters() int {
+	if c.MaxCharacters <= 0 {
+		return DefaultMaxCharacters
+	}
+	return c.MaxCharacters
+}
+
+// GetProgression retorna as regras de progressão dos personagens do servidor
+func (c *GuildConfig) GetProgression() *gamedata.ProgressionRuleset {
+	return gamedata.ProgressionRulesetOrDefault(c.Progression)
+}
+
+// GetDeckRules retorna as regras de deck do servidor ou as regras padrão
+func (c *GuildConfig) GetDeckRules() *entities.DeckConfig {
+	if c.DeckRules == nil {
+		return entities.DefaultDeckConfig()
+	}
+
+	rules := *c.DeckRules
+	if rules.CardLimits == nil {
+		rules.CardLimits = make(map[string]int)
+	}
+	if rules.ClassLimits == nil {
+		rules.ClassLimits = make(map[string]int)
+	}
+	if rules.RarityLimits == nil {
+		rules.RarityLimits = make(map[entities.CardRarity]int)
+	}
+	return &rules
+}
@@ -0,0 +1,62 @@
+package quests
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"time"
+
+	"sirdraith/internal/domain/entities"
+)
+
+// Quantidade de missões oferecidas em cada renovação
+const (
+	DailyQuestCount  = 3
+	WeeklyQuestCount = 2
+)
+
+// RotationSize retorna quantas missões são oferecidas em cada renovação da frequência
+func RotationSize(cadence entities.QuestCadence) int {
+	if cadence == entities.CadenceWeekly {
+		return WeeklyQuestCount
+	}
+	return DailyQuestCount
+}
+
+// Rotation sorteia as missões da frequência oferecidas a um servidor no período
+// que contém o momento informado. O sorteio depende apenas do servidor e do
+// período, então repetir a renovação de um período gera as mesmas missões
+func Rotation(pool []*entities.Quest, guildID string, cadence entities.QuestCadence, now time.Time) []*entities.Quest {
+	var candidates []*entities.Quest
+	for _, quest := range pool {
+		if quest.Cadence == cadence {
+			candidates = append(candidates, quest)
+		}
+	}
+
+	period := cadence.PeriodStart(now)
+	rng := rand.New(rand.NewSource(rotationSeed(guildID, cadence, period)))
+	rng.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+
+	if size := RotationSize(cadence); len(candidates) > size {
+		candidates = candidates[:size]
+	}
+
+	expiresAt := cadence.PeriodEnd(now)
+	rotation := make([]*entities.Quest, 0, len(candidates))
+	for _, template := range candidates {
+		quest := *template
+		quest.GuildID = guildID
+		quest.ExpiresAt = &expiresAt
+		rotation = append(rotation, &quest)
+	}
+	return rotation
+}
+
+// rotationSeed gera a semente do sorteio de um servidor em um período
+func rotationSeed(guildID string, cadence entities.QuestCadence, period time.Time) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(entities.QuestScheduleID(guildID, cadence)))
+	return int64(h.Sum64()) ^ period.Unix()
+}
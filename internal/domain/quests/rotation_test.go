@@ -0,0 +1,61 @@
+package quests
+
+import (
+	"testing"
+	"time"
+
+	"sirdraith/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func titles(quests []*entities.Quest) []string {
+	var result []string
+	for _, q := range quests {
+		result = append(result, q.Title)
+	}
+	return result
+}
+
+func TestTemplates(t *testing.T) {
+	counts := map[entities.QuestCadence]int{}
+	for _, quest := range Templates() {
+		require.NotEmpty(t, quest.Objectives, quest.Title)
+		require.Contains(t, entities.Cadences, quest.Cadence, quest.Title)
+		counts[quest.Cadence]++
+	}
+	for _, cadence := range entities.Cadences {
+		assert.Greater(t, counts[cadence], RotationSize(cadence), "o repertório deve ser maior que a renovação de %s", cadence)
+	}
+}
+
+func TestRotation(t *testing.T) {
+	now := time.Date(2025, time.October, 16, 18, 30, 0, 0, time.UTC)
+
+	for _, cadence := range entities.Cadences {
+		t.Run(string(cadence), func(t *testing.T) {
+			rotation := Rotation(Templates(), "guild-1", cadence, now)
+			require.Len(t, rotation, RotationSize(cadence))
+			for _, quest := range rotation {
+				assert.Equal(t, cadence, quest.Cadence)
+				assert.Equal(t, "guild-1", quest.GuildID)
+				require.NotNil(t, quest.ExpiresAt)
+				assert.Equal(t, cadence.PeriodEnd(now), *quest.ExpiresAt)
+			}
+
+			// O mesmo período sempre sorteia as mesmas missões
+			again := Rotation(Templates(), "guild-1", cadence, cadence.PeriodStart(now))
+			assert.Equal(t, titles(rotation), titles(again))
+		})
+	}
+}
+
+func TestRotation_DoesNotModifyPool(t *testing.T) {
+	pool := Templates()
+	Rotation(pool, "guild-1", entities.CadenceDaily, time.Now())
+	for _, quest := range pool {
+		assert.Empty(t, quest.GuildID)
+		assert.Nil(t, quest.ExpiresAt)
+	}
+}
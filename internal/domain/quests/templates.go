@@ -0,0 +1,125 @@
+package quests
+
+import (
+	"time"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/gamedata"
+)
+
+// Templates retorna o repertório de missões oferecidas aos servidores, cada uma
+// com sua frequência de renovação. Cada chamada devolve cópias novas, sem ID
+// nem servidor definidos
+func Templates() []*entities.Quest {
+	return []*entities.Quest{
+		{
+			Title:       "Ratos no Porão",
+			Cadence:     entities.CadenceDaily,
+			Description: "O taverneiro reclama de ratos enormes roendo seus barris.",
+			Objectives: []entities.QuestObjective{
+				{Type: entities.ObjectiveDefeat, Target: "rato-gigante", Amount: 3},
+			},
+			Reward:   entities.QuestReward{Experience: 40, Gold: 15},
+			MinLevel: 1,
+			Duration: 24 * time.Hour,
+		},
+		{
+			Title:       "Patrulha da Estrada",
+			Cadence:     entities.CadenceDaily,
+			Description: "Bandidos e goblins atacam as caravanas. Limpe a estrada do rei.",
+			Objectives: []entities.QuestObjective{
+				{Type: entities.ObjectiveDefeat, Amount: 5},
+			},
+			Reward:   entities.QuestReward{Experience: 80, Gold: 30},
+			MinLevel: 1,
+			Duration: 48 * time.Hour,
+		},
+		{
+			Title:       "Peles para o Curtidor",
+			Cadence:     entities.CadenceDaily,
+			Description: "O curtidor da vila paga bem por peles de lobo em bom estado.",
+			Objectives: []entities.QuestObjective{
+				{Type: entities.ObjectiveCollect, Target: "Pele de Lobo", Amount: 2},
+			},
+			Reward: entities.QuestReward{
+				Experience: 60,
+				Gold:       40,
+				Items: []entities.Item{
+					{Name: "Capa de Couro", Description: "Uma capa resistente feita pelo curtidor.", Quantity: 1, Type: gamedata.Armor, Value: 20, Slot: gamedata.Chest, Proficiency: gamedata.LightArmor, Rarity: gamedata.Common, Stats: gamedata.ItemStats{Defense: 1}},
+				},
+			},
+			MinLevel: 1,
+			Duration: 72 * time.Hour,
+		},
+		{
+			Title:       "O Mapa Apagado",
+			Cadence:     entities.CadenceDaily,
+			Description: "Um velho mapa do tesouro está quase ilegível. Decifre-o antes que se perca.",
+			Objectives: []entities.QuestObjective{
+				{Type: entities.ObjectiveSkillCheck, Target: string(gamedata.Investigation), Difficulty: 13},
+			},
+			Reward:   entities.QuestReward{Experience: 50, Gold: 25},
+			MinLevel: 1,
+			Duration: 24 * time.Hour,
+		},
+		{
+			Title:       "Pedágio Ilegal",
+			Description: "Bandidos cobram pedágio na ponte velha. Convença-os a partir ou expulse-os à força.",
+			Cadence:     entities.CadenceDaily,
+			Objectives: []entities.QuestObjective{
+				{Type: entities.ObjectiveSkillCheck, Target: string(gamedata.Intimidation), Difficulty: 12},
+				{Type: entities.ObjectiveDefeat, Target: "bandido", Amount: 2},
+			},
+			Reward:   entities.QuestReward{Experience: 90, Gold: 45},
+			MinLevel: 2,
+			Duration: 24 * time.Hour,
+		},
+		{
+			Title:       "A Cripta Inquieta",
+			Cadence:     entities.CadenceWeekly,
+			Description: "Os mortos não descansam no cemitério da colina. Descubra o motivo e devolva-os à terra.",
+			Objectives: []entities.QuestObjective{
+				{Type: entities.ObjectiveSkillCheck, Target: string(gamedata.Religion), Difficulty: 14},
+				{Type: entities.ObjectiveDefeat, Target: "esqueleto", Amount: 4},
+			},
+			Reward:   entities.QuestReward{Experience: 150, Gold: 60},
+			MinLevel: 2,
+			Duration: 72 * time.Hour,
+		},
+		{
+			Title:       "A Fúria do Ogro",
+			Cadence:     entities.CadenceWeekly,
+			Description: "Um ogro desceu das montanhas e devora o gado dos fazendeiros.",
+			Objectives: []entities.QuestObjective{
+				{Type: entities.ObjectiveDefeat, Target: "ogro", Amount: 1},
+				{Type: entities.ObjectiveCollect, Target: "Bolsa do Ogro", Amount: 1},
+			},
+			Reward:   entities.QuestReward{Experience: 300, Gold: 120},
+			MinLevel: 5,
+			Duration: 96 * time.Hour,
+		},
+		{
+			Title:       "A Teia na Mina",
+			Description: "Os mineiros abandonaram a galeria norte depois que aranhas gigantes fizeram ninho lá.",
+			Cadence:     entities.CadenceWeekly,
+			Objectives: []entities.QuestObjective{
+				{Type: entities.ObjectiveDefeat, Target: "aranha-gigante", Amount: 3},
+			},
+			Reward:   entities.QuestReward{Experience: 220, Gold: 90},
+			MinLevel: 3,
+			Duration: 96 * time.Hour,
+		},
+		{
+			Title:       "O Troll da Ponte",
+			Description: "Um troll tomou a ponte do rio e ninguém mais atravessa. Rastreie-o e acabe com ele.",
+			Cadence:     entities.CadenceWeekly,
+			Objectives: []entities.QuestObjective{
+				{Type: entities.ObjectiveSkillCheck, Target: string(gamedata.Survival), Difficulty: 15},
+				{Type: entities.ObjectiveDefeat, Target: "troll", Amount: 1},
+			},
+			Reward:   entities.QuestReward{Experience: 500, Gold: 200},
+			MinLevel: 9,
+			Duration: 120 * time.Hour,
+		},
+	}
+}
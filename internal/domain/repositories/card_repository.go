@@ -6,6 +6,16 @@ import (
 	"sirdraith/internal/domain/entities"
 )
 
+// CardFilter holds the criteria for searching cards. Zero values are ignored.
+type CardFilter struct {
+	Name    string              // Case-insensitive substring of the card name
+	Type    entities.CardType   // Exact card type
+	Rarity  entities.CardRarity // Exact card rarity
+	MinCost *int                // Minimum cost, inclusive
+	MaxCost *int                // Maximum cost, inclusive
+	Keyword string              // Keyword the card must have
+}
+
 // CardRepository defines the interface for card data persistence
 type CardRepository interface {
 	// Create stores a new card in the repository
@@ -14,6 +24,9 @@ type CardRepository interface {
 	// Update modifies an existing card in the repository
 	Update(ctx context.Context, card *entities.Card) error
 
+	// Upsert creates the card or replaces the existing card with the same ID
+	Upsert(ctx context.Context, card *entities.Card) error
+
 	// Delete removes a card from the repository
 	Delete(ctx context.Context, id string) error
 
@@ -28,4 +41,8 @@ type CardRepository interface {
 
 	// FindByRarity retrieves all cards of a specific rarity
 	FindByRarity(ctx context.Context, rarity entities.CardRarity) ([]*entities.Card, error)
+
+	// Search retrieves one page of cards matching the filter, ordered by name,
+	// along with the total number of matching cards. Pages start at 1.
+	Search(ctx context.Context, filter CardFilter, page, pageSize int) ([]*entities.Card, int64, error)
 }
@@ -20,12 +20,21 @@ type CharacterRepository interface {
 	// GetByGuildID busca todos os personagens de uma guilda
 	GetByGuildID(ctx context.Context, guildID string) ([]*entities.Character, error)
 
-	// GetByUserAndGuild busca um personagem específico de um usuário em uma guilda
+	// GetByUserAndGuild busca o personagem selecionado de um usuário em uma guilda
 	GetByUserAndGuild(ctx context.Context, userID, guildID string) (*entities.Character, error)
 
-	// Update atualiza um personagem existente
+	// Update atualiza um personagem existente. Retorna entities.ErrCharacterInCombat
+	// se o personagem salvo estiver em combate
 	Update(ctx context.Context, character *entities.Character) error
 
+	// SetInCombat marca ou desmarca o personagem como em combate
+	SetInCombat(ctx context.Context, id string, inCombat bool) error
+
+	// EndCombat salva o que um combate altera no personagem (estado de combate,
+	// condições, nível, experiência, escolhas pendentes e inventário), soma o
+	// ouro ganho ao atual e o desmarca como em combate
+	EndCombat(ctx context.Context, character *entities.Character, gold int) error
+
 	// Delete remove um personagem
 	Delete(ctx context.Context, id string) error
 
@@ -46,4 +55,13 @@ type CharacterRepository interface {
 
 	// CountByUser conta o número de personagens de um usuário
 	CountByUser(ctx context.Context, userID string) (int64, error)
+
+	// ListByUserAndGuild lista os personagens de um usuário em uma guilda
+	ListByUserAndGuild(ctx context.Context, userID, guildID string) ([]*entities.Character, error)
+
+	// CountByUserAndGuild conta o número de personagens de um usuário em uma guilda
+	CountByUserAndGuild(ctx context.Context, userID, guildID string) (int64, error)
+
+	// SetSelected seleciona um personagem do usuário na guilda, desmarcando os demais
+	SetSelected(ctx context.Context, userID, guildID, characterID string) error
 }
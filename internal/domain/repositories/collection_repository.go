@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"sirdraith/internal/domain/entities"
+)
+
+// CollectionRepository define a interface para persistência de coleções de cartas
+type CollectionRepository interface {
+	// FindByCharacter busca a coleção de um personagem
+	FindByCharacter(ctx context.Context, characterID string) (*entities.CardCollection, error)
+
+	// Save cria ou atualiza a coleção de um personagem
+	Save(ctx context.Context, collection *entities.CardCollection) error
+}
@@ -20,8 +20,8 @@ type DeckRepository interface {
 	// FindByID busca um deck pelo ID
 	FindByID(ctx context.Context, id string) (*entities.Deck, error)
 
-	// FindByUser busca todos os decks de um usuário
-	FindByUser(ctx context.Context, userID string) ([]*entities.Deck, error)
+	// FindByUserAndGuild busca todos os decks de um usuário em um servidor
+	FindByUserAndGuild(ctx context.Context, userID, guildID string) ([]*entities.Deck, error)
 
 	// FindByGuild busca todos os decks de um servidor
 	FindByGuild(ctx context.Context, guildID string) ([]*entities.Deck, error)
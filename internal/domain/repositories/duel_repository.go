@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+
+	"sirdraith/internal/domain/battle"
+)
+
+// DuelRepository define a interface para persistência de duelos
+type DuelRepository interface {
+	// Create armazena um novo desafio
+	Create(ctx context.Context, match *battle.Match) error
+
+	// Update atualiza um desafio existente
+	Update(ctx context.Context, match *battle.Match) error
+
+	// FindOpenByUser busca o desafio pendente ou em andamento de um usuário no servidor
+	FindOpenByUser(ctx context.Context, guildID, userID string) (*battle.Match, error)
+}
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"sirdraith/internal/domain/entities"
+)
+
+// MonsterRepository define a interface para persistência do bestiário
+type MonsterRepository interface {
+	// FindByID busca um monstro pelo ID
+	FindByID(ctx context.Context, id string) (*entities.Monster, error)
+
+	// FindAll busca todos os monstros do bestiário
+	FindAll(ctx context.Context) ([]*entities.Monster, error)
+
+	// FindByChallenge busca os monstros com nível de desafio dentro do intervalo
+	FindByChallenge(ctx context.Context, min, max float64) ([]*entities.Monster, error)
+
+	// Upsert cria ou substitui um monstro
+	Upsert(ctx context.Context, monster *entities.Monster) error
+}
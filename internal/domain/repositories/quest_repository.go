@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"sirdraith/internal/domain/entities"
+)
+
+// QuestRepository define a interface para persistência das missões oferecidas
+type QuestRepository interface {
+	// Create cria uma nova missão
+	Create(ctx context.Context, quest *entities.Quest) error
+
+	// FindByID busca uma missão pelo ID
+	FindByID(ctx context.Context, id string) (*entities.Quest, error)
+
+	// FindAvailable busca as missões oferecidas em um servidor no momento informado
+	FindAvailable(ctx context.Context, guildID string, now time.Time) ([]*entities.Quest, error)
+
+	// ExpireByCadence retira do quadro as missões de uma frequência ainda oferecidas em um servidor
+	ExpireByCadence(ctx context.Context, guildID string, cadence entities.QuestCadence, now time.Time) error
+}
+
+// QuestProgressRepository define a interface para persistência do progresso nas missões
+type QuestProgressRepository interface {
+	// Create registra uma missão aceita
+	Create(ctx context.Context, progress *entities.QuestProgress) error
+
+	// Update atualiza o progresso de uma missão
+	Update(ctx context.Context, progress *entities.QuestProgress) error
+
+	// Complete salva a missão concluída se ela ainda estiver ativa no banco,
+	// retornando entities.ErrQuestNotActive caso contrário
+	Complete(ctx context.Context, progress *entities.QuestProgress) error
+
+	// FindActiveByCharacter busca as missões em andamento de um personagem
+	FindActiveByCharacter(ctx context.Context, characterID string) ([]*entities.QuestProgress, error)
+
+	// FindByCharacterAndQuest busca o progresso de um personagem em uma missão
+	FindByCharacterAndQuest(ctx context.Context, characterID, questID string) (*entities.QuestProgress, error)
+
+	// ExpireOverdue marca como expiradas as missões ativas de um servidor cujo prazo acabou
+	ExpireOverdue(ctx context.Context, guildID string, now time.Time) (int64, error)
+}
+
+// QuestScheduleRepository define a interface para persistência do estado da renovação de missões
+type QuestScheduleRepository interface {
+	// Get busca o estado da renovação de um servidor, retornando nil se nunca houve renovação
+	Get(ctx context.Context, guildID string, cadence entities.QuestCadence) (*entities.QuestSchedule, error)
+
+	// Claim reivindica a renovação do período informado. Retorna false se o
+	// período (ou um posterior) já foi reivindicado
+	Claim(ctx context.Context, guildID string, cadence entities.QuestCadence, period time.Time) (bool, error)
+
+	// Update atualiza o estado da renovação
+	Update(ctx context.Context, schedule *entities.QuestSchedule) error
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"sirdraith/internal/domain/entities"
+)
+
+// RollRepository define a interface para persistência da auditoria de rolagens
+type RollRepository interface {
+	// Create registra uma rolagem
+	Create(ctx context.Context, record *entities.RollRecord) error
+
+	// FindRecent busca as rolagens mais recentes de um servidor, da mais nova
+	// para a mais antiga. Com userID vazio, busca as de todos os usuários
+	FindRecent(ctx context.Context, guildID, userID string, limit int) ([]*entities.RollRecord, error)
+}
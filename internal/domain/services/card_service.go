@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repositories"
+)
+
+// CardPageSize é a quantidade de cartas exibidas por página na busca
+const CardPageSize = 10
+
+// CardPage representa uma página do resultado de uma busca de cartas
+type CardPage struct {
+	Cards      []*entities.Card
+	Page       int   // Página atual, começando em 1
+	TotalPages int   // Quantidade total de páginas
+	Total      int64 // Quantidade total de cartas encontradas
+}
+
+// HasPrevious indica se existe uma página anterior
+func (p *CardPage) HasPrevious() bool {
+	return p.Page > 1
+}
+
+// HasNext indica se existe uma próxima página
+func (p *CardPage) HasNext() bool {
+	return p.Page < p.TotalPages
+}
+
+// CardService encapsula a consulta ao catálogo de cartas
+type CardService struct {
+	cardRepo repositories.CardRepository
+}
+
+// NewCardService cria uma nova instância do serviço de cartas
+func NewCardService(cardRepo repositories.CardRepository) *CardService {
+	return &CardService{
+		cardRepo: cardRepo,
+	}
+}
+
+// SearchCards busca uma página de cartas que atendem ao filtro
+func (s *CardService) SearchCards(ctx context.Context, filter repositories.CardFilter, page int) (*CardPage, error) {
+	if filter.MinCost != nil && filter.MaxCost != nil && *filter.MinCost > *filter.MaxCost {
+		return nil, fmt.Errorf("custo mínimo maior que o máximo")
+	}
+	if page < 1 {
+		page = 1
+	}
+
+	cards, total, err := s.cardRepo.Search(ctx, filter, page, CardPageSize)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar cartas: %w", err)
+	}
+
+	totalPages := int((total + CardPageSize - 1) / CardPageSize)
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	// A página pode ter ficado além do fim se o catálogo diminuiu
+	if page > totalPages {
+		return s.SearchCards(ctx, filter, totalPages)
+	}
+
+	return &CardPage{
+		Cards:      cards,
+		Page:       page,
+		TotalPages: totalPages,
+		Total:      total,
+	}, nil
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"sirdraith/internal/domain/catalog"
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repositories"
+)
+
+// ImportIssue descreve uma carta rejeitada na importação
+type ImportIssue struct {
+	CardID string
+	Reason string
+}
+
+// ImportReport resume o resultado de uma importação de cartas
+type ImportReport struct {
+	Created    []string      // Cartas novas
+	Conflicts  []string      // Cartas existentes com conteúdo diferente, sobrescritas pelo arquivo
+	Unchanged  []string      // Cartas idênticas às já cadastradas
+	Invalid    []ImportIssue // Cartas que não passaram na validação
+	Duplicates []string      // IDs repetidos no arquivo
+	Applied    bool          // Se as alterações foram gravadas
+}
+
+// HasErrors indica se o arquivo possui cartas inválidas ou duplicadas
+func (r *ImportReport) HasErrors() bool {
+	return len(r.Invalid) > 0 || len(r.Duplicates) > 0
+}
+
+// CatalogService encapsula a importação e exportação do catálogo de cartas
+type CatalogService struct {
+	cardRepo repositories.CardRepository
+}
+
+// NewCatalogService cria uma nova instância do serviço de catálogo
+func NewCatalogService(cardRepo repositories.CardRepository) *CatalogService {
+	return &CatalogService{
+		cardRepo: cardRepo,
+	}
+}
+
+// Import valida um conjunto de cartas e grava as novas e alteradas.
+// Nada é gravado se houver cartas inválidas ou duplicadas, ou em modo de simulação.
+func (s *CatalogService) Import(ctx context.Context, data []byte, format catalog.Format, dryRun bool) (*ImportReport, error) {
+	cards, err := catalog.Decode(data, format)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ImportReport{}
+	seen := make(map[string]bool, len(cards))
+	for i, card := range cards {
+		if card == nil {
+			report.Invalid = append(report.Invalid, ImportIssue{CardID: fmt.Sprintf("#%d", i+1), Reason: "carta vazia"})
+			continue
+		}
+		if seen[card.ID] {
+			report.Duplicates = append(report.Duplicates, card.ID)
+			continue
+		}
+		seen[card.ID] = true
+
+		if err := card.Validate(); err != nil {
+			id := card.ID
+			if id == "" {
+				id = fmt.Sprintf("#%d", i+1)
+			}
+			report.Invalid = append(report.Invalid, ImportIssue{CardID: id, Reason: err.Error()})
+		}
+	}
+	if report.HasErrors() {
+		return report, nil
+	}
+
+	now := time.Now().Unix()
+	var pending []*entities.Card
+	for _, card := range cards {
+		existing, err := s.cardRepo.FindByID(ctx, card.ID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar carta %s: %w", card.ID, err)
+		}
+
+		switch {
+		case existing == nil:
+			report.Created = append(report.Created, card.ID)
+			card.CreatedAt = now
+		case sameCard(existing, card):
+			report.Unchanged = append(report.Unchanged, card.ID)
+			continue
+		default:
+			report.Conflicts = append(report.Conflicts, card.ID)
+			card.CreatedAt = existing.CreatedAt
+		}
+		card.UpdatedAt = now
+		pending = append(pending, card)
+	}
+
+	if dryRun {
+		return report, nil
+	}
+
+	for _, card := range pending {
+		if err := s.cardRepo.Upsert(ctx, card); err != nil {
+			return nil, fmt.Errorf("erro ao gravar carta %s: %w", card.ID, err)
+		}
+	}
+	report.Applied = true
+	return report, nil
+}
+
+// Export gera o catálogo completo no formato informado
+func (s *CatalogService) Export(ctx context.Context, format catalog.Format) ([]byte, error) {
+	cards, err := s.cardRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar cartas: %w", err)
+	}
+	return catalog.Encode(cards, format)
+}
+
+// sameCard compara o conteúdo exportável de duas cartas, ignorando datas
+func sameCard(a, b *entities.Card) bool {
+	left, errLeft := json.Marshal(a)
+	right, errRight := json.Marshal(b)
+	return errLeft == nil && errRight == nil && bytes.Equal(left, right)
+}
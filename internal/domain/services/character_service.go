@@ -2,32 +2,73 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand"
+	"strings"
 	"time"
 
+	"sirdraith/internal/domain/dice"
 	"sirdraith/internal/domain/entities"
 	"sirdraith/internal/domain/gamedata"
+	"sirdraith/internal/domain/model"
 	"sirdraith/internal/domain/repositories"
+	"sirdraith/internal/domain/repository"
 )
 
+var (
+	// ErrCharacterLimit indica que o usuário atingiu o limite de personagens no servidor
+	ErrCharacterLimit = errors.New("limite de personagens neste servidor atingido")
+	// ErrCharacterNameTaken indica que o usuário já possui um personagem com o nome
+	ErrCharacterNameTaken = errors.New("você já possui um personagem com este nome")
+	// ErrCharacterNotFound indica que o usuário não possui personagem com o nome informado
+	ErrCharacterNotFound = errors.New("personagem não encontrado")
+	// ErrNoPendingChoices indica um personagem sem escolhas de evolução pendentes
+	ErrNoPendingChoices = errors.New("o personagem não tem escolhas de evolução pendentes")
+)
+
+// ProgressionMigration resume o recálculo dos personagens de um servidor para as suas regras de progressão
+type ProgressionMigration struct {
+	Ruleset *gamedata.ProgressionRuleset
+	Updated int // Personagens recalculados
+	Skipped int // Personagens em combate, recalculados em uma próxima migração
+}
+
 // CharacterService gerencia as operações de negócio relacionadas aos personagens
 type CharacterService struct {
-	repo repositories.CharacterRepository
+	repo       repositories.CharacterRepository
+	configRepo repository.ConfigRepository
+	rng        *rand.Rand
+	recorder   RollRecorder // Opcional, registra os testes contra a morte e os dados de vida
 }
 
 // NewCharacterService cria uma nova instância do serviço de personagens
-func NewCharacterService(repo repositories.CharacterRepository) *CharacterService {
+func NewCharacterService(repo repositories.CharacterRepository, configRepo repository.ConfigRepository) *CharacterService {
 	return &CharacterService{
-		repo: repo,
+		repo:       repo,
+		configRepo: configRepo,
+		rng:        dice.NewRandomSource().Rand(),
 	}
 }
 
-// CreateCharacter cria um novo personagem
+// SetRandomSource define a fonte aleatória das rolagens do serviço
+func (s *CharacterService) SetRandomSource(source *dice.Source) {
+	s.rng = source.Rand()
+}
+
+// SetRollRecorder define quem registra as rolagens dos personagens
+func (s *CharacterService) SetRollRecorder(recorder RollRecorder) {
+	s.recorder = recorder
+}
+
+// CreateCharacter cria um novo personagem e o seleciona como personagem ativo
 func (s *CharacterService) CreateCharacter(ctx context.Context, userID, guildID, name string) (*entities.Character, error) {
-	// Verifica se o usuário já tem um personagem neste servidor
-	existing, err := s.repo.GetByUserAndGuild(ctx, userID, guildID)
-	if err == nil && existing != nil {
-		return nil, fmt.Errorf("usuário já possui um personagem neste servidor")
+	if err := s.CanCreateCharacter(ctx, userID, guildID, name); err != nil {
+		return nil, err
+	}
+	ruleset, err := s.ProgressionRuleset(guildID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Cria um novo personagem com valores padrão
@@ -40,23 +81,193 @@ func (s *CharacterService) CreateCharacter(ctx context.Context, userID, guildID,
 		Gold:       gamedata.StartingGold,
 		Inventory:  make([]entities.Item, 0),
 		IsActive:   true,
+		IsSelected: true,
 		CreatedAt:  time.Now(),
 		UpdatedAt:  time.Now(),
 	}
+	character.ApplyProgression(ruleset.ID)
 
 	if err := s.repo.Create(ctx, character); err != nil {
 		return nil, fmt.Errorf("erro ao criar personagem: %w", err)
 	}
+	if err := s.repo.SetSelected(ctx, userID, guildID, character.ID.Hex()); err != nil {
+		return nil, fmt.Errorf("erro ao selecionar personagem: %w", err)
+	}
 
 	return character, nil
 }
 
+// CanCreateCharacter verifica se o usuário pode criar um personagem com o nome
+// informado, respeitando o limite de personagens do servidor
+func (s *CharacterService) CanCreateCharacter(ctx context.Context, userID, guildID, name string) error {
+	characters, err := s.repo.ListByUserAndGuild(ctx, userID, guildID)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar personagens: %w", err)
+	}
+
+	limit, err := s.CharacterLimit(guildID)
+	if err != nil {
+		return err
+	}
+	living := 0
+	for _, character := range characters {
+		if !character.Combat.Dead {
+			living++
+		}
+	}
+	if living >= limit {
+		return fmt.Errorf("%w (%d)", ErrCharacterLimit, limit)
+	}
+
+	if findCharacterByName(characters, name) != nil {
+		return ErrCharacterNameTaken
+	}
+	return nil
+}
+
+// CharacterLimit retorna quantos personagens cada usuário pode ter no servidor
+func (s *CharacterService) CharacterLimit(guildID string) (int, error) {
+	if s.configRepo == nil {
+		return model.DefaultMaxCharacters, nil
+	}
+
+	config, err := s.configRepo.GetGuildConfig(guildID)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao buscar limite de personagens: %w", err)
+	}
+	return config.GetMaxCharacters(), nil
+}
+
+// ProgressionRuleset retorna as regras de progressão dos personagens do servidor
+func (s *CharacterService) ProgressionRuleset(guildID string) (*gamedata.ProgressionRuleset, error) {
+	if s.configRepo == nil {
+		return gamedata.DefaultProgression(), nil
+	}
+
+	config, err := s.configRepo.GetGuildConfig(guildID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar regras de progressão: %w", err)
+	}
+	return config.GetProgression(), nil
+}
+
+// Permadeath indica se os personagens do servidor morrem de vez ao falhar nos testes contra a morte
+func (s *CharacterService) Permadeath(guildID string) (bool, error) {
+	if s.configRepo == nil {
+		return false, nil
+	}
+
+	config, err := s.configRepo.GetGuildConfig(guildID)
+	if err != nil {
+		return false, fmt.Errorf("erro ao buscar regra de morte permanente: %w", err)
+	}
+	return config.Permadeath, nil
+}
+
+// MigrateProgression recalcula os personagens do servidor com as regras de
+// progressão configuradas: experiência, vida máxima e estatísticas de combate.
+// Personagens em combate são mantidos
+func (s *CharacterService) MigrateProgression(ctx context.Context, guildID string) (*ProgressionMigration, error) {
+	ruleset, err := s.ProgressionRuleset(guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	characters, err := s.repo.ListByGuild(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar personagens: %w", err)
+	}
+
+	migration := &ProgressionMigration{Ruleset: ruleset}
+	for _, character := range characters {
+		if !character.ApplyProgression(ruleset.ID) {
+			continue
+		}
+		character.UpdatedAt = time.Now()
+		err := s.repo.Update(ctx, character)
+		if errors.Is(err, entities.ErrCharacterInCombat) {
+			migration.Skipped++
+			continue
+		}
+		if err != nil {
+			return migration, fmt.Errorf("erro ao salvar %s: %w", character.Name, err)
+		}
+		migration.Updated++
+	}
+	return migration, nil
+}
+
+// ListUserCharacters lista os personagens do usuário no servidor
+func (s *CharacterService) ListUserCharacters(ctx context.Context, userID, guildID string) ([]*entities.Character, error) {
+	return s.repo.ListByUserAndGuild(ctx, userID, guildID)
+}
+
+// SelectCharacter torna ativo o personagem do usuário com o nome informado
+func (s *CharacterService) SelectCharacter(ctx context.Context, userID, guildID, name string) (*entities.Character, error) {
+	characters, err := s.repo.ListByUserAndGuild(ctx, userID, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar personagens: %w", err)
+	}
+
+	character := findCharacterByName(characters, name)
+	if character == nil {
+		return nil, ErrCharacterNotFound
+	}
+	if character.Combat.IsInCombat {
+		return nil, fmt.Errorf("%s está em combate e não pode ser trocado agora", character.Name)
+	}
+
+	if err := s.repo.SetSelected(ctx, userID, guildID, character.ID.Hex()); err != nil {
+		return nil, fmt.Errorf("erro ao selecionar personagem: %w", err)
+	}
+	character.IsSelected = true
+	return character, nil
+}
+
+// SpendAbilityPoint gasta um ponto de atributo pendente do personagem do usuário
+func (s *CharacterService) SpendAbilityPoint(ctx context.Context, userID, characterID, attribute string) (*entities.Character, error) {
+	return s.applyLevelUpChoice(ctx, userID, characterID, func(character *entities.Character) error {
+		return character.SpendAbilityPoint(attribute)
+	})
+}
+
+// ChooseSkillProficiency escolhe uma perícia de classe pendente para o personagem do usuário
+func (s *CharacterService) ChooseSkillProficiency(ctx context.Context, userID, characterID string, skill gamedata.Skill) (*entities.Character, error) {
+	return s.applyLevelUpChoice(ctx, userID, characterID, func(character *entities.Character) error {
+		return character.ChooseSkillProficiency(skill)
+	})
+}
+
+// applyLevelUpChoice carrega o personagem do usuário, aplica a escolha de evolução e o salva
+func (s *CharacterService) applyLevelUpChoice(ctx context.Context, userID, characterID string, choose func(*entities.Character) error) (*entities.Character, error) {
+	character, err := s.repo.GetByID(ctx, characterID)
+	if err != nil || character == nil || character.UserID != userID {
+		return nil, ErrCharacterNotFound
+	}
+	if !character.HasPendingChoices() {
+		return character, ErrNoPendingChoices
+	}
+
+	if err := choose(character); err != nil {
+		return nil, err
+	}
+	character.UpdatedAt = time.Now()
+	err = s.repo.Update(ctx, character)
+	if errors.Is(err, entities.ErrCharacterInCombat) {
+		return nil, fmt.Errorf("%w, faça suas escolhas depois", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erro ao salvar personagem: %w", err)
+	}
+	return character, nil
+}
+
 // GetCharacter busca um personagem pelo ID
 func (s *CharacterService) GetCharacter(ctx context.Context, id string) (*entities.Character, error) {
 	return s.repo.GetByID(ctx, id)
 }
 
-// GetCharacterByUserAndGuild busca um personagem pelo ID do usuário e do servidor
+// GetCharacterByUserAndGuild busca o personagem ativo do usuário no servidor
 func (s *CharacterService) GetCharacterByUserAndGuild(ctx context.Context, userID, guildID string) (*entities.Character, error) {
 	return s.repo.GetByUserAndGuild(ctx, userID, guildID)
 }
@@ -118,6 +329,75 @@ func (s *CharacterService) UnequipItem(ctx context.Context, character *entities.
 	return s.repo.Update(ctx, character)
 }
 
+// UseItem consome um item do inventário, aplica seus efeitos e descarta os bônus
+// temporários vencidos. Durante uma caçada os itens não podem ser usados
+func (s *CharacterService) UseItem(ctx context.Context, character *entities.Character, itemName string) (*entities.ItemUseResult, error) {
+	if character.Combat.IsInCombat {
+		return nil, fmt.Errorf("%w: fuja ou termine a caçada antes de usar itens", entities.ErrCharacterInCombat)
+	}
+	result, err := character.UseItem(itemName, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao usar item: %w", err)
+	}
+	if err := s.repo.Update(ctx, character); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Rest faz o personagem descansar. O descanso curto gasta até hitDice dados de vida
+func (s *CharacterService) Rest(ctx context.Context, character *entities.Character, rest gamedata.RestType, hitDice int) (*entities.RestResult, error) {
+	var result *entities.RestResult
+	var err error
+	switch rest {
+	case gamedata.ShortRest:
+		result, err = character.ShortRest(s.rng, hitDice, time.Now())
+	case gamedata.LongRest:
+		result, err = character.LongRest(time.Now())
+	default:
+		return nil, fmt.Errorf("tipo de descanso inválido: %s", rest)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	character.UpdatedAt = time.Now()
+	if err := s.repo.Update(ctx, character); err != nil {
+		return nil, fmt.Errorf("erro ao salvar personagem: %w", err)
+	}
+	if len(result.HitDiceRolls) > 0 {
+		total := 0
+		for _, roll := range result.HitDiceRolls {
+			total += roll
+		}
+		// Os dados de vida já vêm somados ao modificador de constituição
+		expression := fmt.Sprintf("%dd%d+CON em cada", len(result.HitDiceRolls), character.Ruleset().HitDie(character.Class))
+		recordRoll(ctx, s.recorder, RollerOf(character), entities.RollHitDice, expression, result.HitDiceRolls, total)
+	}
+	return result, nil
+}
+
+// DeathSave faz um teste contra a morte do personagem caído, seguindo a regra
+// de morte permanente do servidor
+func (s *CharacterService) DeathSave(ctx context.Context, character *entities.Character) (*entities.DeathSaveResult, error) {
+	permadeath, err := s.Permadeath(character.GuildID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := character.RollDeathSave(s.rng, permadeath, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	character.UpdatedAt = time.Now()
+	if err := s.repo.Update(ctx, character); err != nil {
+		return nil, fmt.Errorf("erro ao salvar personagem: %w", err)
+	}
+	recordRoll(ctx, s.recorder, RollerOf(character), entities.RollDeathSave, "1d20", []int{result.Roll}, result.Roll)
+	return result, nil
+}
+
 // TakeDamage aplica dano ao personagem
 func (s *CharacterService) TakeDamage(ctx context.Context, character *entities.Character, damage int) error {
 	character.TakeDamage(damage)
@@ -139,3 +419,13 @@ func (s *CharacterService) ListCharactersByGuild(ctx context.Context, guildID st
 func (s *CharacterService) SearchCharacters(ctx context.Context, query string) ([]*entities.Character, error) {
 	return s.repo.Search(ctx, query)
 }
+
+// findCharacterByName busca um personagem pelo nome, sem diferenciar maiúsculas
+func findCharacterByName(characters []*entities.Character, name string) *entities.Character {
+	for _, character := range characters {
+		if strings.EqualFold(strings.TrimSpace(character.Name), strings.TrimSpace(name)) {
+			return character
+		}
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+
+	"sirdraith/internal/domain/dice"
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repositories"
+	"sirdraith/internal/domain/repository"
+)
+
+var (
+	// ErrBoosterNotFound indica um pacote de cartas inexistente
+	ErrBoosterNotFound = errors.New("pacote de cartas não encontrado")
+	// ErrNotEnoughGold indica que o personagem não possui ouro suficiente
+	ErrNotEnoughGold = errors.New("ouro insuficiente")
+	// ErrNoCharacter indica que o usuário não possui personagem no servidor
+	ErrNoCharacter = errors.New("é necessário ter um personagem neste servidor")
+)
+
+// CollectionService encapsula a lógica de coleção de cartas e abertura de pacotes
+type CollectionService struct {
+	collectionRepo repositories.CollectionRepository
+	cardRepo       repositories.CardRepository
+	characterRepo  repositories.CharacterRepository
+	recorder       RollRecorder // Opcional, registra os sorteios dos pacotes
+	rng            *rand.Rand
+	mu             sync.Mutex // Serializa as compras
+}
+
+// NewCollectionService cria uma nova instância do serviço de coleções
+func NewCollectionService(collectionRepo repositories.CollectionRepository, cardRepo repositories.CardRepository, characterRepo repositories.CharacterRepository) *CollectionService {
+	return &CollectionService{
+		collectionRepo: collectionRepo,
+		cardRepo:       cardRepo,
+		characterRepo:  characterRepo,
+		rng:            dice.NewRandomSource().Rand(),
+	}
+}
+
+// SetRandomSource define a fonte aleatória da abertura de pacotes
+func (s *CollectionService) SetRandomSource(source *dice.Source) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rng = source.Rand()
+}
+
+// SetRollRecorder define quem registra os sorteios dos pacotes
+func (s *CollectionService) SetRollRecorder(recorder RollRecorder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recorder = recorder
+}
+
+// findCharacter busca o personagem selecionado do usuário no servidor. A falta
+// de personagem vira ErrNoCharacter e os demais erros do repositório são repassados
+func findCharacter(ctx context.Context, repo repositories.CharacterRepository, userID, guildID string) (*entities.Character, error) {
+	character, err := repo.GetByUserAndGuild(ctx, userID, guildID)
+	if errors.Is(err, repository.ErrCharacterNotFound) || (err == nil && character == nil) {
+		return nil, ErrNoCharacter
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar personagem: %w", err)
+	}
+	return character, nil
+}
+
+// GetCollection retorna o personagem do usuário no servidor e sua coleção de cartas
+func (s *CollectionService) GetCollection(ctx context.Context, userID, guildID string) (*entities.Character, *entities.CardCollection, error) {
+	character, err := findCharacter(ctx, s.characterRepo, userID, guildID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	collection, err := s.loadCollection(ctx, character)
+	if err != nil {
+		return nil, nil, err
+	}
+	return character, collection, nil
+}
+
+// OwnedCard representa uma carta da coleção com a quantidade de cópias
+type OwnedCard struct {
+	Card     *entities.Card
+	Quantity int
+}
+
+// ListCollection lista as cartas da coleção do usuário, das mais raras para as mais comuns
+func (s *CollectionService) ListCollection(ctx context.Context, userID, guildID string) ([]OwnedCard, error) {
+	_, collection, err := s.GetCollection(ctx, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	owned := make([]OwnedCard, 0, len(collection.Cards))
+	for cardID, quantity := range collection.Cards {
+		card, err := s.cardRepo.FindByID(ctx, cardID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar carta: %w", err)
+		}
+		if card == nil || quantity <= 0 {
+			continue
+		}
+		owned = append(owned, OwnedCard{Card: card, Quantity: quantity})
+	}
+
+	sort.Slice(owned, func(i, j int) bool {
+		ri, rj := rarityRank[owned[i].Card.Rarity], rarityRank[owned[j].Card.Rarity]
+		if ri != rj {
+			return ri > rj
+		}
+		return owned[i].Card.Name < owned[j].Card.Name
+	})
+	return owned, nil
+}
+
+// rarityRank ordena as raridades da mais comum para a mais rara
+var rarityRank = map[entities.CardRarity]int{
+	entities.CardRarityCommon:    0,
+	entities.CardRarityUncommon:  1,
+	entities.CardRarityRare:      2,
+	entities.CardRarityEpic:      3,
+	entities.CardRarityLegendary: 4,
+}
+
+// OpenBooster compra um pacote com o ouro do personagem e adiciona as cartas sorteadas à coleção
+func (s *CollectionService) OpenBooster(ctx context.Context, userID, guildID, packID string) (*entities.Character, []*entities.Card, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pack, ok := entities.BoosterPacks[packID]
+	if !ok {
+		return nil, nil, ErrBoosterNotFound
+	}
+
+	character, collection, err := s.GetCollection(ctx, userID, guildID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if character.Combat.IsInCombat {
+		return nil, nil, entities.ErrCharacterInCombat
+	}
+	if character.Gold < pack.Price {
+		return nil, nil, fmt.Errorf("%w: %s custa %d e você tem %d", ErrNotEnoughGold, pack.Name, pack.Price, character.Gold)
+	}
+
+	pool, err := s.cardRepo.FindAll(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao buscar cartas: %w", err)
+	}
+	cards, rolls, err := pack.Open(pool, s.rng)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao abrir pacote: %w", err)
+	}
+
+	character.Gold -= pack.Price
+	if err := s.characterRepo.Update(ctx, character); err != nil {
+		return nil, nil, fmt.Errorf("erro ao cobrar pacote: %w", err)
+	}
+
+	collection.AddCards(cards...)
+	if err := s.collectionRepo.Save(ctx, collection); err != nil {
+		// Devolve o ouro para não cobrar por cartas que não foram entregues
+		character.Gold += pack.Price
+		if refundErr := s.characterRepo.Update(ctx, character); refundErr != nil {
+			return nil, nil, fmt.Errorf("erro ao salvar coleção: %w (falha ao devolver ouro: %v)", err, refundErr)
+		}
+		return nil, nil, fmt.Errorf("erro ao salvar coleção: %w", err)
+	}
+
+	names := make([]string, 0, len(cards))
+	for _, card := range cards {
+		names = append(names, fmt.Sprintf("%s (%s)", card.Name, card.Rarity))
+	}
+	expression := fmt.Sprintf("%s: %s", pack.Name, strings.Join(names, ", "))
+	recordRoll(ctx, s.recorder, RollerOf(character), entities.RollBooster, expression, rolls, len(cards))
+	return character, cards, nil
+}
+
+// loadCollection busca a coleção do personagem ou cria uma vazia
+func (s *CollectionService) loadCollection(ctx context.Context, character *entities.Character) (*entities.CardCollection, error) {
+	collection, err := s.collectionRepo.FindByCharacter(ctx, character.ID.Hex())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar coleção: %w", err)
+	}
+	if collection == nil {
+		collection = entities.NewCardCollection(character)
+	}
+	return collection, nil
+}
@@ -2,43 +2,63 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"sirdraith/internal/domain/entities"
 	"sirdraith/internal/domain/repositories"
+	"sirdraith/internal/domain/repository"
+)
+
+var (
+	// ErrDeckNotOwned indica um deck de outro usuário
+	ErrDeckNotOwned = errors.New("este deck não pertence a você")
+	// ErrDeckOtherCharacter indica um deck de outro personagem do usuário
+	ErrDeckOtherCharacter = errors.New("este deck pertence a outro personagem seu; use usar <nome> para trocar de personagem")
 )
 
 // DeckService encapsula a lógica de negócio relacionada a decks
 type DeckService struct {
-	deckRepo repositories.DeckRepository
-	cardRepo repositories.CardRepository
+	deckRepo       repositories.DeckRepository
+	cardRepo       repositories.CardRepository
+	characterRepo  repositories.CharacterRepository
+	collectionRepo repositories.CollectionRepository
+	configRepo     repository.ConfigRepository
 }
 
 // NewDeckService cria uma nova instância do serviço de decks
-func NewDeckService(deckRepo repositories.DeckRepository, cardRepo repositories.CardRepository) *DeckService {
+func NewDeckService(deckRepo repositories.DeckRepository, cardRepo repositories.CardRepository, characterRepo repositories.CharacterRepository, collectionRepo repositories.CollectionRepository, configRepo repository.ConfigRepository) *DeckService {
 	return &DeckService{
-		deckRepo: deckRepo,
-		cardRepo: cardRepo,
+		deckRepo:       deckRepo,
+		cardRepo:       cardRepo,
+		characterRepo:  characterRepo,
+		collectionRepo: collectionRepo,
+		configRepo:     configRepo,
 	}
 }
 
-// CreateDeck cria um novo deck
+// CreateDeck cria um novo deck para o personagem ativo do usuário
 func (s *DeckService) CreateDeck(ctx context.Context, userID, guildID, name, description, class string) (*entities.Deck, error) {
+	character, err := findCharacter(ctx, s.characterRepo, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+
 	deck := entities.NewDeck(userID, guildID, name, description, class)
+	deck.CharacterID = character.ID.Hex()
 	if err := s.deckRepo.Create(ctx, deck); err != nil {
 		return nil, fmt.Errorf("erro ao criar deck: %w", err)
 	}
 	return deck, nil
 }
 
-// AddCardToDeck adiciona uma carta ao deck
-func (s *DeckService) AddCardToDeck(ctx context.Context, deckID string, cardID string) error {
-	deck, err := s.deckRepo.FindByID(ctx, deckID)
+// AddCardToDeck adiciona uma carta a um deck do usuário
+func (s *DeckService) AddCardToDeck(ctx context.Context, userID, guildID, deckID, cardID string) error {
+	deck, character, err := s.ownedDeck(ctx, userID, guildID, deckID)
 	if err != nil {
-		return fmt.Errorf("erro ao buscar deck: %w", err)
-	}
-	if deck == nil {
-		return fmt.Errorf("deck não encontrado")
+		return err
 	}
 
 	card, err := s.cardRepo.FindByID(ctx, cardID)
@@ -49,22 +69,48 @@ func (s *DeckService) AddCardToDeck(ctx context.Context, deckID string, cardID s
 		return fmt.Errorf("carta não encontrada")
 	}
 
-	config := entities.DefaultDeckConfig()
-	if err := deck.AddCard(cardID, config); err != nil {
+	if err := s.checkCharacter(ctx, character, card, deck.GetCardQuantity(card.ID)+1); err != nil {
+		return err
+	}
+
+	config, err := s.deckRules(deck.GuildID)
+	if err != nil {
+		return err
+	}
+	catalog, err := s.loadCatalog(ctx, deck)
+	if err != nil {
+		return err
+	}
+	if err := deck.AddCard(card, config, catalog); err != nil {
 		return fmt.Errorf("erro ao adicionar carta: %w", err)
 	}
 
 	return s.deckRepo.Update(ctx, deck)
 }
 
-// RemoveCardFromDeck remove uma carta do deck
-func (s *DeckService) RemoveCardFromDeck(ctx context.Context, deckID string, cardID string) error {
-	deck, err := s.deckRepo.FindByID(ctx, deckID)
+// checkCharacter verifica se o personagem dono do deck atende aos requisitos da
+// carta e possui a quantidade de cópias dela na coleção
+func (s *DeckService) checkCharacter(ctx context.Context, character *entities.Character, card *entities.Card, quantity int) error {
+	// Sem personagem, a carta só é aceita se não tiver requisitos
+	if err := card.CheckRequirements(character); err != nil {
+		return err
+	}
+	if character == nil {
+		return ErrNoCharacter
+	}
+
+	collection, err := s.collectionRepo.FindByCharacter(ctx, character.ID.Hex())
 	if err != nil {
-		return fmt.Errorf("erro ao buscar deck: %w", err)
+		return fmt.Errorf("erro ao buscar coleção: %w", err)
 	}
-	if deck == nil {
-		return fmt.Errorf("deck não encontrado")
+	return collection.CheckOwnership(card.ID, quantity)
+}
+
+// RemoveCardFromDeck remove uma carta de um deck do usuário
+func (s *DeckService) RemoveCardFromDeck(ctx context.Context, userID, guildID, deckID, cardID string) error {
+	deck, _, err := s.ownedDeck(ctx, userID, guildID, deckID)
+	if err != nil {
+		return err
 	}
 
 	if err := deck.RemoveCard(cardID); err != nil {
@@ -79,9 +125,25 @@ func (s *DeckService) GetDeck(ctx context.Context, deckID string) (*entities.Dec
 	return s.deckRepo.FindByID(ctx, deckID)
 }
 
-// ListDecksByUser lista todos os decks de um usuário
-func (s *DeckService) ListDecksByUser(ctx context.Context, userID string) ([]*entities.Deck, error) {
-	return s.deckRepo.FindByUser(ctx, userID)
+// ListDecks lista os decks do personagem ativo do usuário no servidor, junto
+// com os decks antigos, criados antes de os decks pertencerem a personagens
+func (s *DeckService) ListDecks(ctx context.Context, userID, guildID string) (*entities.Character, []*entities.Deck, error) {
+	character, err := findCharacter(ctx, s.characterRepo, userID, guildID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	decks, err := s.deckRepo.FindByUserAndGuild(ctx, userID, guildID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao buscar decks: %w", err)
+	}
+	var owned []*entities.Deck
+	for _, deck := range decks {
+		if deck.CharacterID == "" || deck.CharacterID == character.ID.Hex() {
+			owned = append(owned, deck)
+		}
+	}
+	return character, owned, nil
 }
 
 // ListDecksByGuild lista todos os decks de um servidor
@@ -99,11 +161,199 @@ func (s *DeckService) ValidateDeck(ctx context.Context, deckID string) error {
 		return fmt.Errorf("deck não encontrado")
 	}
 
-	config := entities.DefaultDeckConfig()
-	return deck.Validate(config)
+	config, err := s.deckRules(deck.GuildID)
+	if err != nil {
+		return err
+	}
+	catalog, err := s.loadCatalog(ctx, deck)
+	if err != nil {
+		return err
+	}
+	return deck.Validate(config, catalog)
+}
+
+// DeckAnalysis reúne as estatísticas de um deck e sua validade nas regras do servidor
+type DeckAnalysis struct {
+	Deck            *entities.Deck
+	Stats           *entities.DeckStats
+	Rules           *entities.DeckConfig
+	ValidationError error // Motivo pelo qual o deck é inválido, nil se for válido
+}
+
+// IsValid indica se o deck atende às regras do servidor
+func (a *DeckAnalysis) IsValid() bool {
+	return a.ValidationError == nil
+}
+
+// AnalyzeDeck calcula as estatísticas de um deck e o valida com as regras do servidor
+func (s *DeckService) AnalyzeDeck(ctx context.Context, deckID string) (*DeckAnalysis, error) {
+	deck, err := s.deckRepo.FindByID(ctx, deckID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar deck: %w", err)
+	}
+	if deck == nil {
+		return nil, fmt.Errorf("deck não encontrado")
+	}
+
+	config, err := s.deckRules(deck.GuildID)
+	if err != nil {
+		return nil, err
+	}
+	catalog, err := s.loadCatalog(ctx, deck)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeckAnalysis{
+		Deck:            deck,
+		Stats:           entities.AnalyzeDeck(deck, catalog),
+		Rules:           config,
+		ValidationError: deck.Validate(config, catalog),
+	}, nil
+}
+
+// ExportDeckCode gera o código de compartilhamento de um deck
+func (s *DeckService) ExportDeckCode(ctx context.Context, deckID string) (*entities.Deck, string, error) {
+	deck, err := s.deckRepo.FindByID(ctx, deckID)
+	if err != nil {
+		return nil, "", fmt.Errorf("erro ao buscar deck: %w", err)
+	}
+	if deck == nil {
+		return nil, "", fmt.Errorf("deck não encontrado")
+	}
+	if deck.GetCardCount() == 0 {
+		return nil, "", fmt.Errorf("o deck está vazio")
+	}
+
+	code, err := entities.EncodeDeckCode(deck)
+	if err != nil {
+		return nil, "", fmt.Errorf("erro ao gerar código: %w", err)
+	}
+	return deck, code, nil
+}
+
+// ImportDeckCode cria um deck para o usuário a partir de um código de
+// compartilhamento, validando as cartas no catálogo, as regras de deck do
+// servidor, os requisitos das cartas e a coleção do personagem
+func (s *DeckService) ImportDeckCode(ctx context.Context, userID, guildID, code, name string) (*entities.Deck, error) {
+	list, err := entities.DecodeDeckCode(code)
+	if err != nil {
+		return nil, fmt.Errorf("código de deck inválido: %w", err)
+	}
+	character, err := findCharacter(ctx, s.characterRepo, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	deck := entities.NewDeck(userID, guildID, name, "", list.Class)
+	deck.CharacterID = character.ID.Hex()
+	deck.Cards = list.Cards
+
+	catalog, err := s.loadCatalog(ctx, deck)
+	if err != nil {
+		return nil, err
+	}
+	var missing []string
+	for cardID := range deck.Cards {
+		if _, ok := catalog[cardID]; !ok {
+			missing = append(missing, cardID)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("cartas não encontradas no catálogo: %s", strings.Join(missing, ", "))
+	}
+
+	config, err := s.deckRules(guildID)
+	if err != nil {
+		return nil, err
+	}
+	if err := deck.Validate(config, catalog); err != nil {
+		return nil, fmt.Errorf("o deck não atende às regras do servidor: %w", err)
+	}
+
+	for cardID, quantity := range deck.Cards {
+		if err := s.checkCharacter(ctx, character, catalog[cardID], quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.deckRepo.Create(ctx, deck); err != nil {
+		return nil, fmt.Errorf("erro ao criar deck: %w", err)
+	}
+	return deck, nil
+}
+
+// deckRules retorna as regras de deck configuradas no servidor
+func (s *DeckService) deckRules(guildID string) (*entities.DeckConfig, error) {
+	if s.configRepo == nil {
+		return entities.DefaultDeckConfig(), nil
+	}
+
+	config, err := s.configRepo.GetGuildConfig(guildID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar regras de deck: %w", err)
+	}
+	return config.GetDeckRules(), nil
 }
 
-// DeleteDeck remove um deck
-func (s *DeckService) DeleteDeck(ctx context.Context, deckID string) error {
+// loadCatalog busca os dados das cartas presentes no deck
+func (s *DeckService) loadCatalog(ctx context.Context, deck *entities.Deck) (map[string]*entities.Card, error) {
+	catalog := make(map[string]*entities.Card, len(deck.Cards))
+	for cardID := range deck.Cards {
+		card, err := s.cardRepo.FindByID(ctx, cardID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar carta: %w", err)
+		}
+		if card != nil {
+			catalog[cardID] = card
+		}
+	}
+	return catalog, nil
+}
+
+// DeleteDeck remove um deck do usuário
+func (s *DeckService) DeleteDeck(ctx context.Context, userID, guildID, deckID string) error {
+	if _, _, err := s.ownedDeck(ctx, userID, guildID, deckID); err != nil {
+		return err
+	}
 	return s.deckRepo.Delete(ctx, deckID)
 }
+
+// ownedDeck busca um deck do servidor e garante que ele pertence ao usuário e ao
+// seu personagem ativo, retornado junto (nil se o usuário não tiver personagem).
+// Decks antigos, sem personagem, passam a ser do personagem ativo
+func (s *DeckService) ownedDeck(ctx context.Context, userID, guildID, deckID string) (*entities.Deck, *entities.Character, error) {
+	deck, err := s.deckRepo.FindByID(ctx, deckID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao buscar deck: %w", err)
+	}
+	if deck == nil || deck.GuildID != guildID {
+		return nil, nil, fmt.Errorf("deck não encontrado")
+	}
+	if deck.UserID != userID {
+		return nil, nil, ErrDeckNotOwned
+	}
+
+	character, err := deckCharacter(ctx, s.characterRepo, deck)
+	if err != nil {
+		return nil, nil, err
+	}
+	if deck.CharacterID == "" && character != nil {
+		deck.CharacterID = character.ID.Hex()
+	}
+	return deck, character, nil
+}
+
+// deckCharacter busca o personagem ativo do dono do deck e garante que o deck
+// não é de outro personagem. Retorna nil se o dono não tiver personagem
+func deckCharacter(ctx context.Context, repo repositories.CharacterRepository, deck *entities.Deck) (*entities.Character, error) {
+	character, err := findCharacter(ctx, repo, deck.UserID, deck.GuildID)
+	if err != nil && !errors.Is(err, ErrNoCharacter) {
+		return nil, err
+	}
+	if deck.CharacterID != "" && (character == nil || deck.CharacterID != character.ID.Hex()) {
+		return nil, ErrDeckOtherCharacter
+	}
+	return character, nil
+}
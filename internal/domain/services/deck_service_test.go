@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"sirdraith/internal/domain/entities"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// mockDeckRepository guarda os decks em memória
+type mockDeckRepository struct {
+	decks map[string]*entities.Deck
+}
+
+func newMockDeckRepository(decks ...*entities.Deck) *mockDeckRepository {
+	m := &mockDeckRepository{decks: make(map[string]*entities.Deck)}
+	for _, deck := range decks {
+		m.Create(context.Background(), deck)
+	}
+	return m
+}
+
+func (m *mockDeckRepository) Create(ctx context.Context, deck *entities.Deck) error {
+	if deck.ID.IsZero() {
+		deck.ID = primitive.NewObjectID()
+	}
+	m.decks[deck.ID.Hex()] = deck
+	return nil
+}
+
+func (m *mockDeckRepository) Update(ctx context.Context, deck *entities.Deck) error {
+	m.decks[deck.ID.Hex()] = deck
+	return nil
+}
+
+func (m *mockDeckRepository) Delete(ctx context.Context, id string) error {
+	delete(m.decks, id)
+	return nil
+}
+
+func (m *mockDeckRepository) FindByID(ctx context.Context, id string) (*entities.Deck, error) {
+	return m.decks[id], nil
+}
+
+func (m *mockDeckRepository) FindByUserAndGuild(ctx context.Context, userID, guildID string) ([]*entities.Deck, error) {
+	var decks []*entities.Deck
+	for _, deck := range m.decks {
+		if deck.UserID == userID && deck.GuildID == guildID {
+			decks = append(decks, deck)
+		}
+	}
+	return decks, nil
+}
+
+func (m *mockDeckRepository) FindByGuild(ctx context.Context, guildID string) ([]*entities.Deck, error) {
+	var decks []*entities.Deck
+	for _, deck := range m.decks {
+		if deck.GuildID == guildID {
+			decks = append(decks, deck)
+		}
+	}
+	return decks, nil
+}
+
+func (m *mockDeckRepository) FindByClass(ctx context.Context, class string) ([]*entities.Deck, error) {
+	var decks []*entities.Deck
+	for _, deck := range m.decks {
+		if deck.Class == class {
+			decks = append(decks, deck)
+		}
+	}
+	return decks, nil
+}
+
+func TestDeckService_Ownership(t *testing.T) {
+	deck := entities.NewDeck("123", "456", "Meu deck", "", "Warrior")
+	deckRepo := newMockDeckRepository(deck)
+	service := NewDeckService(deckRepo, nil, NewMockCharacterRepository(), nil, nil)
+	deckID := deck.ID.Hex()
+
+	if err := service.AddCardToDeck(context.Background(), "999", "456", deckID, "card"); !errors.Is(err, ErrDeckNotOwned) {
+		t.Errorf("AddCardToDeck() by another user error = %v, want %v", err, ErrDeckNotOwned)
+	}
+	if err := service.RemoveCardFromDeck(context.Background(), "999", "456", deckID, "card"); !errors.Is(err, ErrDeckNotOwned) {
+		t.Errorf("RemoveCardFromDeck() by another user error = %v, want %v", err, ErrDeckNotOwned)
+	}
+	if err := service.DeleteDeck(context.Background(), "123", "789", deckID); err == nil {
+		t.Error("DeleteDeck() from another guild succeeded")
+	}
+	if err := service.DeleteDeck(context.Background(), "999", "456", deckID); !errors.Is(err, ErrDeckNotOwned) {
+		t.Errorf("DeleteDeck() by another user error = %v, want %v", err, ErrDeckNotOwned)
+	}
+	if _, ok := deckRepo.decks[deckID]; !ok {
+		t.Fatal("DeleteDeck() removed a deck the caller does not own")
+	}
+
+	if err := service.DeleteDeck(context.Background(), "123", "456", deckID); err != nil {
+		t.Errorf("DeleteDeck() by the owner error = %v", err)
+	}
+	if _, ok := deckRepo.decks[deckID]; ok {
+		t.Error("DeleteDeck() kept the owner's deck")
+	}
+}
+
+func TestDeckService_ActiveCharacter(t *testing.T) {
+	characters := NewMockCharacterRepository()
+	first := newCharacterWithHealth(10)
+	second := newCharacterWithHealth(10)
+	characters.Create(context.Background(), first)
+	characters.Create(context.Background(), second)
+	characters.SetSelected(context.Background(), "123", "456", first.ID.Hex())
+
+	deckRepo := newMockDeckRepository()
+	service := NewDeckService(deckRepo, nil, characters, nil, nil)
+
+	deck, err := service.CreateDeck(context.Background(), "123", "456", "Primeiro", "", "Warrior")
+	if err != nil {
+		t.Fatalf("CreateDeck() error = %v", err)
+	}
+	if deck.CharacterID != first.ID.Hex() {
+		t.Errorf("CreateDeck() character = %q, want the active %q", deck.CharacterID, first.ID.Hex())
+	}
+	legacy := entities.NewDeck("123", "456", "Antigo", "", "Warrior")
+	deckRepo.Create(context.Background(), legacy)
+
+	characters.SetSelected(context.Background(), "123", "456", second.ID.Hex())
+	character, decks, err := service.ListDecks(context.Background(), "123", "456")
+	if err != nil {
+		t.Fatalf("ListDecks() error = %v", err)
+	}
+	if character != second || len(decks) != 1 || decks[0] != legacy {
+		t.Errorf("ListDecks() = %v, want only the legacy deck for the active character", decks)
+	}
+	if err := service.DeleteDeck(context.Background(), "123", "456", deck.ID.Hex()); !errors.Is(err, ErrDeckOtherCharacter) {
+		t.Errorf("DeleteDeck() of another character's deck error = %v, want %v", err, ErrDeckOtherCharacter)
+	}
+}
@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"sirdraith/internal/domain/battle"
+	"sirdraith/internal/domain/dice"
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repositories"
+)
+
+var (
+	// ErrNoOpenDuel indica que o usuário não participa de nenhum desafio aberto
+	ErrNoOpenDuel = errors.New("você não está em nenhum duelo")
+	// ErrAlreadyDueling indica que o usuário já participa de um desafio aberto
+	ErrAlreadyDueling = errors.New("já existe um duelo em aberto")
+)
+
+// DuelService encapsula a lógica de negócio dos duelos de cartas
+type DuelService struct {
+	duelRepo      repositories.DuelRepository
+	deckRepo      repositories.DeckRepository
+	cardRepo      repositories.CardRepository
+	characterRepo repositories.CharacterRepository
+	rng           *rand.Rand
+	mu            sync.Mutex // Serializa as ações para evitar sobrescrever o estado salvo
+}
+
+// NewDuelService cria uma nova instância do serviço de duelos
+func NewDuelService(duelRepo repositories.DuelRepository, deckRepo repositories.DeckRepository, cardRepo repositories.CardRepository, characterRepo repositories.CharacterRepository) *DuelService {
+	return &DuelService{
+		duelRepo:      duelRepo,
+		deckRepo:      deckRepo,
+		cardRepo:      cardRepo,
+		characterRepo: characterRepo,
+		rng:           dice.NewRandomSource().Rand(),
+	}
+}
+
+// SetRandomSource define a fonte aleatória das sementes dos duelos
+func (s *DuelService) SetRandomSource(source *dice.Source) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rng = source.Rand()
+}
+
+// Challenge cria um desafio de um usuário para outro usando o deck escolhido
+func (s *DuelService) Challenge(ctx context.Context, guildID, channelID, challengerID, challengerName, opponentID, opponentName, deckID string) (*battle.Match, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if challengerID == opponentID {
+		return nil, fmt.Errorf("você não pode desafiar a si mesmo")
+	}
+	if _, err := s.loadDeck(ctx, guildID, challengerID, deckID); err != nil {
+		return nil, err
+	}
+
+	for _, userID := range []string{challengerID, opponentID} {
+		open, err := s.duelRepo.FindOpenByUser(ctx, guildID, userID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar duelos: %w", err)
+		}
+		if open != nil {
+			return nil, ErrAlreadyDueling
+		}
+	}
+
+	match := battle.NewMatch(guildID, channelID, challengerID, challengerName, deckID, opponentID, opponentName)
+	if err := s.duelRepo.Create(ctx, match); err != nil {
+		return nil, fmt.Errorf("erro ao criar desafio: %w", err)
+	}
+	return match, nil
+}
+
+// Accept aceita o desafio pendente do usuário e inicia o duelo
+func (s *DuelService) Accept(ctx context.Context, guildID, opponentID, deckID string) (*battle.Match, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	match, err := s.findOpen(ctx, guildID, opponentID)
+	if err != nil {
+		return nil, err
+	}
+	if match.Status != battle.MatchPending || match.OpponentID != opponentID {
+		return nil, fmt.Errorf("você não possui desafios pendentes")
+	}
+
+	challengerCards, err := s.expandDeck(ctx, guildID, match.ChallengerID, match.ChallengerDeck)
+	if err != nil {
+		return nil, fmt.Errorf("deck de %s: %w", match.ChallengerName, err)
+	}
+	opponentCards, err := s.expandDeck(ctx, guildID, opponentID, deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	match.OpponentDeck = deckID
+	err = match.Start(
+		battle.PlayerSetup{ID: match.ChallengerID, Name: match.ChallengerName, Cards: challengerCards},
+		battle.PlayerSetup{ID: match.OpponentID, Name: match.OpponentName, Cards: opponentCards},
+		s.rng.Int63(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao iniciar duelo: %w", err)
+	}
+
+	if err := s.duelRepo.Update(ctx, match); err != nil {
+		return nil, fmt.Errorf("erro ao salvar duelo: %w", err)
+	}
+	return match, nil
+}
+
+// Decline recusa um desafio pendente ou cancela o desafio feito pelo usuário
+func (s *DuelService) Decline(ctx context.Context, guildID, userID string) (*battle.Match, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	match, err := s.findOpen(ctx, guildID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if match.Status != battle.MatchPending {
+		return nil, fmt.Errorf("o duelo já começou, use desistir para abandoná-lo")
+	}
+
+	match.Status = battle.MatchDeclined
+	match.UpdatedAt = time.Now()
+	if err := s.duelRepo.Update(ctx, match); err != nil {
+		return nil, fmt.Errorf("erro ao salvar duelo: %w", err)
+	}
+	return match, nil
+}
+
+// PlayCard joga uma carta da mão do usuário no duelo em andamento
+func (s *DuelService) PlayCard(ctx context.Context, guildID, userID string, handIndex int, target *battle.Target) (*battle.Match, error) {
+	return s.act(ctx, guildID, userID, func(d *battle.Duel) error {
+		return d.PlayCard(userID, handIndex, target)
+	})
+}
+
+// Attack ataca com uma criatura do usuário no duelo em andamento
+func (s *DuelService) Attack(ctx context.Context, guildID, userID string, attackerSlot int, target battle.Target) (*battle.Match, error) {
+	return s.act(ctx, guildID, userID, func(d *battle.Duel) error {
+		return d.Attack(userID, attackerSlot, target)
+	})
+}
+
+// EndTurn encerra o turno do usuário no duelo em andamento
+func (s *DuelService) EndTurn(ctx context.Context, guildID, userID string) (*battle.Match, error) {
+	return s.act(ctx, guildID, userID, func(d *battle.Duel) error {
+		return d.EndTurn(userID)
+	})
+}
+
+// Surrender encerra o duelo em andamento com a vitória do oponente
+func (s *DuelService) Surrender(ctx context.Context, guildID, userID string) (*battle.Match, error) {
+	return s.act(ctx, guildID, userID, func(d *battle.Duel) error {
+		return d.Surrender(userID)
+	})
+}
+
+// GetOpenDuel retorna o desafio pendente ou em andamento do usuário
+func (s *DuelService) GetOpenDuel(ctx context.Context, guildID, userID string) (*battle.Match, error) {
+	return s.findOpen(ctx, guildID, userID)
+}
+
+// SetBoardMessage registra a mensagem que exibe o tabuleiro do duelo
+func (s *DuelService) SetBoardMessage(ctx context.Context, match *battle.Match, messageID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	match.MessageID = messageID
+	return s.duelRepo.Update(ctx, match)
+}
+
+// act carrega o duelo em andamento, aplica a ação e salva o novo estado
+func (s *DuelService) act(ctx context.Context, guildID, userID string, action func(d *battle.Duel) error) (*battle.Match, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	match, err := s.findOpen(ctx, guildID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if match.Status != battle.MatchActive || match.Duel == nil {
+		return nil, battle.ErrMatchNotActive
+	}
+
+	if err := action(match.Duel); err != nil {
+		return nil, err
+	}
+
+	match.Sync()
+	if err := s.duelRepo.Update(ctx, match); err != nil {
+		return nil, fmt.Errorf("erro ao salvar duelo: %w", err)
+	}
+	return match, nil
+}
+
+func (s *DuelService) findOpen(ctx context.Context, guildID, userID string) (*battle.Match, error) {
+	match, err := s.duelRepo.FindOpenByUser(ctx, guildID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar duelo: %w", err)
+	}
+	if match == nil {
+		return nil, ErrNoOpenDuel
+	}
+	return match, nil
+}
+
+// loadDeck busca um deck e garante que ele pertence ao usuário no servidor e
+// ao seu personagem ativo, como nas alterações de decks
+func (s *DuelService) loadDeck(ctx context.Context, guildID, userID, deckID string) (*entities.Deck, error) {
+	deck, err := s.deckRepo.FindByID(ctx, deckID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar deck: %w", err)
+	}
+	if deck == nil || deck.UserID != userID || deck.GuildID != guildID {
+		return nil, fmt.Errorf("deck não encontrado")
+	}
+	if _, err := deckCharacter(ctx, s.characterRepo, deck); err != nil {
+		return nil, err
+	}
+	if deck.GetCardCount() == 0 {
+		return nil, battle.ErrEmptyDeck
+	}
+	return deck, nil
+}
+
+// expandDeck carrega as cartas de um deck, uma entrada por cópia
+func (s *DuelService) expandDeck(ctx context.Context, guildID, userID, deckID string) ([]*entities.Card, error) {
+	deck, err := s.loadDeck(ctx, guildID, userID, deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := make(map[string]*entities.Card, len(deck.Cards))
+	for cardID := range deck.Cards {
+		card, err := s.cardRepo.FindByID(ctx, cardID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar carta: %w", err)
+		}
+		catalog[cardID] = card
+	}
+	return battle.ExpandDeck(deck, catalog)
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"sirdraith/internal/domain/entities"
+)
+
+func TestDuelService_DeckOfActiveCharacter(t *testing.T) {
+	characters := NewMockCharacterRepository()
+	first := newCharacterWithHealth(10)
+	second := newCharacterWithHealth(10)
+	characters.Create(context.Background(), first)
+	characters.Create(context.Background(), second)
+	characters.SetSelected(context.Background(), "123", "456", second.ID.Hex())
+
+	deck := entities.NewDeck("123", "456", "Do primeiro", "", "Warrior")
+	deck.CharacterID = first.ID.Hex()
+	deck.Cards["espada"] = 1
+	service := NewDuelService(nil, newMockDeckRepository(deck), nil, characters)
+
+	if _, err := service.Challenge(context.Background(), "456", "canal", "123", "Sir Test", "789", "Rival", deck.ID.Hex()); !errors.Is(err, ErrDeckOtherCharacter) {
+		t.Errorf("Challenge() with another character's deck error = %v, want %v", err, ErrDeckOtherCharacter)
+	}
+
+	characters.SetSelected(context.Background(), "123", "456", first.ID.Hex())
+	if _, err := service.loadDeck(context.Background(), "456", "123", deck.ID.Hex()); err != nil {
+		t.Errorf("loadDeck() with the active character's deck error = %v", err)
+	}
+}
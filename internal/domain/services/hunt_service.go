@@ -0,0 +1,399 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"sirdraith/internal/domain/bestiary"
+	"sirdraith/internal/domain/combat"
+	"sirdraith/internal/domain/dice"
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repositories"
+)
+
+var (
+	// ErrNoHunt indica que o usuário não está caçando
+	ErrNoHunt = errors.New("você não está em nenhuma caçada")
+	// ErrAlreadyHunting indica que o usuário já está em uma caçada
+	ErrAlreadyHunting = errors.New("você já está em uma caçada")
+	// ErrCharacterDown indica um personagem sem vida para lutar
+	ErrCharacterDown = errors.New("seu personagem está caído; use resistir ou descansar antes de lutar")
+)
+
+// Hunt representa um combate em andamento entre um personagem e monstros
+type Hunt struct {
+	Character *entities.Character
+	Encounter *combat.Encounter
+	Monsters  []*combat.MonsterCombatant // Na ordem em que são numerados para o jogador
+	Plan      *bestiary.EncounterPlan
+	StartedAt time.Time
+}
+
+// HuntTurn descreve o que aconteceu em uma ação da caçada
+type HuntTurn struct {
+	Results      []*combat.AttackResult // Ataque do jogador seguido dos ataques dos monstros
+	Finished     bool
+	Victory      bool
+	Reward       combat.Reward
+	Loot         []entities.Item // Itens adicionados ao inventário
+	LostLoot     []entities.Item // Itens que não couberam no inventário
+	LevelsGained int
+	QuestUpdates []*entities.QuestProgress // Missões que avançaram com os monstros derrotados
+}
+
+// MonsterDefeatListener é notificado dos monstros derrotados ao fim de uma caçada
+type MonsterDefeatListener interface {
+	RecordDefeats(ctx context.Context, character *entities.Character, monsterIDs []string) ([]*entities.QuestProgress, error)
+}
+
+// HuntService encapsula as caçadas de monstros
+type HuntService struct {
+	characterRepo repositories.CharacterRepository
+	monsterRepo   repositories.MonsterRepository
+	listener      MonsterDefeatListener
+	recorder      RollRecorder     // Opcional, registra os ataques e os espólios
+	hunts         map[string]*Hunt // Caçadas ativas por usuário e servidor
+	rng           *rand.Rand
+	mu            sync.Mutex // Protege as caçadas
+}
+
+// NewHuntService cria uma nova instância do serviço de caçadas
+func NewHuntService(characterRepo repositories.CharacterRepository, monsterRepo repositories.MonsterRepository) *HuntService {
+	return &HuntService{
+		characterRepo: characterRepo,
+		monsterRepo:   monsterRepo,
+		hunts:         make(map[string]*Hunt),
+		rng:           dice.NewRandomSource().Rand(),
+	}
+}
+
+// SetRandomSource define a fonte aleatória dos encontros, ataques e espólios
+func (s *HuntService) SetRandomSource(source *dice.Source) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rng = source.Rand()
+}
+
+// SetDefeatListener define quem é notificado dos monstros derrotados nas caçadas
+func (s *HuntService) SetDefeatListener(listener MonsterDefeatListener) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listener = listener
+}
+
+// SetRollRecorder define quem registra as rolagens das caçadas
+func (s *HuntService) SetRollRecorder(recorder RollRecorder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recorder = recorder
+}
+
+// StartHunt gera um encontro para o personagem do usuário e inicia o combate.
+// Se os monstros forem mais rápidos, seus ataques já são resolvidos. O
+// personagem fica marcado como em combate no banco até o fim da caçada; uma
+// marca sem caçada em andamento (ex: o bot reiniciou no meio de uma caçada) é
+// reaproveitada pela nova caçada
+func (s *HuntService) StartHunt(ctx context.Context, userID, guildID string) (*Hunt, *HuntTurn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.hunts[huntKey(userID, guildID)]; exists {
+		return nil, nil, ErrAlreadyHunting
+	}
+
+	character, err := findCharacter(ctx, s.characterRepo, userID, guildID)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch character.HealthState() {
+	case entities.Dead:
+		return nil, nil, entities.ErrCharacterDead
+	case entities.Dying, entities.Stable:
+		return nil, nil, ErrCharacterDown
+	}
+
+	monsters, err := s.loadBestiary(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	plan, err := bestiary.GenerateEncounter([]int{character.Level}, monsters, s.rng)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao gerar encontro: %w", err)
+	}
+
+	hunt := &Hunt{
+		Character: character,
+		Monsters:  combat.NewMonsterCombatants(plan.Monsters),
+		Plan:      plan,
+		StartedAt: time.Now(),
+	}
+	enemies := make([]combat.Combatant, 0, len(hunt.Monsters))
+	for _, m := range hunt.Monsters {
+		enemies = append(enemies, m)
+	}
+	hunt.Encounter, err = combat.NewEncounter([]combat.Combatant{combat.NewCharacterCombatant(character)}, enemies, s.rng)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao iniciar combate: %w", err)
+	}
+
+	if err := s.characterRepo.SetInCombat(ctx, character.ID.Hex(), true); err != nil {
+		return nil, nil, fmt.Errorf("erro ao iniciar combate: %w", err)
+	}
+
+	turn := &HuntTurn{}
+	if err := s.runEnemies(ctx, hunt, turn); err != nil {
+		if !turn.Finished {
+			s.characterRepo.SetInCombat(ctx, character.ID.Hex(), false)
+		}
+		return nil, nil, err
+	}
+	if !turn.Finished {
+		s.hunts[huntKey(userID, guildID)] = hunt
+	}
+	return hunt, turn, nil
+}
+
+// Strike ataca o monstro de número informado (começando em 1) e resolve os
+// turnos dos monstros. Com alvo 0, ataca o primeiro monstro de pé
+func (s *HuntService) Strike(ctx context.Context, userID, guildID string, target int) (*Hunt, *HuntTurn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hunt, exists := s.hunts[huntKey(userID, guildID)]
+	if !exists {
+		return nil, nil, ErrNoHunt
+	}
+
+	monster, err := hunt.pickTarget(target)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, err := hunt.Encounter.Attack(hunt.Character.ID.Hex(), monster.ID())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	turn := &HuntTurn{Results: []*combat.AttackResult{result}}
+	if err := s.runEnemies(ctx, hunt, turn); err != nil {
+		return nil, nil, err
+	}
+	return hunt, turn, nil
+}
+
+// Flee abandona a caçada sem recompensas, mantendo os ferimentos sofridos
+func (s *HuntService) Flee(ctx context.Context, userID, guildID string) (*Hunt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := huntKey(userID, guildID)
+	hunt, exists := s.hunts[key]
+	if !exists {
+		return nil, ErrNoHunt
+	}
+	delete(s.hunts, key)
+
+	character, err := s.reload(ctx, hunt)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.characterRepo.EndCombat(ctx, character, 0); err != nil {
+		return nil, fmt.Errorf("erro ao salvar personagem: %w", err)
+	}
+	hunt.Character = character
+	return hunt, nil
+}
+
+// GetHunt retorna a caçada em andamento do usuário
+func (s *HuntService) GetHunt(userID, guildID string) (*Hunt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hunt, exists := s.hunts[huntKey(userID, guildID)]
+	if !exists {
+		return nil, ErrNoHunt
+	}
+	return hunt, nil
+}
+
+// runEnemies resolve os turnos dos monstros e encerra a caçada se o combate
+// acabou. Os ataques do turno são registrados depois de resolvidos
+func (s *HuntService) runEnemies(ctx context.Context, hunt *Hunt, turn *HuntTurn) error {
+	results, err := hunt.Encounter.RunEnemyTurns()
+	if err != nil {
+		return fmt.Errorf("erro no turno dos monstros: %w", err)
+	}
+	turn.Results = append(turn.Results, results...)
+
+	if hunt.Encounter.Finished {
+		return s.finish(ctx, hunt, turn)
+	}
+	s.recordTurn(ctx, hunt, turn)
+	return nil
+}
+
+// finish concede as recompensas da vitória e salva o personagem antes de
+// registrar os ataques e os espólios rolados
+func (s *HuntService) finish(ctx context.Context, hunt *Hunt, turn *HuntTurn) error {
+	delete(s.hunts, huntKey(hunt.Character.UserID, hunt.Character.GuildID))
+	turn.Finished = true
+	turn.Victory = hunt.Encounter.Winner == combat.SideParty
+
+	character, err := s.reload(ctx, hunt)
+	if err != nil {
+		return err
+	}
+	hunt.Character = character
+
+	goldBefore := character.Gold
+	var lootRolls []lootRoll
+	if turn.Victory {
+		levelBefore := character.Level
+		turn.Reward = hunt.Encounter.Reward()
+		if err := combat.DistributeRewards([]*entities.Character{character}, turn.Reward); err != nil {
+			return err
+		}
+		turn.LevelsGained = character.Level - levelBefore
+
+		for _, m := range hunt.Monsters {
+			items, rolls := m.Monster.RollLoot(s.rng)
+			if len(rolls) > 0 {
+				lootRolls = append(lootRolls, lootRoll{monster: m.Monster, rolls: rolls, dropped: len(items)})
+			}
+			for _, item := range items {
+				if err := character.AddItem(item); err != nil {
+					turn.LostLoot = append(turn.LostLoot, item)
+					continue
+				}
+				turn.Loot = append(turn.Loot, item)
+			}
+		}
+	}
+	// Sem vitória, o personagem derrotado continua caído com 0 de vida e passa
+	// a fazer testes contra a morte
+
+	if err := s.characterRepo.EndCombat(ctx, character, character.Gold-goldBefore); err != nil {
+		return fmt.Errorf("erro ao salvar personagem: %w", err)
+	}
+	s.recordTurn(ctx, hunt, turn)
+	for _, loot := range lootRolls {
+		recordRoll(ctx, s.recorder, RollerOf(character), entities.RollLoot, loot.expression(), loot.rolls, loot.dropped)
+	}
+
+	if s.listener == nil {
+		return nil
+	}
+	var defeated []string
+	for _, m := range hunt.Monsters {
+		if m.Health() <= 0 {
+			defeated = append(defeated, m.Monster.ID)
+		}
+	}
+	if len(defeated) == 0 {
+		return nil
+	}
+	updates, err := s.listener.RecordDefeats(ctx, character, defeated)
+	if err != nil {
+		return fmt.Errorf("erro ao atualizar missões: %w", err)
+	}
+	turn.QuestUpdates = updates
+	return nil
+}
+
+// lootRoll guarda os d100 rolados para o espólio de um monstro
+type lootRoll struct {
+	monster *entities.Monster
+	rolls   []int
+	dropped int // Itens que caíram
+}
+
+// expression descreve as chances de cada item do espólio, na ordem dos dados
+func (l lootRoll) expression() string {
+	chances := make([]string, 0, len(l.monster.Loot))
+	for _, entry := range l.monster.Loot {
+		chances = append(chances, fmt.Sprintf("%s %d%%", entry.Item.Name, entry.Chance))
+	}
+	return fmt.Sprintf("d100 de %s (%s)", l.monster.Name, strings.Join(chances, ", "))
+}
+
+// recordTurn registra os ataques rolados na ação da caçada
+func (s *HuntService) recordTurn(ctx context.Context, hunt *Hunt, turn *HuntTurn) {
+	for _, result := range turn.Results {
+		expression := fmt.Sprintf("1d20%+d de %s contra %s (CA %d)", result.Total-result.Roll, result.Attacker.Name(), result.Target.Name(), result.Target.ArmorClass())
+		switch {
+		case result.Critical:
+			expression += fmt.Sprintf(": crítico, %d de dano", result.Damage)
+		case result.Hit:
+			expression += fmt.Sprintf(": acerto, %d de dano", result.Damage)
+		default:
+			expression += ": erro"
+		}
+		recordRoll(ctx, s.recorder, RollerOf(hunt.Character), entities.RollAttack, expression, []int{result.Roll}, result.Total)
+	}
+}
+
+// reload recarrega o personagem salvo e aplica a ele o estado de combate da
+// caçada, para que o resultado seja salvo sobre os dados atuais do personagem
+func (s *HuntService) reload(ctx context.Context, hunt *Hunt) (*entities.Character, error) {
+	character, err := s.characterRepo.GetByID(ctx, hunt.Character.ID.Hex())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar personagem: %w", err)
+	}
+	character.Combat = hunt.Character.Combat
+	character.Combat.IsInCombat = false
+	character.Conditions = hunt.Character.Conditions
+	return character, nil
+}
+
+// loadBestiary carrega os monstros válidos, populando o bestiário inicial se
+// estiver vazio. Monstros inválidos (ex: sem ataques) são ignorados
+func (s *HuntService) loadBestiary(ctx context.Context) ([]*entities.Monster, error) {
+	stored, err := s.monsterRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar monstros: %w", err)
+	}
+	if len(stored) > 0 {
+		var monsters []*entities.Monster
+		for _, m := range stored {
+			if m.Validate() == nil {
+				monsters = append(monsters, m)
+			}
+		}
+		if len(monsters) == 0 {
+			return nil, fmt.Errorf("nenhum monstro válido no bestiário")
+		}
+		return monsters, nil
+	}
+
+	monsters := bestiary.Monsters()
+	for _, m := range monsters {
+		if err := s.monsterRepo.Upsert(ctx, m); err != nil {
+			return nil, fmt.Errorf("erro ao popular bestiário: %w", err)
+		}
+	}
+	return monsters, nil
+}
+
+// pickTarget retorna o monstro de número informado ou o primeiro de pé
+func (h *Hunt) pickTarget(target int) (*combat.MonsterCombatant, error) {
+	if target == 0 {
+		for _, m := range h.Monsters {
+			if m.Health() > 0 {
+				return m, nil
+			}
+		}
+	}
+	if target < 1 || target > len(h.Monsters) || h.Monsters[target-1].Health() <= 0 {
+		return nil, combat.ErrInvalidTarget
+	}
+	return h.Monsters[target-1], nil
+}
+
+func huntKey(userID, guildID string) string {
+	return userID + ":" + guildID
+}
@@ -0,0 +1,264 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"sirdraith/internal/domain/bestiary"
+	"sirdraith/internal/domain/dice"
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/gamedata"
+	"sirdraith/internal/domain/model"
+)
+
+// mockMonsterRepository guarda o bestiário em memória
+type mockMonsterRepository struct {
+	monsters []*entities.Monster
+}
+
+func (m *mockMonsterRepository) FindByID(ctx context.Context, id string) (*entities.Monster, error) {
+	for _, monster := range m.monsters {
+		if monster.ID == id {
+			return monster, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockMonsterRepository) FindAll(ctx context.Context) ([]*entities.Monster, error) {
+	return m.monsters, nil
+}
+
+func (m *mockMonsterRepository) FindByChallenge(ctx context.Context, min, max float64) ([]*entities.Monster, error) {
+	var found []*entities.Monster
+	for _, monster := range m.monsters {
+		if monster.ChallengeRating >= min && monster.ChallengeRating <= max {
+			found = append(found, monster)
+		}
+	}
+	return found, nil
+}
+
+func (m *mockMonsterRepository) Upsert(ctx context.Context, monster *entities.Monster) error {
+	m.monsters = append(m.monsters, monster)
+	return nil
+}
+
+// copyingCharacterRepository entrega cópias dos personagens salvos, como o
+// banco, para que alterações em memória só valham depois de salvas
+type copyingCharacterRepository struct {
+	*MockCharacterRepository
+}
+
+func (r copyingCharacterRepository) GetByID(ctx context.Context, id string) (*entities.Character, error) {
+	character, err := r.MockCharacterRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	copied := *character
+	return &copied, nil
+}
+
+func (r copyingCharacterRepository) GetByUserAndGuild(ctx context.Context, userID, guildID string) (*entities.Character, error) {
+	character, err := r.MockCharacterRepository.GetByUserAndGuild(ctx, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+	copied := *character
+	return &copied, nil
+}
+
+func (r copyingCharacterRepository) ListByGuild(ctx context.Context, guildID string) ([]*entities.Character, error) {
+	characters, err := r.MockCharacterRepository.ListByGuild(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	copies := make([]*entities.Character, 0, len(characters))
+	for _, character := range characters {
+		copied := *character
+		copies = append(copies, &copied)
+	}
+	return copies, nil
+}
+
+func (r copyingCharacterRepository) Update(ctx context.Context, character *entities.Character) error {
+	copied := *character
+	return r.MockCharacterRepository.Update(ctx, &copied)
+}
+
+func TestHuntService_KeepsChangesMadeDuringHunt(t *testing.T) {
+	mock := NewMockCharacterRepository()
+	repo := copyingCharacterRepository{mock}
+	character := newCharacterWithHealth(1000)
+	character.Combat.MaxHealth = 1000
+	mock.Create(context.Background(), character)
+
+	service := NewHuntService(repo, &mockMonsterRepository{})
+	service.SetRandomSource(dice.NewSource(7))
+
+	hunt, turn, err := service.StartHunt(context.Background(), "123", "456")
+	if err != nil {
+		t.Fatalf("StartHunt() error = %v", err)
+	}
+	if turn.Finished {
+		t.Fatal("StartHunt() finished before the character could act")
+	}
+	if !character.Combat.IsInCombat {
+		t.Fatal("StartHunt() did not mark the stored character as in combat")
+	}
+
+	stale, _ := repo.GetByID(context.Background(), character.ID.Hex())
+	stale.Gold = 0
+	if err := repo.Update(context.Background(), stale); !errors.Is(err, entities.ErrCharacterInCombat) {
+		t.Errorf("Update() during a hunt error = %v, want %v", err, entities.ErrCharacterInCombat)
+	}
+
+	// Ouro salvo por fora durante a caçada não pode ser desfeito no fim dela
+	character.Gold += 50
+	goldBefore := character.Gold
+
+	for !turn.Finished {
+		if _, turn, err = service.Strike(context.Background(), "123", "456", 0); err != nil {
+			t.Fatalf("Strike() error = %v", err)
+		}
+	}
+
+	if !turn.Victory {
+		t.Fatal("Strike() lost the hunt with 1000 health")
+	}
+	if character.Combat.IsInCombat {
+		t.Error("finish() left the character in combat")
+	}
+	if want := goldBefore + turn.Reward.Gold; character.Gold != want || hunt.Character.Gold != want {
+		t.Errorf("finish() gold = %d, want %d", character.Gold, want)
+	}
+	if turn.LevelsGained == 0 && character.Combat.Health != hunt.Character.Combat.Health {
+		t.Errorf("finish() health = %d, want the hunt's %d", character.Combat.Health, hunt.Character.Combat.Health)
+	}
+}
+
+func TestHuntService_RecordsRolls(t *testing.T) {
+	mock := NewMockCharacterRepository()
+	character := newCharacterWithHealth(1000)
+	character.Combat.MaxHealth = 1000
+	mock.Create(context.Background(), character)
+
+	rolls := &mockRollRepository{}
+	configRepo := &mockConfigRepository{configs: map[string]*model.GuildConfig{
+		"456": {ID: "456", RollAudit: true},
+	}}
+	service := NewHuntService(copyingCharacterRepository{mock}, &mockMonsterRepository{})
+	service.SetRandomSource(dice.NewSource(7))
+	service.SetRollRecorder(NewRollService(dice.NewSource(7), rolls, configRepo))
+
+	hunt, turn, err := service.StartHunt(context.Background(), "123", "456")
+	if err != nil {
+		t.Fatalf("StartHunt() error = %v", err)
+	}
+	attacks := len(turn.Results)
+	for !turn.Finished {
+		if _, turn, err = service.Strike(context.Background(), "123", "456", 0); err != nil {
+			t.Fatalf("Strike() error = %v", err)
+		}
+		attacks += len(turn.Results)
+	}
+
+	looted := 0
+	for _, m := range hunt.Monsters {
+		if len(m.Monster.Loot) > 0 {
+			looted++
+		}
+	}
+	counts := make(map[entities.RollKind]int)
+	for _, record := range rolls.records {
+		counts[record.Kind]++
+		if record.CharacterName != character.Name {
+			t.Errorf("record %q by %q, want the hunting character", record.Expression, record.CharacterName)
+		}
+	}
+	if counts[entities.RollAttack] != attacks {
+		t.Errorf("recorded %d attacks, want %d", counts[entities.RollAttack], attacks)
+	}
+	if counts[entities.RollLoot] != looted {
+		t.Errorf("recorded %d loot rolls, want %d", counts[entities.RollLoot], looted)
+	}
+}
+
+func TestHuntService_Flee(t *testing.T) {
+	mock := NewMockCharacterRepository()
+	repo := copyingCharacterRepository{mock}
+	character := newCharacterWithHealth(1000)
+	character.Combat.MaxHealth = 1000
+	mock.Create(context.Background(), character)
+
+	service := NewHuntService(repo, &mockMonsterRepository{})
+	service.SetRandomSource(dice.NewSource(7))
+
+	if _, _, err := service.StartHunt(context.Background(), "123", "456"); err != nil {
+		t.Fatalf("StartHunt() error = %v", err)
+	}
+	hunt, err := service.Flee(context.Background(), "123", "456")
+	if err != nil {
+		t.Fatalf("Flee() error = %v", err)
+	}
+	if character.Combat.IsInCombat || character.Combat.Health != hunt.Character.Combat.Health {
+		t.Errorf("Flee() stored combat = %+v, want out of combat with %d health", character.Combat, hunt.Character.Combat.Health)
+	}
+	if err := repo.Update(context.Background(), character); err != nil {
+		t.Errorf("Update() after fleeing error = %v", err)
+	}
+}
+
+func TestHuntService_SkipsInvalidMonsters(t *testing.T) {
+	broken := &entities.Monster{ID: "sem-ataques", Name: "Sem Ataques", MaxHealth: 5, ChallengeRating: 0.125}
+	monsters := &mockMonsterRepository{monsters: append(bestiary.Monsters(), broken)}
+	service := NewHuntService(NewMockCharacterRepository(), monsters)
+
+	loaded, err := service.loadBestiary(context.Background())
+	if err != nil {
+		t.Fatalf("loadBestiary() error = %v", err)
+	}
+	for _, m := range loaded {
+		if m == broken {
+			t.Error("loadBestiary() kept a monster without attacks")
+		}
+	}
+
+	monsters.monsters = []*entities.Monster{broken}
+	if _, err := service.loadBestiary(context.Background()); err == nil {
+		t.Error("loadBestiary() with only invalid monsters succeeded")
+	}
+}
+
+func TestCharacterService_UseItemDuringHunt(t *testing.T) {
+	mock := NewMockCharacterRepository()
+	repo := copyingCharacterRepository{mock}
+	character := newCharacterWithHealth(1000)
+	character.Combat.MaxHealth = 1000
+	character.Inventory = []entities.Item{{Name: "Poção", Type: gamedata.Consumable, Quantity: 1, Effects: "heal:5"}}
+	mock.Create(context.Background(), character)
+
+	hunts := NewHuntService(repo, &mockMonsterRepository{})
+	hunts.SetRandomSource(dice.NewSource(7))
+	if _, _, err := hunts.StartHunt(context.Background(), "123", "456"); err != nil {
+		t.Fatalf("StartHunt() error = %v", err)
+	}
+
+	service := NewCharacterService(repo, nil)
+	loaded, _ := repo.GetByUserAndGuild(context.Background(), "123", "456")
+	if _, err := service.UseItem(context.Background(), loaded, "Poção"); !errors.Is(err, entities.ErrCharacterInCombat) {
+		t.Errorf("UseItem() during a hunt error = %v, want %v", err, entities.ErrCharacterInCombat)
+	}
+	if loaded.Inventory[0].Quantity != 1 || character.Inventory[0].Quantity != 1 {
+		t.Error("UseItem() during a hunt consumed the item")
+	}
+
+	if _, err := hunts.Flee(context.Background(), "123", "456"); err != nil {
+		t.Fatalf("Flee() error = %v", err)
+	}
+	loaded, _ = repo.GetByUserAndGuild(context.Background(), "123", "456")
+	if _, err := service.UseItem(context.Background(), loaded, "Poção"); err != nil {
+		t.Errorf("UseItem() after the hunt error = %v", err)
+	}
+}
@@ -0,0 +1,344 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"sirdraith/internal/domain/dice"
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/quests"
+	"sirdraith/internal/domain/repositories"
+)
+
+var (
+	// ErrQuestNotFound indica uma missão inexistente na lista
+	ErrQuestNotFound = errors.New("missão não encontrada")
+	// ErrQuestAlreadyAccepted indica uma missão que o personagem já aceitou
+	ErrQuestAlreadyAccepted = errors.New("você já aceitou esta missão")
+	// ErrTooManyQuests indica que o personagem atingiu o limite de missões ativas
+	ErrTooManyQuests = fmt.Errorf("você já possui %d missões ativas", entities.MaxActiveQuests)
+)
+
+// QuestBoard reúne as missões oferecidas no servidor e as missões ativas do personagem
+type QuestBoard struct {
+	Character *entities.Character
+	Available []*entities.Quest
+	Active    []*entities.QuestProgress
+}
+
+// QuestCompletion descreve uma tentativa de concluir uma missão
+type QuestCompletion struct {
+	Progress     *entities.QuestProgress
+	Character    *entities.Character
+	Checks       []entities.SkillCheckResult // Testes de perícia rolados nesta tentativa
+	Completed    bool
+	LevelsGained int
+}
+
+// QuestService encapsula a lógica de missões
+type QuestService struct {
+	questRepo     repositories.QuestRepository
+	progressRepo  repositories.QuestProgressRepository
+	scheduleRepo  repositories.QuestScheduleRepository
+	characterRepo repositories.CharacterRepository
+	recorder      RollRecorder // Opcional, registra os testes de perícia
+	rng           *rand.Rand
+	mu            sync.Mutex // Serializa as alterações de progresso
+}
+
+// NewQuestService cria uma nova instância do serviço de missões
+func NewQuestService(questRepo repositories.QuestRepository, progressRepo repositories.QuestProgressRepository, scheduleRepo repositories.QuestScheduleRepository, characterRepo repositories.CharacterRepository) *QuestService {
+	return &QuestService{
+		questRepo:     questRepo,
+		progressRepo:  progressRepo,
+		scheduleRepo:  scheduleRepo,
+		characterRepo: characterRepo,
+		rng:           dice.NewRandomSource().Rand(),
+	}
+}
+
+// SetRandomSource define a fonte aleatória dos testes de perícia das missões
+func (s *QuestService) SetRandomSource(source *dice.Source) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rng = source.Rand()
+}
+
+// SetRollRecorder define quem registra os testes de perícia das missões
+func (s *QuestService) SetRollRecorder(recorder RollRecorder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recorder = recorder
+}
+
+// GetBoard retorna as missões oferecidas e as missões ativas do personagem do
+// usuário, expirando as que passaram do prazo
+func (s *QuestService) GetBoard(ctx context.Context, userID, guildID string) (*QuestBoard, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.board(ctx, userID, guildID, time.Now())
+}
+
+// AcceptQuest aceita a missão de número informado (começando em 1) na lista de disponíveis
+func (s *QuestService) AcceptQuest(ctx context.Context, userID, guildID string, number int) (*entities.QuestProgress, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	board, err := s.board(ctx, userID, guildID, now)
+	if err != nil {
+		return nil, err
+	}
+	if number < 1 || number > len(board.Available) {
+		return nil, ErrQuestNotFound
+	}
+	if len(board.Active) >= entities.MaxActiveQuests {
+		return nil, ErrTooManyQuests
+	}
+
+	quest := board.Available[number-1]
+	existing, err := s.progressRepo.FindByCharacterAndQuest(ctx, board.Character.ID.Hex(), quest.ID.Hex())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar missão: %w", err)
+	}
+	if existing != nil {
+		return nil, ErrQuestAlreadyAccepted
+	}
+
+	progress, err := entities.NewQuestProgress(quest, board.Character, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.progressRepo.Create(ctx, progress); err != nil {
+		return nil, fmt.Errorf("erro ao aceitar missão: %w", err)
+	}
+	return progress, nil
+}
+
+// CompleteQuest tenta concluir a missão ativa de número informado (começando em 1).
+// Testes de perícia pendentes são rolados antes de verificar os objetivos
+func (s *QuestService) CompleteQuest(ctx context.Context, userID, guildID string, number int) (*QuestCompletion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	board, err := s.board(ctx, userID, guildID, now)
+	if err != nil {
+		return nil, err
+	}
+	if number < 1 || number > len(board.Active) {
+		return nil, ErrQuestNotFound
+	}
+
+	character := board.Character
+	if character.Combat.IsInCombat {
+		return nil, entities.ErrCharacterInCombat
+	}
+	progress := board.Active[number-1]
+	completion := &QuestCompletion{Progress: progress, Character: character}
+
+	completion.Checks, err = progress.AttemptSkillChecks(character, func() int { return s.rng.Intn(20) + 1 }, now)
+	if err != nil {
+		return nil, err
+	}
+
+	levelBefore := character.Level
+	err = progress.Complete(character, now)
+	if errors.Is(err, entities.ErrQuestNotComplete) {
+		if len(completion.Checks) > 0 {
+			if err := s.progressRepo.Update(ctx, progress); err != nil {
+				return nil, fmt.Errorf("erro ao salvar missão: %w", err)
+			}
+		}
+		s.recordChecks(ctx, character, completion.Checks)
+		return completion, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	completion.Completed = true
+	completion.LevelsGained = character.Level - levelBefore
+	// A missão é encerrada antes de salvar as recompensas, para que uma segunda
+	// conclusão não as entregue de novo
+	if err := s.progressRepo.Complete(ctx, progress); err != nil {
+		return nil, fmt.Errorf("erro ao salvar missão: %w", err)
+	}
+	character.UpdatedAt = now
+	if err := s.characterRepo.Update(ctx, character); err != nil {
+		progress.Status = entities.QuestActive
+		progress.CompletedAt = nil
+		if rollbackErr := s.progressRepo.Update(ctx, progress); rollbackErr != nil {
+			return nil, fmt.Errorf("erro ao salvar personagem: %w (falha ao reabrir missão: %v)", err, rollbackErr)
+		}
+		return nil, fmt.Errorf("erro ao salvar personagem: %w", err)
+	}
+	s.recordChecks(ctx, character, completion.Checks)
+	return completion, nil
+}
+
+// recordChecks registra os testes de perícia rolados. Deve ser chamado depois de
+// salvar a missão, já que os registros não desfazem a tentativa
+func (s *QuestService) recordChecks(ctx context.Context, character *entities.Character, checks []entities.SkillCheckResult) {
+	for _, check := range checks {
+		expression := fmt.Sprintf("1d20%+d (%s, CD %d)", check.Modifier, check.Objective.Target, check.Objective.Difficulty)
+		recordRoll(ctx, s.recorder, RollerOf(character), entities.RollSkillCheck, expression, []int{check.Roll}, check.Roll+check.Modifier)
+	}
+}
+
+// RecordDefeats avança os objetivos de derrota das missões ativas do personagem
+func (s *QuestService) RecordDefeats(ctx context.Context, character *entities.Character, monsterIDs []string) ([]*entities.QuestProgress, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	active, err := s.progressRepo.FindActiveByCharacter(ctx, character.ID.Hex())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar missões: %w", err)
+	}
+
+	var updated []*entities.QuestProgress
+	for _, progress := range active {
+		advanced := false
+		for _, monsterID := range monsterIDs {
+			if progress.RecordDefeat(monsterID) {
+				advanced = true
+			}
+		}
+		if !advanced {
+			continue
+		}
+		if err := s.progressRepo.Update(ctx, progress); err != nil {
+			return nil, fmt.Errorf("erro ao salvar missão: %w", err)
+		}
+		updated = append(updated, progress)
+	}
+	return updated, nil
+}
+
+// board carrega o personagem, as missões oferecidas e as missões ativas
+func (s *QuestService) board(ctx context.Context, userID, guildID string, now time.Time) (*QuestBoard, error) {
+	character, err := findCharacter(ctx, s.characterRepo, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	available, err := s.availableQuests(ctx, guildID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := s.progressRepo.FindActiveByCharacter(ctx, character.ID.Hex())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar missões: %w", err)
+	}
+	var active []*entities.QuestProgress
+	for _, p := range progress {
+		if p.Expire(now) {
+			if err := s.progressRepo.Update(ctx, p); err != nil {
+				return nil, fmt.Errorf("erro ao salvar missão: %w", err)
+			}
+			continue
+		}
+		active = append(active, p)
+	}
+
+	return &QuestBoard{Character: character, Available: available, Active: active}, nil
+}
+
+// RotateQuests renova as missões de uma frequência no servidor se o período
+// atual ainda não foi renovado, retirando do quadro as missões do período
+// anterior. Retorna o estado da renovação do período atual
+func (s *QuestService) RotateQuests(ctx context.Context, guildID string, cadence entities.QuestCadence, now time.Time) (*entities.QuestSchedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rotate(ctx, guildID, cadence, now)
+}
+
+// MarkPosted registra que o quadro do período foi anunciado
+func (s *QuestService) MarkPosted(ctx context.Context, schedule *entities.QuestSchedule, now time.Time) error {
+	schedule.PostedAt = &now
+	schedule.UpdatedAt = now
+	if err := s.scheduleRepo.Update(ctx, schedule); err != nil {
+		return fmt.Errorf("erro ao salvar renovação de missões: %w", err)
+	}
+	return nil
+}
+
+// AvailableQuests retorna as missões oferecidas no servidor
+func (s *QuestService) AvailableQuests(ctx context.Context, guildID string, now time.Time) ([]*entities.Quest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.availableQuests(ctx, guildID, now)
+}
+
+// ExpireOverdue encerra as missões aceitas no servidor cujo prazo acabou
+func (s *QuestService) ExpireOverdue(ctx context.Context, guildID string, now time.Time) (int64, error) {
+	expired, err := s.progressRepo.ExpireOverdue(ctx, guildID, now)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao expirar missões: %w", err)
+	}
+	return expired, nil
+}
+
+// availableQuests busca as missões oferecidas no servidor, renovando antes as
+// frequências cujo período atual ainda não foi renovado
+func (s *QuestService) availableQuests(ctx context.Context, guildID string, now time.Time) ([]*entities.Quest, error) {
+	for _, cadence := range entities.Cadences {
+		if _, err := s.rotate(ctx, guildID, cadence, now); err != nil {
+			return nil, err
+		}
+	}
+
+	available, err := s.questRepo.FindAvailable(ctx, guildID, now)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar missões: %w", err)
+	}
+	return available, nil
+}
+
+// rotate reivindica o período atual e publica as missões sorteadas. Um período
+// reivindicado mas não publicado (ex: o bot caiu no meio da renovação) é
+// publicado novamente, substituindo as missões que chegaram a ser criadas
+func (s *QuestService) rotate(ctx context.Context, guildID string, cadence entities.QuestCadence, now time.Time) (*entities.QuestSchedule, error) {
+	period := cadence.PeriodStart(now)
+
+	schedule, err := s.scheduleRepo.Get(ctx, guildID, cadence)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar renovação de missões: %w", err)
+	}
+	if schedule == nil || schedule.Period.Before(period) {
+		if _, err := s.scheduleRepo.Claim(ctx, guildID, cadence, period); err != nil {
+			return nil, fmt.Errorf("erro ao reivindicar renovação de missões: %w", err)
+		}
+		if schedule, err = s.scheduleRepo.Get(ctx, guildID, cadence); err != nil {
+			return nil, fmt.Errorf("erro ao buscar renovação de missões: %w", err)
+		}
+		if schedule == nil {
+			return nil, fmt.Errorf("renovação de missões de %s não encontrada", guildID)
+		}
+	}
+	if schedule.RotatedAt != nil {
+		return schedule, nil
+	}
+
+	if err := s.questRepo.ExpireByCadence(ctx, guildID, cadence, now); err != nil {
+		return nil, fmt.Errorf("erro ao retirar missões: %w", err)
+	}
+	for _, quest := range quests.Rotation(quests.Templates(), guildID, cadence, now) {
+		quest.CreatedAt = now
+		if err := s.questRepo.Create(ctx, quest); err != nil {
+			return nil, fmt.Errorf("erro ao publicar missões: %w", err)
+		}
+	}
+
+	schedule.RotatedAt = &now
+	schedule.UpdatedAt = now
+	if err := s.scheduleRepo.Update(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("erro ao salvar renovação de missões: %w", err)
+	}
+	return schedule, nil
+}
@@ -0,0 +1,313 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/quests"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// mockQuestRepository guarda as missões oferecidas em memória
+type mockQuestRepository struct {
+	quests []*entities.Quest
+}
+
+func (m *mockQuestRepository) Create(ctx context.Context, quest *entities.Quest) error {
+	if quest.ID.IsZero() {
+		quest.ID = primitive.NewObjectID()
+	}
+	m.quests = append(m.quests, quest)
+	return nil
+}
+
+func (m *mockQuestRepository) FindByID(ctx context.Context, id string) (*entities.Quest, error) {
+	for _, quest := range m.quests {
+		if quest.ID.Hex() == id {
+			return quest, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockQuestRepository) FindAvailable(ctx context.Context, guildID string, now time.Time) ([]*entities.Quest, error) {
+	var available []*entities.Quest
+	for _, quest := range m.quests {
+		if quest.GuildID == guildID && quest.IsAvailable(now) {
+			available = append(available, quest)
+		}
+	}
+	return available, nil
+}
+
+func (m *mockQuestRepository) ExpireByCadence(ctx context.Context, guildID string, cadence entities.QuestCadence, now time.Time) error {
+	for _, quest := range m.quests {
+		if quest.GuildID == guildID && quest.Cadence == cadence && quest.IsAvailable(now) {
+			expiresAt := now
+			quest.ExpiresAt = &expiresAt
+		}
+	}
+	return nil
+}
+
+// mockQuestScheduleRepository guarda o estado das renovações em memória,
+// reivindicando apenas períodos posteriores como o repositório MongoDB
+type mockQuestScheduleRepository struct {
+	schedules map[string]entities.QuestSchedule
+	claims    int
+}
+
+func newMockQuestScheduleRepository() *mockQuestScheduleRepository {
+	return &mockQuestScheduleRepository{schedules: make(map[string]entities.QuestSchedule)}
+}
+
+func (m *mockQuestScheduleRepository) Get(ctx context.Context, guildID string, cadence entities.QuestCadence) (*entities.QuestSchedule, error) {
+	schedule, ok := m.schedules[entities.QuestScheduleID(guildID, cadence)]
+	if !ok {
+		return nil, nil
+	}
+	return &schedule, nil
+}
+
+func (m *mockQuestScheduleRepository) Claim(ctx context.Context, guildID string, cadence entities.QuestCadence, period time.Time) (bool, error) {
+	id := entities.QuestScheduleID(guildID, cadence)
+	if schedule, ok := m.schedules[id]; ok && !schedule.Period.Before(period) {
+		return false, nil
+	}
+	m.schedules[id] = entities.QuestSchedule{ID: id, GuildID: guildID, Cadence: cadence, Period: period, UpdatedAt: time.Now()}
+	m.claims++
+	return true, nil
+}
+
+func (m *mockQuestScheduleRepository) Update(ctx context.Context, schedule *entities.QuestSchedule) error {
+	m.schedules[schedule.ID] = *schedule
+	return nil
+}
+
+// mockQuestProgressRepository guarda o progresso das missões em memória,
+// concluindo cada missão uma única vez como o repositório MongoDB
+type mockQuestProgressRepository struct {
+	progress    []*entities.QuestProgress
+	completed   map[primitive.ObjectID]bool
+	completeErr error // Simula uma falha ao salvar a conclusão
+}
+
+func (m *mockQuestProgressRepository) Create(ctx context.Context, progress *entities.QuestProgress) error {
+	m.progress = append(m.progress, progress)
+	return nil
+}
+
+func (m *mockQuestProgressRepository) Update(ctx context.Context, progress *entities.QuestProgress) error {
+	if progress.Status == entities.QuestActive {
+		delete(m.completed, progress.ID)
+	}
+	return nil
+}
+
+func (m *mockQuestProgressRepository) Complete(ctx context.Context, progress *entities.QuestProgress) error {
+	if m.completeErr != nil {
+		return m.completeErr
+	}
+	if m.completed[progress.ID] {
+		return entities.ErrQuestNotActive
+	}
+	if m.completed == nil {
+		m.completed = make(map[primitive.ObjectID]bool)
+	}
+	m.completed[progress.ID] = true
+	return nil
+}
+
+func (m *mockQuestProgressRepository) FindActiveByCharacter(ctx context.Context, characterID string) ([]*entities.QuestProgress, error) {
+	var active []*entities.QuestProgress
+	for _, progress := range m.progress {
+		if progress.CharacterID == characterID && progress.Status == entities.QuestActive {
+			active = append(active, progress)
+		}
+	}
+	return active, nil
+}
+
+func (m *mockQuestProgressRepository) FindByCharacterAndQuest(ctx context.Context, characterID, questID string) (*entities.QuestProgress, error) {
+	for _, progress := range m.progress {
+		if progress.CharacterID == characterID && progress.QuestID == questID {
+			return progress, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockQuestProgressRepository) ExpireOverdue(ctx context.Context, guildID string, now time.Time) (int64, error) {
+	var expired int64
+	for _, progress := range m.progress {
+		if progress.GuildID == guildID && progress.Expire(now) {
+			expired++
+		}
+	}
+	return expired, nil
+}
+
+func countCadence(available []*entities.Quest, cadence entities.QuestCadence) int {
+	count := 0
+	for _, quest := range available {
+		if quest.Cadence == cadence {
+			count++
+		}
+	}
+	return count
+}
+
+func TestQuestService_RotateQuests_SamePeriod(t *testing.T) {
+	questRepo := &mockQuestRepository{}
+	scheduleRepo := newMockQuestScheduleRepository()
+	service := NewQuestService(questRepo, nil, scheduleRepo, NewMockCharacterRepository())
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	first, err := service.RotateQuests(context.Background(), "456", entities.CadenceDaily, now)
+	if err != nil {
+		t.Fatalf("RotateQuests() error = %v", err)
+	}
+	if first.RotatedAt == nil || !first.Period.Equal(entities.CadenceDaily.PeriodStart(now)) {
+		t.Errorf("RotateQuests() = %+v, want the current period rotated", first)
+	}
+
+	second, err := service.RotateQuests(context.Background(), "456", entities.CadenceDaily, now.Add(5*time.Hour))
+	if err != nil {
+		t.Fatalf("RotateQuests() error = %v", err)
+	}
+	if !second.RotatedAt.Equal(*first.RotatedAt) || scheduleRepo.claims != 1 {
+		t.Errorf("RotateQuests() rotated again in the same period: %+v (%d claims)", second, scheduleRepo.claims)
+	}
+	if len(questRepo.quests) != quests.DailyQuestCount {
+		t.Errorf("RotateQuests() created %d quests, want %d", len(questRepo.quests), quests.DailyQuestCount)
+	}
+}
+
+func TestQuestService_RotateQuests_RestartMidRotation(t *testing.T) {
+	questRepo := &mockQuestRepository{}
+	scheduleRepo := newMockQuestScheduleRepository()
+	service := NewQuestService(questRepo, nil, scheduleRepo, NewMockCharacterRepository())
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	// O bot caiu depois de reivindicar o período e publicar só uma das missões
+	if _, err := scheduleRepo.Claim(context.Background(), "456", entities.CadenceDaily, entities.CadenceDaily.PeriodStart(now)); err != nil {
+		t.Fatalf("Claim() error = %v", err)
+	}
+	partial := quests.Rotation(quests.Templates(), "456", entities.CadenceDaily, now)[0]
+	questRepo.Create(context.Background(), partial)
+
+	schedule, err := service.RotateQuests(context.Background(), "456", entities.CadenceDaily, now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("RotateQuests() error = %v", err)
+	}
+	if schedule.RotatedAt == nil || schedule.PostedAt != nil {
+		t.Errorf("RotateQuests() = %+v, want the claimed period rotated and not yet posted", schedule)
+	}
+	if scheduleRepo.claims != 1 {
+		t.Errorf("RotateQuests() claimed %d periods, want the pending one reused", scheduleRepo.claims)
+	}
+
+	available, _ := questRepo.FindAvailable(context.Background(), "456", now.Add(time.Minute))
+	if got := countCadence(available, entities.CadenceDaily); got != quests.DailyQuestCount {
+		t.Errorf("RotateQuests() left %d daily quests on the board, want %d", got, quests.DailyQuestCount)
+	}
+	if partial.IsAvailable(now.Add(time.Minute)) {
+		t.Error("RotateQuests() kept the quest from the interrupted rotation on the board")
+	}
+}
+
+func TestQuestService_RotateQuests_NextPeriod(t *testing.T) {
+	questRepo := &mockQuestRepository{}
+	scheduleRepo := newMockQuestScheduleRepository()
+	service := NewQuestService(questRepo, nil, scheduleRepo, NewMockCharacterRepository())
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	first, err := service.RotateQuests(context.Background(), "456", entities.CadenceDaily, now)
+	if err != nil {
+		t.Fatalf("RotateQuests() error = %v", err)
+	}
+	if err := service.MarkPosted(context.Background(), first, now); err != nil {
+		t.Fatalf("MarkPosted() error = %v", err)
+	}
+
+	tomorrow := now.AddDate(0, 0, 1)
+	next, err := service.RotateQuests(context.Background(), "456", entities.CadenceDaily, tomorrow)
+	if err != nil {
+		t.Fatalf("RotateQuests() error = %v", err)
+	}
+	if !next.Period.Equal(entities.CadenceDaily.PeriodStart(tomorrow)) || next.RotatedAt == nil || next.PostedAt != nil {
+		t.Errorf("RotateQuests() = %+v, want the new period rotated and waiting to be posted", next)
+	}
+
+	available, _ := questRepo.FindAvailable(context.Background(), "456", tomorrow)
+	if len(available) != quests.DailyQuestCount {
+		t.Errorf("RotateQuests() left %d quests on the board, want only the new %d", len(available), quests.DailyQuestCount)
+	}
+}
+
+// failingUpdateCharacterRepository simula uma falha do banco ao salvar personagens
+type failingUpdateCharacterRepository struct {
+	copyingCharacterRepository
+	fail bool
+}
+
+func (r *failingUpdateCharacterRepository) Update(ctx context.Context, character *entities.Character) error {
+	if r.fail {
+		return errors.New("conexão perdida")
+	}
+	return r.copyingCharacterRepository.Update(ctx, character)
+}
+
+func TestQuestService_CompleteQuest_SavesProgressFirst(t *testing.T) {
+	mock := NewMockCharacterRepository()
+	character := newCharacterWithHealth(10)
+	mock.Create(context.Background(), character)
+	goldBefore := character.Gold
+	characters := &failingUpdateCharacterRepository{copyingCharacterRepository: copyingCharacterRepository{mock}}
+
+	quest := &entities.Quest{
+		ID:         primitive.NewObjectID(),
+		GuildID:    "456",
+		Title:      "Caçar",
+		Objectives: []entities.QuestObjective{{Type: entities.ObjectiveDefeat, Amount: 1}},
+		Reward:     entities.QuestReward{Gold: 30},
+	}
+	progress, _ := entities.NewQuestProgress(quest, character, time.Now())
+	progress.Progress[0] = 1
+	progressRepo := &mockQuestProgressRepository{progress: []*entities.QuestProgress{progress}}
+	service := NewQuestService(&mockQuestRepository{}, progressRepo, newMockQuestScheduleRepository(), characters)
+
+	progressRepo.completeErr = errors.New("conexão perdida")
+	if _, err := service.CompleteQuest(context.Background(), "123", "456", 1); err == nil {
+		t.Fatal("CompleteQuest() succeeded without saving the quest")
+	}
+	if saved, _ := mock.GetByID(context.Background(), character.ID.Hex()); saved.Gold != goldBefore {
+		t.Errorf("CompleteQuest() paid %d gold without saving the quest", saved.Gold-goldBefore)
+	}
+
+	progressRepo.completeErr = nil
+	progress.Status = entities.QuestActive
+	characters.fail = true
+	if _, err := service.CompleteQuest(context.Background(), "123", "456", 1); err == nil {
+		t.Fatal("CompleteQuest() succeeded without saving the character")
+	}
+	if progress.Status != entities.QuestActive || progressRepo.completed[progress.ID] {
+		t.Errorf("CompleteQuest() left the quest %s after failing to pay it", progress.Status)
+	}
+
+	characters.fail = false
+	completion, err := service.CompleteQuest(context.Background(), "123", "456", 1)
+	if err != nil || !completion.Completed {
+		t.Fatalf("CompleteQuest() = %+v, %v, want the quest completed", completion, err)
+	}
+	if saved, _ := mock.GetByID(context.Background(), character.ID.Hex()); saved.Gold != goldBefore+30 {
+		t.Errorf("CompleteQuest() gold = %d, want %d", saved.Gold, goldBefore+30)
+	}
+	if err := progressRepo.Complete(context.Background(), progress); !errors.Is(err, entities.ErrQuestNotActive) {
+		t.Errorf("Complete() of a completed quest error = %v, want %v", err, entities.ErrQuestNotActive)
+	}
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"strings"
+	"time"
+
+	"sirdraith/internal/domain/dice"
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repositories"
+	"sirdraith/internal/domain/repository"
+)
+
+// MaxRollHistory é o máximo de rolagens mostradas no histórico da auditoria
+const MaxRollHistory = 25
+
+// Roller identifica quem fez uma rolagem
+type Roller struct {
+	GuildID       string
+	UserID        string
+	CharacterName string // Vazio quando o usuário rola sem personagem
+}
+
+// RollerOf identifica a rolagem como feita pelo personagem
+func RollerOf(character *entities.Character) Roller {
+	return Roller{GuildID: character.GuildID, UserID: character.UserID, CharacterName: character.Name}
+}
+
+// RollRecorder registra as rolagens feitas fora do serviço de rolagens, como
+// os testes contra a morte
+type RollRecorder interface {
+	Record(ctx context.Context, roller Roller, kind entities.RollKind, expression string, dice []int, total int) error
+}
+
+// recordRoll registra a rolagem, se houver quem registre. A auditoria é
+// opcional e a ação já foi salva, então uma falha no registro só é logada
+func recordRoll(ctx context.Context, recorder RollRecorder, roller Roller, kind entities.RollKind, expression string, dice []int, total int) {
+	if recorder == nil {
+		return
+	}
+	if err := recorder.Record(ctx, roller, kind, expression, dice, total); err != nil {
+		log.Printf("Erro ao registrar rolagem de %s no servidor %s: %v", roller.UserID, roller.GuildID, err)
+	}
+}
+
+// RollService rola os dados do jogo a partir de uma fonte aleatória comum e,
+// nos servidores com auditoria ativa, registra cada rolagem
+type RollService struct {
+	source     *dice.Source
+	rng        *rand.Rand
+	repo       repositories.RollRepository
+	configRepo repository.ConfigRepository
+}
+
+// NewRollService cria uma nova instância do serviço de rolagens. Sem
+// repositório, as rolagens não são registradas
+func NewRollService(source *dice.Source, repo repositories.RollRepository, configRepo repository.ConfigRepository) *RollService {
+	return &RollService{
+		source:     source,
+		rng:        source.Rand(),
+		repo:       repo,
+		configRepo: configRepo,
+	}
+}
+
+// Source retorna a fonte aleatória do serviço, para ser compartilhada com os
+// demais serviços que rolam dados
+func (s *RollService) Source() *dice.Source {
+	return s.source
+}
+
+// Roll rola uma expressão de dados e a registra na auditoria
+func (s *RollService) Roll(ctx context.Context, roller Roller, kind entities.RollKind, expression *dice.Expression, opts dice.Options) (*dice.Result, error) {
+	return s.roll(ctx, roller, kind, expression, opts, "")
+}
+
+// RollCheck rola um d20 somado ao modificador, com vantagem ou desvantagem, e o
+// registra na auditoria com a descrição do teste
+func (s *RollService) RollCheck(ctx context.Context, roller Roller, kind entities.RollKind, description string, modifier int, mode dice.Mode) (*dice.Result, error) {
+	expression, err := dice.Parse(fmt.Sprintf("1d20%+d", modifier))
+	if err != nil {
+		return nil, err
+	}
+	return s.roll(ctx, roller, kind, expression, dice.Options{Mode: mode}, description)
+}
+
+// roll rola a expressão e registra os dados rolados, o modo e a descrição
+func (s *RollService) roll(ctx context.Context, roller Roller, kind entities.RollKind, expression *dice.Expression, opts dice.Options, description string) (*dice.Result, error) {
+	result, err := expression.Roll(s.rng, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var rolled []int
+	for _, term := range result.Terms {
+		for _, die := range term.Dice {
+			rolled = append(rolled, die.Value)
+		}
+	}
+	var details []string
+	if description != "" {
+		details = append(details, description)
+	}
+	switch result.Mode {
+	case dice.Advantage:
+		details = append(details, "vantagem")
+	case dice.Disadvantage:
+		details = append(details, "desvantagem")
+	}
+	notation := result.Expression
+	if len(details) > 0 {
+		notation += " (" + strings.Join(details, ", ") + ")"
+	}
+
+	if err := s.Record(ctx, roller, kind, notation, rolled, result.Total); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RollD20 rola um d20 e o registra na auditoria com a descrição informada
+func (s *RollService) RollD20(ctx context.Context, roller Roller, kind entities.RollKind, description string) (int, error) {
+	roll := s.rng.Intn(20) + 1
+	if err := s.Record(ctx, roller, kind, description, []int{roll}, roll); err != nil {
+		return 0, err
+	}
+	return roll, nil
+}
+
+// Record registra uma rolagem se o servidor tiver a auditoria ativa
+func (s *RollService) Record(ctx context.Context, roller Roller, kind entities.RollKind, expression string, dice []int, total int) error {
+	enabled, err := s.AuditEnabled(roller.GuildID)
+	if err != nil || !enabled {
+		return err
+	}
+
+	record := &entities.RollRecord{
+		GuildID:       roller.GuildID,
+		UserID:        roller.UserID,
+		CharacterName: roller.CharacterName,
+		Kind:          kind,
+		Expression:    expression,
+		Dice:          dice,
+		Total:         total,
+		CreatedAt:     time.Now(),
+	}
+	if err := s.repo.Create(ctx, record); err != nil {
+		return fmt.Errorf("erro ao registrar rolagem: %w", err)
+	}
+	return nil
+}
+
+// AuditEnabled indica se as rolagens do servidor são registradas
+func (s *RollService) AuditEnabled(guildID string) (bool, error) {
+	if s.repo == nil || s.configRepo == nil {
+		return false, nil
+	}
+
+	config, err := s.configRepo.GetGuildConfig(guildID)
+	if err != nil {
+		return false, fmt.Errorf("erro ao buscar configuração de auditoria: %w", err)
+	}
+	return config.RollAudit, nil
+}
+
+// History retorna as rolagens registradas mais recentes do servidor, de um
+// usuário ou de todos com userID vazio
+func (s *RollService) History(ctx context.Context, guildID, userID string, limit int) ([]*entities.RollRecord, error) {
+	if s.repo == nil {
+		return nil, nil
+	}
+	if limit <= 0 || limit > MaxRollHistory {
+		limit = MaxRollHistory
+	}
+	return s.repo.FindRecent(ctx, guildID, userID, limit)
+}
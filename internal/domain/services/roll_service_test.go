@@ -0,0 +1,317 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"sirdraith/internal/domain/dice"
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/gamedata"
+	"sirdraith/internal/domain/model"
+	"sirdraith/internal/domain/repositories"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// mockRollRepository guarda as rolagens registradas em memória
+type mockRollRepository struct {
+	records []*entities.RollRecord
+}
+
+func (m *mockRollRepository) Create(ctx context.Context, record *entities.RollRecord) error {
+	m.records = append(m.records, record)
+	return nil
+}
+
+func (m *mockRollRepository) FindRecent(ctx context.Context, guildID, userID string, limit int) ([]*entities.RollRecord, error) {
+	var found []*entities.RollRecord
+	for i := len(m.records) - 1; i >= 0 && len(found) < limit; i-- {
+		record := m.records[i]
+		if record.GuildID == guildID && (userID == "" || record.UserID == userID) {
+			found = append(found, record)
+		}
+	}
+	return found, nil
+}
+
+func TestRollService_Roll(t *testing.T) {
+	expression, err := dice.Parse("4d6kh3+2")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	roll := func(seed int64, guildID string, repo *mockRollRepository) *dice.Result {
+		configRepo := &mockConfigRepository{configs: map[string]*model.GuildConfig{
+			"audited": {ID: "audited", RollAudit: true},
+		}}
+		service := NewRollService(dice.NewSource(seed), repo, configRepo)
+		roller := Roller{GuildID: guildID, UserID: "123", CharacterName: "Aria"}
+		result, err := service.Roll(context.Background(), roller, entities.RollExpression, expression, dice.Options{Mode: dice.Advantage})
+		if err != nil {
+			t.Fatalf("Roll() error = %v", err)
+		}
+		return result
+	}
+
+	repo := &mockRollRepository{}
+	first := roll(42, "audited", repo)
+	if second := roll(42, "quiet", repo); !reflect.DeepEqual(first, second) {
+		t.Errorf("Roll() with the same seed = %+v, want %+v", second, first)
+	}
+
+	if len(repo.records) != 1 {
+		t.Fatalf("Roll() recorded %d rolls, want only the audited guild's", len(repo.records))
+	}
+	record := repo.records[0]
+	if record.GuildID != "audited" || record.UserID != "123" || record.CharacterName != "Aria" || record.Kind != entities.RollExpression {
+		t.Errorf("Roll() record = %+v", record)
+	}
+	if record.Expression != "4d6kh3+2 (vantagem)" || record.Total != first.Total || len(record.Dice) != 4 || record.CreatedAt.IsZero() {
+		t.Errorf("Roll() record = %+v, want the rolled dice and total %d", record, first.Total)
+	}
+}
+
+func TestRollService_WithoutRepository(t *testing.T) {
+	service := NewRollService(dice.NewSource(1), nil, nil)
+
+	roll, err := service.RollD20(context.Background(), Roller{GuildID: "456"}, entities.RollSkillCheck, "1d20+3")
+	if err != nil || roll < 1 || roll > 20 {
+		t.Errorf("RollD20() = %d, %v", roll, err)
+	}
+	if history, err := service.History(context.Background(), "456", "", 10); err != nil || len(history) != 0 {
+		t.Errorf("History() = %v, %v, want no records", history, err)
+	}
+}
+
+func TestRollService_History(t *testing.T) {
+	repo := &mockRollRepository{}
+	configRepo := &mockConfigRepository{configs: map[string]*model.GuildConfig{
+		"456": {ID: "456", RollAudit: true},
+	}}
+	service := NewRollService(dice.NewSource(1), repo, configRepo)
+
+	for _, userID := range []string{"1", "2", "1"} {
+		if _, err := service.RollD20(context.Background(), Roller{GuildID: "456", UserID: userID}, entities.RollSkillCheck, "1d20"); err != nil {
+			t.Fatalf("RollD20() error = %v", err)
+		}
+	}
+
+	all, _ := service.History(context.Background(), "456", "", 0)
+	if len(all) != 3 || all[0] != repo.records[2] {
+		t.Errorf("History() = %d records, want the 3 newest first", len(all))
+	}
+	mine, _ := service.History(context.Background(), "456", "1", 1)
+	if len(mine) != 1 || mine[0] != repo.records[2] {
+		t.Errorf("History() for user = %v, want only the newest roll", mine)
+	}
+}
+
+func TestCharacterService_RecordsRolls(t *testing.T) {
+	repo := NewMockCharacterRepository()
+	configRepo := &mockConfigRepository{configs: map[string]*model.GuildConfig{
+		"456": {ID: "456", RollAudit: true},
+	}}
+	rolls := &mockRollRepository{}
+	service := NewCharacterService(repo, configRepo)
+	service.SetRandomSource(dice.NewSource(3))
+	service.SetRollRecorder(NewRollService(dice.NewSource(3), rolls, configRepo))
+
+	character := newCharacterWithHealth(10)
+	character.TakeDamage(10)
+	repo.Create(context.Background(), character)
+
+	result, err := service.DeathSave(context.Background(), character)
+	if err != nil {
+		t.Fatalf("DeathSave() error = %v", err)
+	}
+	if len(rolls.records) != 1 {
+		t.Fatalf("DeathSave() recorded %d rolls, want 1", len(rolls.records))
+	}
+	if record := rolls.records[0]; record.Kind != entities.RollDeathSave || record.Total != result.Roll || record.UserID != character.UserID {
+		t.Errorf("DeathSave() record = %+v, want roll %d", record, result.Roll)
+	}
+}
+
+func TestRollService_RollCheck(t *testing.T) {
+	repo := &mockRollRepository{}
+	configRepo := &mockConfigRepository{configs: map[string]*model.GuildConfig{
+		"456": {ID: "456", RollAudit: true},
+	}}
+	service := NewRollService(dice.NewSource(5), repo, configRepo)
+
+	result, err := service.RollCheck(context.Background(), Roller{GuildID: "456", UserID: "123"}, entities.RollSavingThrow, "Sabedoria, CD 15", -1, dice.Disadvantage)
+	if err != nil {
+		t.Fatalf("RollCheck() error = %v", err)
+	}
+
+	kept := result.Terms[0].Dice
+	if len(kept) != 2 || result.Total != min(kept[0].Value, kept[1].Value)-1 {
+		t.Errorf("RollCheck() = %+v, want the lowest of two d20 minus 1", result)
+	}
+	if record := repo.records[0]; record.Expression != "1d20-1 (Sabedoria, CD 15, desvantagem)" || record.Kind != entities.RollSavingThrow {
+		t.Errorf("RollCheck() record = %+v", record)
+	}
+}
+
+// failingRollRecorder simula uma auditoria fora do ar
+type failingRollRecorder struct {
+	calls int
+}
+
+func (r *failingRollRecorder) Record(ctx context.Context, roller Roller, kind entities.RollKind, expression string, dice []int, total int) error {
+	r.calls++
+	return errors.New("auditoria fora do ar")
+}
+
+// mockCollectionRepository guarda as coleções de cartas em memória
+type mockCollectionRepository struct {
+	collections map[string]*entities.CardCollection
+}
+
+func (m *mockCollectionRepository) FindByCharacter(ctx context.Context, characterID string) (*entities.CardCollection, error) {
+	return m.collections[characterID], nil
+}
+
+func (m *mockCollectionRepository) Save(ctx context.Context, collection *entities.CardCollection) error {
+	m.collections[collection.CharacterID] = collection
+	return nil
+}
+
+// mockCardRepository entrega o catálogo de cartas em memória
+type mockCardRepository struct {
+	repositories.CardRepository
+	cards []*entities.Card
+}
+
+func (m *mockCardRepository) FindAll(ctx context.Context) ([]*entities.Card, error) {
+	return m.cards, nil
+}
+
+func TestFailingRollRecorder_KeepsResults(t *testing.T) {
+	ctx := context.Background()
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	t.Run("booster", func(t *testing.T) {
+		characters := NewMockCharacterRepository()
+		character := newCharacterWithHealth(10)
+		character.Gold = 1000
+		characters.Create(ctx, character)
+		collections := &mockCollectionRepository{collections: map[string]*entities.CardCollection{}}
+		cards := &mockCardRepository{cards: []*entities.Card{{ID: "espada", Name: "Espada", Rarity: entities.CardRarityCommon}}}
+
+		recorder := &failingRollRecorder{}
+		service := NewCollectionService(collections, cards, characters)
+		service.SetRollRecorder(recorder)
+
+		_, opened, err := service.OpenBooster(ctx, "123", "456", "basico")
+		if err != nil || len(opened) == 0 {
+			t.Fatalf("OpenBooster() = %v, %v, want the cards", opened, err)
+		}
+		if recorder.calls == 0 {
+			t.Error("OpenBooster() did not record the pack")
+		}
+		if collections.collections[character.ID.Hex()].Cards["espada"] != len(opened) {
+			t.Error("OpenBooster() did not keep the cards")
+		}
+	})
+
+	t.Run("rest", func(t *testing.T) {
+		characters := NewMockCharacterRepository()
+		character := newCharacterWithHealth(10)
+		character.TakeDamage(6)
+		characters.Create(ctx, character)
+
+		recorder := &failingRollRecorder{}
+		service := NewCharacterService(characters, nil)
+		service.SetRollRecorder(recorder)
+
+		result, err := service.Rest(ctx, character, gamedata.ShortRest, 1)
+		if err != nil || result == nil {
+			t.Fatalf("Rest() = %v, %v, want the rest result", result, err)
+		}
+		if recorder.calls == 0 {
+			t.Error("Rest() did not record the hit dice")
+		}
+	})
+
+	t.Run("hunt", func(t *testing.T) {
+		characters := NewMockCharacterRepository()
+		character := newCharacterWithHealth(1000)
+		characters.Create(ctx, character)
+		quests := &countingDefeatListener{}
+
+		recorder := &failingRollRecorder{}
+		service := NewHuntService(copyingCharacterRepository{characters}, &mockMonsterRepository{})
+		service.SetRandomSource(dice.NewSource(7))
+		service.SetRollRecorder(recorder)
+		service.SetDefeatListener(quests)
+
+		_, turn, err := service.StartHunt(ctx, "123", "456")
+		for err == nil && !turn.Finished {
+			_, turn, err = service.Strike(ctx, "123", "456", 0)
+		}
+		if err != nil {
+			t.Fatalf("hunt error = %v", err)
+		}
+		if !turn.Victory || recorder.calls == 0 {
+			t.Fatalf("hunt victory = %v with %d records, want a recorded victory", turn.Victory, recorder.calls)
+		}
+		if quests.defeats == 0 {
+			t.Error("finish() skipped the quest progress after a failed record")
+		}
+		if character.Combat.IsInCombat {
+			t.Error("finish() left the character in combat")
+		}
+	})
+
+	t.Run("quest", func(t *testing.T) {
+		characters := NewMockCharacterRepository()
+		character := newCharacterWithHealth(10)
+		character.Attributes.Intelligence = 20
+		characters.Create(ctx, character)
+		goldBefore := character.Gold
+
+		now := time.Now()
+		quest := &entities.Quest{
+			ID:         primitive.NewObjectID(),
+			GuildID:    "456",
+			Title:      "Investigar",
+			Objectives: []entities.QuestObjective{{Type: entities.ObjectiveSkillCheck, Target: string(gamedata.Investigation), Difficulty: 1}},
+			Reward:     entities.QuestReward{Gold: 30},
+		}
+		progress, _ := entities.NewQuestProgress(quest, character, now)
+		progressRepo := &mockQuestProgressRepository{progress: []*entities.QuestProgress{progress}}
+
+		recorder := &failingRollRecorder{}
+		service := NewQuestService(&mockQuestRepository{}, progressRepo, newMockQuestScheduleRepository(), characters)
+		service.SetRollRecorder(recorder)
+
+		completion, err := service.CompleteQuest(ctx, "123", "456", 1)
+		if err != nil || !completion.Completed {
+			t.Fatalf("CompleteQuest() = %+v, %v, want the quest completed", completion, err)
+		}
+		if recorder.calls == 0 {
+			t.Error("CompleteQuest() did not record the skill check")
+		}
+		if character.Gold != goldBefore+30 {
+			t.Errorf("CompleteQuest() gold = %d, want %d", character.Gold, goldBefore+30)
+		}
+	})
+}
+
+// countingDefeatListener conta os monstros derrotados recebidos
+type countingDefeatListener struct {
+	defeats int
+}
+
+func (l *countingDefeatListener) RecordDefeats(ctx context.Context, character *entities.Character, monsterIDs []string) ([]*entities.QuestProgress, error) {
+	l.defeats += len(monsterIDs)
+	return nil, nil
+}
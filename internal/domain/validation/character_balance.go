@@ -2,73 +2,15 @@ package validation
 
 import (
 	"fmt"
-	"math"
 
 	"sirdraith/internal/domain/entities"
 	"sirdraith/internal/domain/gamedata"
 )
 
-// CalculateExpForLevel calcula a experiência necessária para um determinado nível
-func CalculateExpForLevel(level int) int {
-	if level <= 1 {
-		return 0
-	}
-	if level > gamedata.MaxLevel {
-		return -1
-	}
-
-	// Fórmula: Exp = Base * (1.5 ^ (Level - 1))
-	baseExp := 100.0
-	multiplier := math.Pow(1.5, float64(level-1))
-	return int(baseExp * multiplier)
-}
-
-// CalculateMaxHealth calcula o HP máximo do personagem baseado no nível e constituição
-func CalculateMaxHealth(level int, constitution int) int {
-	if level < 1 || level > gamedata.MaxLevel {
-		return 0
-	}
-
-	// Base HP por nível
-	baseHP := 10
-
-	// Bônus de constituição (começa em -1 para constituição 10)
-	constBonus := (constitution - 10) / 2
-
-	// HP total = (Base + Bônus Const) * Nível
-	totalHP := (baseHP + constBonus) * level
-
-	// Garantir mínimo de 1 HP
-	if totalHP < 1 {
-		return 1
-	}
-
-	return totalHP
-}
-
-// CalculateAttackBonus calcula o bônus de ataque baseado na classe e atributos
-func CalculateAttackBonus(class gamedata.CharacterClass, level int, attributes *gamedata.Attributes) int {
-	if attributes == nil {
-		return 0
-	}
-
-	// Bônus base por nível (proficiency bonus)
-	proficiencyBonus := (level-1)/4 + 2
-
-	// Bônus de atributo baseado na classe
-	var attrBonus int
-	switch class {
-	case gamedata.Warrior:
-		attrBonus = (attributes.Strength - 10) / 2
-	case gamedata.Ranger:
-		attrBonus = (attributes.Dexterity - 10) / 2
-	case gamedata.Mage:
-		attrBonus = (attributes.Intelligence - 10) / 2
-	default:
-		return 0
-	}
-
-	return proficiencyBonus + attrBonus
+// CalculateAttackBonus calcula o bônus de ataque baseado na classe, nos atributos
+// e no bônus de proficiência das regras de progressão
+func CalculateAttackBonus(ruleset *gamedata.ProgressionRuleset, class gamedata.CharacterClass, level int, attributes *gamedata.Attributes) int {
+	return gamedata.AttackBonus(ruleset, class, level, attributes)
 }
 
 // CalculateDefense calcula a defesa base do personagem
@@ -103,7 +45,7 @@ func CalculateDefense(class gamedata.CharacterClass, attributes *gamedata.Attrib
 	return baseDefense + attrBonus
 }
 
-// ValidateCharacterProgression valida a progressão do personagem
+// ValidateCharacterProgression valida a progressão do personagem segundo as suas regras de progressão
 func ValidateCharacterProgression(character *entities.Character) error {
 	if character == nil {
 		return fmt.Errorf("personagem não pode ser nulo")
@@ -114,9 +56,11 @@ func ValidateCharacterProgression(character *entities.Character) error {
 		return fmt.Errorf("nível inválido: deve estar entre %d e %d", gamedata.StartingLevel, gamedata.MaxLevel)
 	}
 
+	ruleset := character.Ruleset()
+
 	// Validar experiência
-	minExp := CalculateExpForLevel(character.Level)
-	maxExp := CalculateExpForLevel(character.Level + 1)
+	minExp := ruleset.ExpForLevel(character.Level)
+	maxExp := ruleset.ExpForLevel(character.Level + 1)
 	if maxExp != -1 && character.Experience >= maxExp {
 		return fmt.Errorf("experiência suficiente para subir de nível")
 	}
@@ -125,7 +69,7 @@ func ValidateCharacterProgression(character *entities.Character) error {
 	}
 
 	// Validar HP máximo
-	expectedHP := CalculateMaxHealth(character.Level, character.Attributes.Constitution)
+	expectedHP := ruleset.MaxHealth(character.Class, character.Level, character.Attributes.Constitution)
 	if character.Combat.MaxHealth != expectedHP {
 		return fmt.Errorf("HP máximo incorreto: esperado %d, atual %d", expectedHP, character.Combat.MaxHealth)
 	}
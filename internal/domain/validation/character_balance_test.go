@@ -9,83 +9,6 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestCalculateExpForLevel(t *testing.T) {
-	tests := []struct {
-		name     string
-		level    int
-		expected int
-	}{
-		{
-			name:     "nível 1 deve retornar 0",
-			level:    1,
-			expected: 0,
-		},
-		{
-			name:     "nível 2 deve retornar 100",
-			level:    2,
-			expected: 100,
-		},
-		{
-			name:     "nível 3 deve retornar 150",
-			level:    3,
-			expected: 150,
-		},
-		{
-			name:     "nível máximo + 1 deve retornar -1",
-			level:    gamedata.MaxLevel + 1,
-			expected: -1,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := CalculateExpForLevel(tt.level)
-			assert.Equal(t, tt.expected, result)
-		})
-	}
-}
-
-func TestCalculateMaxHealth(t *testing.T) {
-	tests := []struct {
-		name         string
-		level        int
-		constitution int
-		expected     int
-	}{
-		{
-			name:         "nível 1 com constituição 10 deve ter 10 HP",
-			level:        1,
-			constitution: 10,
-			expected:     10,
-		},
-		{
-			name:         "nível 1 com constituição 14 deve ter 12 HP",
-			level:        1,
-			constitution: 14,
-			expected:     12,
-		},
-		{
-			name:         "nível 2 com constituição 12 deve ter 22 HP",
-			level:        2,
-			constitution: 12,
-			expected:     22,
-		},
-		{
-			name:         "nível inválido deve retornar 0",
-			level:        0,
-			constitution: 10,
-			expected:     0,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := CalculateMaxHealth(tt.level, tt.constitution)
-			assert.Equal(t, tt.expected, result)
-		})
-	}
-}
-
 func TestCalculateAttackBonus(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -125,7 +48,7 @@ func TestCalculateAttackBonus(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := CalculateAttackBonus(tt.class, tt.level, tt.attributes)
+			result := CalculateAttackBonus(gamedata.DefaultProgression(), tt.class, tt.level, tt.attributes)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -188,12 +111,31 @@ func TestValidateCharacterProgression(t *testing.T) {
 					Constitution: 14,
 				},
 				Combat: entities.Combat{
-					MaxHealth: 12,
-					Health:    12,
+					MaxHealth: 10,
+					Health:    10,
 				},
 			},
 			expectError: false,
 		},
+		{
+			name:        "personagem criado e evoluído pela entidade",
+			character:   leveledCharacter("", 450),
+			expectError: false,
+		},
+		{
+			name:        "personagem evoluído com regras épicas",
+			character:   leveledCharacter("epico", 450),
+			expectError: false,
+		},
+		{
+			name: "personagem avaliado com outras regras",
+			character: func() *entities.Character {
+				c := leveledCharacter("", 450)
+				c.Progression = "epico"
+				return c
+			}(),
+			expectError: true,
+		},
 		{
 			name: "nível inválido",
 			character: &entities.Character{
@@ -238,3 +180,12 @@ func TestValidateCharacterProgression(t *testing.T) {
 		})
 	}
 }
+
+// leveledCharacter cria um guerreiro com as regras informadas e lhe concede experiência
+func leveledCharacter(progression string, exp int) *entities.Character {
+	c := entities.NewCharacter("user", "guild", "Sir Test", string(gamedata.Warrior))
+	c.Attributes = gamedata.GetBaseAttributesForClass(gamedata.Warrior)
+	c.ApplyProgression(progression)
+	_ = c.AddExperience(exp)
+	return c
+}
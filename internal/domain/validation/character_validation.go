@@ -27,11 +27,9 @@ const (
 	MinItemValue      = 0
 	MaxItemValue      = 100000
 
-	// Experiência e Gold
-	BaseExpPerLevel = 100 // Experiência base para o primeiro nível
-	ExpMultiplier   = 1.5 // Multiplicador de experiência por nível
-	StartingGold    = 100 // Ouro inicial
-	MaxGold         = 1000000
+	// Gold
+	StartingGold = 100 // Ouro inicial
+	MaxGold      = 1000000
 )
 
 var (
@@ -86,7 +84,7 @@ func ValidateNewCharacter(character *entities.Character) error {
 	}
 
 	// Validar atributos
-	if err := ValidateAttributes(character.Attributes); err != nil {
+	if err := ValidateAttributes(&character.Attributes); err != nil {
 		return err
 	}
 
@@ -127,19 +125,15 @@ func validateName(name string) error {
 }
 
 // validateClass valida a classe do personagem
-func validateClass(class string) error {
-	class = strings.TrimSpace(strings.ToLower(class))
-	validClasses := []string{"guerreiro", "mago", "arqueiro"}
-	for _, validClass := range validClasses {
-		if class == validClass {
-			return nil
-		}
+func validateClass(class gamedata.CharacterClass) error {
+	if _, exists := gamedata.ClassEquipmentRestrictions[class]; exists {
+		return nil
 	}
 	return fmt.Errorf("classe inválida: %s", class)
 }
 
 // ValidateAttributes valida os atributos do personagem
-func ValidateAttributes(attrs *entities.Attributes) error {
+func ValidateAttributes(attrs *gamedata.Attributes) error {
 	if attrs == nil {
 		return fmt.Errorf("atributos não podem ser nulos")
 	}
@@ -209,22 +203,24 @@ func ValidateItem(item *entities.Item) error {
 		return err
 	}
 
+	// Validar categoria de proficiência
+	if err := gamedata.ValidateProficiencyTier(item.Type, item.Proficiency); err != nil {
+		return err
+	}
+
 	// Validar atributos do item
-	if item.Stats != nil {
-		if err := validateItemStats(item.Stats); err != nil {
-			return err
-		}
+	if err := validateItemStats(&item.Stats); err != nil {
+		return err
 	}
 
 	return nil
 }
 
 // validateItemType valida o tipo do item
-func validateItemType(itemType string) error {
-	itemType = strings.TrimSpace(strings.ToLower(itemType))
-	validTypes := []string{
-		"arma", "armadura", "escudo", "anel",
-		"amuleto", "poção", "pergaminho", "outro",
+func validateItemType(itemType gamedata.ItemType) error {
+	validTypes := []gamedata.ItemType{
+		gamedata.Weapon, gamedata.Armor, gamedata.Accessory,
+		gamedata.Consumable, gamedata.Quest,
 	}
 	for _, validType := range validTypes {
 		if itemType == validType {
@@ -235,11 +231,10 @@ func validateItemType(itemType string) error {
 }
 
 // validateRarity valida a raridade do item
-func validateRarity(rarity string) error {
-	rarity = strings.TrimSpace(strings.ToLower(rarity))
-	validRarities := []string{
-		"comum", "incomum", "raro",
-		"muito raro", "lendário", "único",
+func validateRarity(rarity gamedata.ItemRarity) error {
+	validRarities := []gamedata.ItemRarity{
+		gamedata.Common, gamedata.Uncommon, gamedata.Rare,
+		gamedata.Epic, gamedata.Legendary, gamedata.Mythical,
 	}
 	for _, validRarity := range validRarities {
 		if rarity == validRarity {
@@ -250,18 +245,15 @@ func validateRarity(rarity string) error {
 }
 
 // validateItemStats valida os atributos de um item
-func validateItemStats(stats *entities.ItemStats) error {
+func validateItemStats(stats *gamedata.ItemStats) error {
 	if stats == nil {
 		return nil
 	}
 
 	attributes := []int{
-		stats.Strength,
-		stats.Dexterity,
-		stats.Constitution,
-		stats.Intelligence,
-		stats.Wisdom,
-		stats.Charisma,
+		stats.Attack,
+		stats.Defense,
+		stats.MagicPower,
 	}
 
 	for _, value := range attributes {
@@ -286,7 +278,7 @@ func ValidateEquipment(character *entities.Character, item *entities.Item) error
 	}
 
 	// Validar tipo de item equipável
-	equipableTypes := []string{"arma", "armadura", "escudo", "anel", "amuleto"}
+	equipableTypes := []gamedata.ItemType{gamedata.Weapon, gamedata.Armor, gamedata.Accessory}
 	isEquipable := false
 	for _, validType := range equipableTypes {
 		if item.Type == validType {
@@ -307,7 +299,7 @@ func ValidateEquipment(character *entities.Character, item *entities.Item) error
 	if len(item.RequiredClasses) > 0 {
 		classAllowed := false
 		for _, class := range item.RequiredClasses {
-			if strings.EqualFold(class, character.Class) {
+			if class == character.Class {
 				classAllowed = true
 				break
 			}
@@ -317,5 +309,10 @@ func ValidateEquipment(character *entities.Character, item *entities.Item) error
 		}
 	}
 
+	// Validar restrições de tipo e proficiência da classe
+	if err := gamedata.ValidateClassItem(character.Class, item.Type, item.Proficiency); err != nil {
+		return err
+	}
+
 	return nil
 }
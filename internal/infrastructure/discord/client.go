@@ -44,7 +44,7 @@ func NewClient(token string, db *mongo.Database) (*Client, error) {
 	characterRepo := mongodb.NewCharacterRepository(db)
 
 	// Inicializa serviços
-	characterService := services.NewCharacterService(characterRepo)
+	characterService := services.NewCharacterService(characterRepo, configRepo)
 
 	// Inicializa o registro de comandos e gerenciador de eventos
 	registry := commands.NewCommandRegistry(session, "!", configRepo, characterService, db)
@@ -86,6 +86,12 @@ func (c *Client) registerCommands() {
 	characterCommands.Register(c.commandRegistry)
 }
 
+// SetOwnerID define o dono do bot, autorizado a alterar dados compartilhados
+// por todos os servidores. Vazio usa o dono da aplicação no Discord
+func (c *Client) SetOwnerID(ownerID string) {
+	c.commandRegistry.SetOwnerID(ownerID)
+}
+
 // Connect estabelece a conexão com o Discord
 func (c *Client) Connect() error {
 	// Registra os handlers padrão
@@ -127,12 +133,17 @@ func (c *Client) Connect() error {
 		return fmt.Errorf("erro ao abrir conexão com Discord: %w", err)
 	}
 
+	// Inicia as tarefas periódicas, como a renovação das missões
+	c.commandRegistry.StartSchedulers()
+
 	log.Println("Bot conectado ao Discord com sucesso!")
 	return nil
 }
 
 // Disconnect desconecta o bot do Discord
 func (c *Client) Disconnect() error {
+	c.commandRegistry.StopSchedulers()
+
 	if err := c.session.Close(); err != nil {
 		return fmt.Errorf("erro ao desconectar do Discord: %w", err)
 	}
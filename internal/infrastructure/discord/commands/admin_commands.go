@@ -1,10 +1,15 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/gamedata"
+
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -16,6 +21,11 @@ func AdminCommands() []*Command {
 		BanCommand(),
 		UnbanCommand(),
 		ChannelsCommand(),
+		DeckRulesCommand(),
+		CharacterLimitCommand(),
+		ProgressionCommand(),
+		PermadeathCommand(),
+		RollAuditCommand(),
 	}
 }
 
@@ -239,8 +249,8 @@ func ChannelsCommand() *Command {
 	return &Command{
 		Name:        "channels",
 		Aliases:     []string{"canais", "canal"},
-		Description: "Configura os canais especiais do servidor (boas-vindas e despedida)",
-		Usage:       "channels <welcome/goodbye> <#canal>",
+		Description: "Configura os canais especiais do servidor (boas-vindas, despedida e missões)",
+		Usage:       "channels <welcome/goodbye/quests> <#canal>",
 		Category:    "Admin",
 		Handler: func(ctx *CommandContext) error {
 			// Verifica permissões
@@ -261,9 +271,10 @@ func ChannelsCommand() *Command {
 			if len(ctx.Args) == 0 {
 				embed := &discordgo.MessageEmbed{
 					Title: "Configuração de Canais",
-					Description: fmt.Sprintf("Canal de boas-vindas: %s\nCanal de despedida: %s",
+					Description: fmt.Sprintf("Canal de boas-vindas: %s\nCanal de despedida: %s\nCanal de missões: %s",
 						channelMention(config.WelcomeChannel),
-						channelMention(config.GoodbyeChannel)),
+						channelMention(config.GoodbyeChannel),
+						channelMention(config.QuestChannel)),
 					Color: 0x00ff00,
 				}
 				_, err := ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
@@ -271,12 +282,12 @@ func ChannelsCommand() *Command {
 			}
 
 			if len(ctx.Args) != 2 {
-				return sendErrorEmbed(ctx, "Use: !channels <welcome|goodbye> #canal")
+				return sendErrorEmbed(ctx, "Use: !channels <welcome|goodbye|quests> #canal")
 			}
 
 			channelType := strings.ToLower(ctx.Args[0])
-			if channelType != "welcome" && channelType != "goodbye" {
-				return sendErrorEmbed(ctx, "Tipo de canal inválido. Use 'welcome' ou 'goodbye'.")
+			if channelType != "welcome" && channelType != "goodbye" && channelType != "quests" {
+				return sendErrorEmbed(ctx, "Tipo de canal inválido. Use 'welcome', 'goodbye' ou 'quests'.")
 			}
 
 			channelID := extractChannelID(ctx.Args[1])
@@ -298,6 +309,8 @@ func ChannelsCommand() *Command {
 				config.WelcomeChannel = channelID
 			case "goodbye":
 				config.GoodbyeChannel = channelID
+			case "quests":
+				config.QuestChannel = channelID
 			}
 
 			err = ctx.Registry.configRepository.UpdateGuildConfig(ctx.Message.GuildID, config)
@@ -318,6 +331,442 @@ func ChannelsCommand() *Command {
 	}
 }
 
+// DeckRulesCommand cria o comando para configurar as regras de montagem de decks
+func DeckRulesCommand() *Command {
+	return &Command{
+		Name:        "regras-deck",
+		Aliases:     []string{"deckrules"},
+		Description: "Mostra ou altera as regras de montagem de decks do servidor",
+		Usage:       "regras-deck [max-cartas <n> | max-copias <n> | carta <id> <n|banir|liberar> | raridade <raridade> <n|liberar> | classe <classe> <n|liberar> | restringir-classe <sim|nao> | padrao]",
+		Category:    "Admin",
+		Handler: func(ctx *CommandContext) error {
+			config, err := ctx.Registry.configRepository.GetGuildConfig(ctx.Message.GuildID)
+			if err != nil {
+				return sendErrorEmbed(ctx, "Erro ao buscar configuração do servidor.")
+			}
+			rules := config.GetDeckRules()
+
+			if len(ctx.Args) == 0 {
+				_, err := ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, deckRulesEmbed(rules))
+				return err
+			}
+
+			// Verifica permissões
+			perms, err := ctx.Session.UserChannelPermissions(ctx.Message.Author.ID, ctx.Message.ChannelID)
+			if err != nil {
+				return fmt.Errorf("erro ao verificar permissões: %w", err)
+			}
+
+			if perms&discordgo.PermissionAdministrator == 0 {
+				return sendErrorEmbed(ctx, "Você não tem permissão para usar este comando.")
+			}
+
+			if err := applyDeckRule(rules, ctx.Args); err != nil {
+				return sendErrorEmbed(ctx, err.Error())
+			}
+
+			config.DeckRules = rules
+			err = ctx.Registry.configRepository.UpdateGuildConfig(ctx.Message.GuildID, config)
+			if err != nil {
+				return sendErrorEmbed(ctx, "Erro ao atualizar configuração do servidor.")
+			}
+
+			embed := deckRulesEmbed(rules)
+			embed.Title = "✅ Regras de Deck Atualizadas"
+			_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+			return err
+		},
+	}
+}
+
+// CharacterLimitCommand cria o comando para configurar o limite de personagens por usuário
+func CharacterLimitCommand() *Command {
+	return &Command{
+		Name:        "limite-personagens",
+		Aliases:     []string{"charlimit"},
+		Description: "Mostra ou altera quantos personagens cada usuário pode ter no servidor",
+		Usage:       "limite-personagens [n | padrao]",
+		Category:    "Admin",
+		Handler: func(ctx *CommandContext) error {
+			config, err := ctx.Registry.configRepository.GetGuildConfig(ctx.Message.GuildID)
+			if err != nil {
+				return sendErrorEmbed(ctx, "Erro ao buscar configuração do servidor.")
+			}
+
+			if len(ctx.Args) == 0 {
+				return ctx.Reply(fmt.Sprintf("👥 Cada usuário pode ter até **%d** personagem(ns) neste servidor.", config.GetMaxCharacters()))
+			}
+
+			// Verifica permissões
+			perms, err := ctx.Session.UserChannelPermissions(ctx.Message.Author.ID, ctx.Message.ChannelID)
+			if err != nil {
+				return fmt.Errorf("erro ao verificar permissões: %w", err)
+			}
+
+			if perms&discordgo.PermissionAdministrator == 0 {
+				return sendErrorEmbed(ctx, "Você não tem permissão para usar este comando.")
+			}
+
+			if strings.ToLower(ctx.Args[0]) == "padrao" {
+				config.MaxCharacters = 0
+			} else {
+				limit, err := parseInt(ctx.Args[0])
+				if err != nil || limit < 1 {
+					return sendErrorEmbed(ctx, "O limite deve ser um número maior que zero.")
+				}
+				config.MaxCharacters = limit
+			}
+
+			err = ctx.Registry.configRepository.UpdateGuildConfig(ctx.Message.GuildID, config)
+			if err != nil {
+				return sendErrorEmbed(ctx, "Erro ao atualizar configuração do servidor.")
+			}
+
+			return ctx.Reply(fmt.Sprintf("✅ Cada usuário agora pode ter até **%d** personagem(ns) neste servidor.", config.GetMaxCharacters()))
+		},
+	}
+}
+
+// ProgressionCommand cria o comando para escolher as regras de progressão dos personagens
+func ProgressionCommand() *Command {
+	return &Command{
+		Name:        "progressao",
+		Aliases:     []string{"progressão"},
+		Description: "Mostra ou altera as regras de progressão (experiência, vida e proficiência) dos personagens do servidor",
+		Usage:       "progressao [regras | recalcular]",
+		Category:    "Admin",
+		Handler: func(ctx *CommandContext) error {
+			config, err := ctx.Registry.configRepository.GetGuildConfig(ctx.Message.GuildID)
+			if err != nil {
+				return sendErrorEmbed(ctx, "Erro ao buscar configuração do servidor.")
+			}
+
+			if len(ctx.Args) == 0 {
+				current := config.GetProgression()
+				var lines []string
+				for _, ruleset := range gamedata.ProgressionRulesets() {
+					marker := "⚪"
+					if ruleset.ID == current.ID {
+						marker = "🟢"
+					}
+					lines = append(lines, fmt.Sprintf("%s `%s` **%s** — %s", marker, ruleset.ID, ruleset.Name, ruleset.Description))
+				}
+				embed := &discordgo.MessageEmbed{
+					Title:       "📈 Regras de Progressão",
+					Description: strings.Join(lines, "\n"),
+					Color:       0x0099ff,
+					Footer: &discordgo.MessageEmbedFooter{
+						Text: "Use progressao <regras> para trocar ou progressao recalcular para corrigir os personagens",
+					},
+				}
+				_, err := ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+				return err
+			}
+
+			// Verifica permissões
+			perms, err := ctx.Session.UserChannelPermissions(ctx.Message.Author.ID, ctx.Message.ChannelID)
+			if err != nil {
+				return fmt.Errorf("erro ao verificar permissões: %w", err)
+			}
+
+			if perms&discordgo.PermissionAdministrator == 0 {
+				return sendErrorEmbed(ctx, "Você não tem permissão para usar este comando.")
+			}
+
+			option := strings.ToLower(ctx.Args[0])
+			if option != "recalcular" {
+				ruleset, ok := gamedata.GetProgressionRuleset(option)
+				if !ok {
+					return sendErrorEmbed(ctx, fmt.Sprintf("Regras de progressão desconhecidas: %s. Use progressao para ver as opções.", option))
+				}
+
+				config.Progression = ruleset.ID
+				err = ctx.Registry.configRepository.UpdateGuildConfig(ctx.Message.GuildID, config)
+				if err != nil {
+					return sendErrorEmbed(ctx, "Erro ao atualizar configuração do servidor.")
+				}
+			}
+
+			// Recalcula os personagens existentes com as regras do servidor
+			migration, err := ctx.Registry.characterService.MigrateProgression(context.Background(), ctx.Message.GuildID)
+			if err != nil {
+				return sendErrorEmbed(ctx, fmt.Sprintf("Erro ao recalcular personagens: %s", err))
+			}
+
+			message := fmt.Sprintf("✅ Regras de progressão: **%s**. %d personagem(ns) recalculado(s).", migration.Ruleset.Name, migration.Updated)
+			if migration.Skipped > 0 {
+				message += fmt.Sprintf(" %d em combate ficaram para depois; use `progressao recalcular` quando as caçadas terminarem.", migration.Skipped)
+			}
+			return ctx.Reply(message)
+		},
+	}
+}
+
+// PermadeathCommand cria o comando para ligar ou desligar a morte permanente de personagens
+func PermadeathCommand() *Command {
+	return &Command{
+		Name:        "morte-permanente",
+		Aliases:     []string{"permadeath"},
+		Description: "Mostra ou altera se personagens que falham nos testes contra a morte morrem de vez",
+		Usage:       "morte-permanente [ligar | desligar]",
+		Category:    "Admin",
+		Handler: func(ctx *CommandContext) error {
+			config, err := ctx.Registry.configRepository.GetGuildConfig(ctx.Message.GuildID)
+			if err != nil {
+				return sendErrorEmbed(ctx, "Erro ao buscar configuração do servidor.")
+			}
+
+			if len(ctx.Args) == 0 {
+				return ctx.Reply(permadeathText(config.Permadeath))
+			}
+
+			// Verifica permissões
+			perms, err := ctx.Session.UserChannelPermissions(ctx.Message.Author.ID, ctx.Message.ChannelID)
+			if err != nil {
+				return fmt.Errorf("erro ao verificar permissões: %w", err)
+			}
+
+			if perms&discordgo.PermissionAdministrator == 0 {
+				return sendErrorEmbed(ctx, "Você não tem permissão para usar este comando.")
+			}
+
+			switch strings.ToLower(ctx.Args[0]) {
+			case "ligar", "on":
+				config.Permadeath = true
+			case "desligar", "off":
+				config.Permadeath = false
+			default:
+				return sendErrorEmbed(ctx, "Use morte-permanente ligar ou morte-permanente desligar.")
+			}
+
+			err = ctx.Registry.configRepository.UpdateGuildConfig(ctx.Message.GuildID, config)
+			if err != nil {
+				return sendErrorEmbed(ctx, "Erro ao atualizar configuração do servidor.")
+			}
+
+			return ctx.Reply("✅ " + permadeathText(config.Permadeath))
+		},
+	}
+}
+
+// permadeathText descreve a regra de morte permanente do servidor
+func permadeathText(permadeath bool) string {
+	if permadeath {
+		return "💀 Morte permanente **ligada**: personagens com três falhas nos testes contra a morte morrem de vez."
+	}
+	return "🛌 Morte permanente **desligada**: personagens com três falhas nos testes contra a morte sobrevivem exaustos."
+}
+
+// RollAuditCommand cria o comando para ligar ou desligar a auditoria de rolagens
+func RollAuditCommand() *Command {
+	return &Command{
+		Name:        "auditoria-rolagens",
+		Aliases:     []string{"rollaudit"},
+		Description: "Mostra ou altera se as rolagens dos jogadores são registradas para conferência",
+		Usage:       "auditoria-rolagens [ligar | desligar]",
+		Category:    "Admin",
+		Handler: func(ctx *CommandContext) error {
+			config, err := ctx.Registry.configRepository.GetGuildConfig(ctx.Message.GuildID)
+			if err != nil {
+				return sendErrorEmbed(ctx, "Erro ao buscar configuração do servidor.")
+			}
+
+			if len(ctx.Args) == 0 {
+				return ctx.Reply(rollAuditText(config.RollAudit))
+			}
+
+			// Verifica permissões
+			perms, err := ctx.Session.UserChannelPermissions(ctx.Message.Author.ID, ctx.Message.ChannelID)
+			if err != nil {
+				return fmt.Errorf("erro ao verificar permissões: %w", err)
+			}
+
+			if perms&discordgo.PermissionAdministrator == 0 {
+				return sendErrorEmbed(ctx, "Você não tem permissão para usar este comando.")
+			}
+
+			switch strings.ToLower(ctx.Args[0]) {
+			case "ligar", "on":
+				config.RollAudit = true
+			case "desligar", "off":
+				config.RollAudit = false
+			default:
+				return sendErrorEmbed(ctx, "Use auditoria-rolagens ligar ou auditoria-rolagens desligar.")
+			}
+
+			err = ctx.Registry.configRepository.UpdateGuildConfig(ctx.Message.GuildID, config)
+			if err != nil {
+				return sendErrorEmbed(ctx, "Erro ao atualizar configuração do servidor.")
+			}
+
+			return ctx.Reply("✅ " + rollAuditText(config.RollAudit))
+		},
+	}
+}
+
+// rollAuditText descreve a auditoria de rolagens do servidor
+func rollAuditText(enabled bool) string {
+	if enabled {
+		return "📜 Auditoria de rolagens **ligada**: cada rolagem é registrada e pode ser conferida com o comando rolagens."
+	}
+	return "🎲 Auditoria de rolagens **desligada**: as rolagens não são registradas."
+}
+
+// applyDeckRule altera uma regra de deck a partir dos argumentos do comando
+func applyDeckRule(rules *entities.DeckConfig, args []string) error {
+	option := strings.ToLower(args[0])
+
+	switch option {
+	case "padrao", "padrão":
+		*rules = *entities.DefaultDeckConfig()
+		return nil
+	case "max-cartas", "max-copias":
+		if len(args) != 2 {
+			return fmt.Errorf("use: regras-deck %s <n>", option)
+		}
+		value, err := parseInt(args[1])
+		if err != nil || value < 1 {
+			return fmt.Errorf("valor inválido: %s", args[1])
+		}
+		if option == "max-cartas" {
+			rules.MaxCards = value
+		} else {
+			rules.MaxPerCard = value
+		}
+		return nil
+	case "restringir-classe":
+		if len(args) != 2 {
+			return fmt.Errorf("use: regras-deck restringir-classe <sim|nao>")
+		}
+		switch strings.ToLower(args[1]) {
+		case "sim":
+			rules.RestrictClass = true
+		case "nao", "não":
+			rules.RestrictClass = false
+		default:
+			return fmt.Errorf("valor inválido: %s. Use 'sim' ou 'nao'", args[1])
+		}
+		return nil
+	case "carta", "raridade", "classe":
+	default:
+		return fmt.Errorf("opção inválida: %s", args[0])
+	}
+
+	if len(args) != 3 {
+		return fmt.Errorf("use: regras-deck %s <nome> <n|liberar>", option)
+	}
+	key := strings.ToLower(args[1])
+	value := strings.ToLower(args[2])
+
+	switch option {
+	case "raridade":
+		if !isValidRarity(entities.CardRarity(key)) {
+			return fmt.Errorf("raridade inválida: %s", args[1])
+		}
+	case "classe":
+		if _, ok := gamedata.ClassRequirements[gamedata.CharacterClass(key)]; !ok {
+			return fmt.Errorf("classe inválida: %s", args[1])
+		}
+	case "carta":
+		key = args[1]
+	}
+
+	limit := 0
+	remove := value == "liberar"
+	if !remove && value != "banir" {
+		n, err := parseInt(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("valor inválido: %s", args[2])
+		}
+		limit = n
+	}
+
+	switch option {
+	case "carta":
+		if remove {
+			delete(rules.CardLimits, key)
+		} else {
+			rules.CardLimits[key] = limit
+		}
+	case "raridade":
+		if remove {
+			delete(rules.RarityLimits, entities.CardRarity(key))
+		} else {
+			rules.RarityLimits[entities.CardRarity(key)] = limit
+		}
+	case "classe":
+		if remove {
+			rules.RemoveClassLimit(key)
+		} else {
+			rules.SetClassLimit(key, limit)
+		}
+	}
+	return nil
+}
+
+// deckRulesEmbed monta o embed com as regras de deck do servidor
+func deckRulesEmbed(rules *entities.DeckConfig) *discordgo.MessageEmbed {
+	restrict := "Não"
+	if rules.RestrictClass {
+		restrict = "Sim"
+	}
+
+	cardLimits := make(map[string]int, len(rules.CardLimits))
+	for k, v := range rules.CardLimits {
+		cardLimits[k] = v
+	}
+	rarityLimits := make(map[string]int, len(rules.RarityLimits))
+	for k, v := range rules.RarityLimits {
+		rarityLimits[string(k)] = v
+	}
+
+	return &discordgo.MessageEmbed{
+		Title: "🎴 Regras de Deck",
+		Color: 0x0099ff,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Máximo de cartas", Value: fmt.Sprintf("%d", rules.MaxCards), Inline: true},
+			{Name: "Cópias por carta", Value: fmt.Sprintf("%d", rules.MaxPerCard), Inline: true},
+			{Name: "Restringir classe", Value: restrict, Inline: true},
+			{Name: "Limites por carta", Value: formatLimits(cardLimits)},
+			{Name: "Limites por raridade", Value: formatLimits(rarityLimits)},
+			{Name: "Limites por classe", Value: formatLimits(rules.ClassLimits)},
+		},
+	}
+}
+
+// formatLimits lista limites em ordem alfabética, indicando itens banidos
+func formatLimits(limits map[string]int) string {
+	if len(limits) == 0 {
+		return "Nenhum"
+	}
+
+	keys := make([]string, 0, len(limits))
+	for k := range limits {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var lines []string
+	for _, k := range keys {
+		if limits[k] == 0 {
+			lines = append(lines, fmt.Sprintf("%s: banida", k))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s: %d", k, limits[k]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isValidRarity verifica se a raridade existe
+func isValidRarity(rarity entities.CardRarity) bool {
+	switch rarity {
+	case entities.CardRarityCommon, entities.CardRarityUncommon, entities.CardRarityRare,
+		entities.CardRarityEpic, entities.CardRarityLegendary:
+		return true
+	}
+	return false
+}
+
 func sendErrorEmbed(ctx *CommandContext, message string) error {
 	embed := &discordgo.MessageEmbed{
 		Title:       "Erro",
@@ -348,6 +797,8 @@ func channelTypeInPortuguese(channelType string) string {
 		return "boas-vindas"
 	case "goodbye":
 		return "despedida"
+	case "quests":
+		return "missões"
 	default:
 		return channelType
 	}
@@ -0,0 +1,342 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repositories"
+	"sirdraith/internal/domain/services"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// browseTTL é o tempo em que os botões de uma busca continuam respondendo
+const browseTTL = 15 * time.Minute
+
+// cardTypeAliases mapeia os nomes aceitos no filtro para os tipos de carta
+var cardTypeAliases = map[string]entities.CardType{
+	"criatura":     entities.CardTypeCreature,
+	"creature":     entities.CardTypeCreature,
+	"feitiço":      entities.CardTypeSpell,
+	"feitico":      entities.CardTypeSpell,
+	"spell":        entities.CardTypeSpell,
+	"artefato":     entities.CardTypeArtifact,
+	"artifact":     entities.CardTypeArtifact,
+	"encantamento": entities.CardTypeEnchant,
+	"enchant":      entities.CardTypeEnchant,
+}
+
+// cardRarityAliases mapeia os nomes aceitos no filtro para as raridades
+var cardRarityAliases = map[string]entities.CardRarity{
+	"comum":     entities.CardRarityCommon,
+	"common":    entities.CardRarityCommon,
+	"incomum":   entities.CardRarityUncommon,
+	"uncommon":  entities.CardRarityUncommon,
+	"rara":      entities.CardRarityRare,
+	"rare":      entities.CardRarityRare,
+	"épica":     entities.CardRarityEpic,
+	"epica":     entities.CardRarityEpic,
+	"epic":      entities.CardRarityEpic,
+	"lendária":  entities.CardRarityLegendary,
+	"lendaria":  entities.CardRarityLegendary,
+	"legendary": entities.CardRarityLegendary,
+}
+
+// cardBrowse guarda o estado de uma busca exibida em uma mensagem
+type cardBrowse struct {
+	userID    string
+	filter    repositories.CardFilter
+	page      int
+	updatedAt time.Time
+}
+
+// CardCommands encapsula os comandos de consulta ao catálogo de cartas
+type CardCommands struct {
+	cardService *services.CardService
+	browses     map[string]*cardBrowse // Buscas ativas por ID da mensagem
+	mu          sync.Mutex
+}
+
+// NewCardCommands cria uma nova instância de CardCommands
+func NewCardCommands(cardService *services.CardService) *CardCommands {
+	return &CardCommands{
+		cardService: cardService,
+		browses:     make(map[string]*cardBrowse),
+	}
+}
+
+// Register registra os comandos de cartas
+func (cc *CardCommands) Register(registry *CommandRegistry) {
+	registry.RegisterCommand(&Command{
+		Name:        "cartas",
+		Aliases:     []string{"buscar-cartas"},
+		Description: "Busca cartas do catálogo por nome, tipo, raridade, custo ou palavra-chave",
+		Usage:       "cartas [nome] [tipo:<tipo>] [raridade:<raridade>] [custo:<n|n-m|n+|-m>] [palavra:<palavra-chave>]",
+		Category:    "Decks",
+		Handler:     cc.handleSearch,
+	})
+
+	registry.RegisterComponentHandler("cartas", cc.handlePageButton)
+}
+
+// handleSearch processa o comando de busca de cartas
+func (cc *CardCommands) handleSearch(ctx *CommandContext) error {
+	filter, err := parseCardFilter(ctx.Args)
+	if err != nil {
+		return sendErrorEmbed(ctx, err.Error())
+	}
+
+	page, err := cc.cardService.SearchCards(context.Background(), filter, 1)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Erro ao buscar cartas: %s", err))
+	}
+
+	msg, err := ctx.Session.ChannelMessageSendComplex(ctx.Message.ChannelID, &discordgo.MessageSend{
+		Embed:      cardPageEmbed(page),
+		Components: cardPageComponents(page),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Só guarda a busca se houver mais de uma página para navegar
+	if page.TotalPages > 1 {
+		cc.mu.Lock()
+		cc.pruneBrowses()
+		cc.browses[msg.ID] = &cardBrowse{
+			userID:    ctx.Message.Author.ID,
+			filter:    filter,
+			page:      page.Page,
+			updatedAt: time.Now(),
+		}
+		cc.mu.Unlock()
+	}
+	return nil
+}
+
+// handlePageButton processa os botões de página anterior e próxima
+func (cc *CardCommands) handlePageButton(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	cc.mu.Lock()
+	browse, exists := cc.browses[i.Message.ID]
+	if exists && time.Since(browse.updatedAt) > browseTTL {
+		delete(cc.browses, i.Message.ID)
+		exists = false
+	}
+	// Copia o estado da busca com o mutex travado, pois outro clique pode alterá-lo
+	var userID string
+	var target int
+	var filter repositories.CardFilter
+	if exists {
+		userID, target, filter = browse.userID, browse.page, browse.filter
+	}
+	cc.mu.Unlock()
+
+	if !exists {
+		return respondEphemeral(s, i, "Esta busca expirou. Use `cartas` novamente.")
+	}
+	if userID != interactionUserID(i) {
+		return respondEphemeral(s, i, "Apenas quem fez a busca pode mudar de página.")
+	}
+
+	switch i.MessageComponentData().CustomID {
+	case "cartas:prev":
+		target--
+	case "cartas:next":
+		target++
+	}
+
+	page, err := cc.cardService.SearchCards(context.Background(), filter, target)
+	if err != nil {
+		return respondEphemeral(s, i, fmt.Sprintf("Erro ao buscar cartas: %s", err))
+	}
+
+	cc.mu.Lock()
+	browse.page = page.Page
+	browse.updatedAt = time.Now()
+	cc.mu.Unlock()
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{cardPageEmbed(page)},
+			Components: cardPageComponents(page),
+		},
+	})
+}
+
+// pruneBrowses remove as buscas expiradas. Deve ser chamado com o mutex travado
+func (cc *CardCommands) pruneBrowses() {
+	for id, browse := range cc.browses {
+		if time.Since(browse.updatedAt) > browseTTL {
+			delete(cc.browses, id)
+		}
+	}
+}
+
+// parseCardFilter interpreta os argumentos do comando de busca.
+// Argumentos no formato chave:valor viram filtros e o restante compõe o nome
+func parseCardFilter(args []string) (repositories.CardFilter, error) {
+	var filter repositories.CardFilter
+	var name []string
+
+	for _, arg := range args {
+		key, value, found := strings.Cut(arg, ":")
+		if !found {
+			name = append(name, arg)
+			continue
+		}
+		value = strings.ToLower(value)
+
+		switch strings.ToLower(key) {
+		case "tipo", "type":
+			cardType, ok := cardTypeAliases[value]
+			if !ok {
+				return filter, fmt.Errorf("tipo inválido: %s (use criatura, feitiço, artefato ou encantamento)", value)
+			}
+			filter.Type = cardType
+		case "raridade", "rarity":
+			rarity, ok := cardRarityAliases[value]
+			if !ok {
+				return filter, fmt.Errorf("raridade inválida: %s (use comum, incomum, rara, épica ou lendária)", value)
+			}
+			filter.Rarity = rarity
+		case "custo", "cost":
+			minCost, maxCost, err := parseCostRange(value)
+			if err != nil {
+				return filter, err
+			}
+			filter.MinCost, filter.MaxCost = minCost, maxCost
+		case "palavra", "keyword":
+			filter.Keyword = value
+		default:
+			name = append(name, arg)
+		}
+	}
+
+	filter.Name = strings.Join(name, " ")
+	return filter, nil
+}
+
+// parseCostRange interpreta um custo exato (3), intervalo (2-4), mínimo (3+) ou máximo (-2)
+func parseCostRange(value string) (*int, *int, error) {
+	invalid := fmt.Errorf("custo inválido: %s (use 3, 2-4, 3+ ou -2)", value)
+	parse := func(s string) (*int, error) {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return nil, invalid
+		}
+		return &n, nil
+	}
+
+	switch {
+	case strings.HasSuffix(value, "+"):
+		low, err := parse(strings.TrimSuffix(value, "+"))
+		return low, nil, err
+	case strings.HasPrefix(value, "-"):
+		high, err := parse(strings.TrimPrefix(value, "-"))
+		return nil, high, err
+	case strings.Contains(value, "-"):
+		from, to, _ := strings.Cut(value, "-")
+		low, err := parse(from)
+		if err != nil {
+			return nil, nil, err
+		}
+		high, err := parse(to)
+		if err != nil {
+			return nil, nil, err
+		}
+		if *low > *high {
+			return nil, nil, invalid
+		}
+		return low, high, nil
+	default:
+		exact, err := parse(value)
+		return exact, exact, err
+	}
+}
+
+// cardPageEmbed cria o embed com uma página do resultado da busca
+func cardPageEmbed(page *services.CardPage) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: "🔎 Catálogo de Cartas",
+		Color: 0x0099ff,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Página %d de %d • %d cartas encontradas", page.Page, page.TotalPages, page.Total),
+		},
+	}
+
+	if len(page.Cards) == 0 {
+		embed.Description = "Nenhuma carta encontrada com esses filtros."
+		return embed
+	}
+
+	var lines []string
+	for _, card := range page.Cards {
+		line := fmt.Sprintf("%s **%s** `%s`\n└ %s • custo %d", rarityEmojis[card.Rarity], card.Name, card.ID, card.Type, card.Cost)
+		if card.Type == entities.CardTypeCreature {
+			line += fmt.Sprintf(" • ⚔️ %d / 🛡️ %d", card.Attack, card.Defense)
+		}
+		lines = append(lines, line)
+	}
+	embed.Description = truncateLines(lines, 4000)
+	return embed
+}
+
+// cardPageComponents cria os botões de navegação entre as páginas
+func cardPageComponents(page *services.CardPage) []discordgo.MessageComponent {
+	if page.TotalPages <= 1 {
+		return nil
+	}
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Anterior",
+					Style:    discordgo.SecondaryButton,
+					CustomID: "cartas:prev",
+					Disabled: !page.HasPrevious(),
+					Emoji: discordgo.ComponentEmoji{
+						Name: "◀️",
+					},
+				},
+				discordgo.Button{
+					Label:    "Próxima",
+					Style:    discordgo.SecondaryButton,
+					CustomID: "cartas:next",
+					Disabled: !page.HasNext(),
+					Emoji: discordgo.ComponentEmoji{
+						Name: "▶️",
+					},
+				},
+			},
+		},
+	}
+}
+
+// respondEphemeral responde a uma interação com uma mensagem visível apenas para quem a acionou
+func respondEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate, message string) error {
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
+
+// interactionUserID retorna o ID de quem acionou a interação, em servidores ou DMs
+func interactionUserID(i *discordgo.InteractionCreate) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
@@ -0,0 +1,187 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"sirdraith/internal/domain/catalog"
+	"sirdraith/internal/domain/services"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Tamanho máximo aceito para arquivos de cartas enviados pelo Discord
+const maxCatalogFileSize = 2 << 20
+
+// CatalogCommands encapsula os comandos administrativos do catálogo de cartas
+type CatalogCommands struct {
+	catalogService *services.CatalogService
+	httpClient     *http.Client
+}
+
+// NewCatalogCommands cria uma nova instância de CatalogCommands
+func NewCatalogCommands(catalogService *services.CatalogService) *CatalogCommands {
+	return &CatalogCommands{
+		catalogService: catalogService,
+		httpClient:     &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Register registra os comandos de catálogo
+func (cc *CatalogCommands) Register(registry *CommandRegistry) {
+	registry.RegisterCommand(&Command{
+		Name:        "importar-cartas",
+		Description: "Importa cartas de um arquivo JSON ou YAML anexado à mensagem (apenas o dono do bot)",
+		Usage:       "importar-cartas [simular] (anexe o arquivo)",
+		Category:    "Admin",
+		Handler:     cc.handleImport,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "exportar-cartas",
+		Description: "Exporta o catálogo de cartas em JSON ou YAML",
+		Usage:       "exportar-cartas [json|yaml]",
+		Category:    "Admin",
+		Handler:     cc.handleExport,
+	})
+}
+
+// handleImport processa o comando de importar cartas
+// O catálogo é compartilhado por todos os servidores, então só o dono do bot
+// pode alterá-lo. Importações em massa devem usar o cmd/cards
+func (cc *CatalogCommands) handleImport(ctx *CommandContext) error {
+	owner, err := ctx.Registry.IsBotOwner(ctx.Message.Author.ID)
+	if err != nil {
+		return err
+	}
+	if !owner {
+		return sendErrorEmbed(ctx, "O catálogo de cartas é compartilhado por todos os servidores; apenas o dono do bot pode importar cartas.")
+	}
+
+	if len(ctx.Message.Attachments) != 1 {
+		return sendErrorEmbed(ctx, "Anexe um arquivo .json ou .yaml com as cartas.")
+	}
+	attachment := ctx.Message.Attachments[0]
+	if attachment.Size > maxCatalogFileSize {
+		return sendErrorEmbed(ctx, "Arquivo muito grande.")
+	}
+
+	format, err := catalog.FormatFromFilename(attachment.Filename)
+	if err != nil {
+		return sendErrorEmbed(ctx, err.Error())
+	}
+
+	data, err := cc.download(attachment.URL)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Erro ao baixar arquivo: %s", err))
+	}
+
+	dryRun := len(ctx.Args) > 0 && strings.EqualFold(ctx.Args[0], "simular")
+	report, err := cc.catalogService.Import(context.Background(), data, format, dryRun)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Erro ao importar cartas: %s", err))
+	}
+
+	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, importReportEmbed(report))
+	return err
+}
+
+// handleExport processa o comando de exportar cartas
+func (cc *CatalogCommands) handleExport(ctx *CommandContext) error {
+	if ok, err := cc.checkAdmin(ctx); !ok {
+		return err
+	}
+
+	format := catalog.FormatYAML
+	if len(ctx.Args) > 0 {
+		var err error
+		if format, err = catalog.ParseFormat(ctx.Args[0]); err != nil {
+			return sendErrorEmbed(ctx, err.Error())
+		}
+	}
+
+	data, err := cc.catalogService.Export(context.Background(), format)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Erro ao exportar cartas: %s", err))
+	}
+
+	_, err = ctx.Session.ChannelFileSendWithMessage(ctx.Message.ChannelID, "📦 Catálogo de cartas", "cartas."+string(format), bytes.NewReader(data))
+	return err
+}
+
+// checkAdmin verifica se o autor da mensagem é administrador
+func (cc *CatalogCommands) checkAdmin(ctx *CommandContext) (bool, error) {
+	perms, err := ctx.Session.UserChannelPermissions(ctx.Message.Author.ID, ctx.Message.ChannelID)
+	if err != nil {
+		return false, fmt.Errorf("erro ao verificar permissões: %w", err)
+	}
+
+	if perms&discordgo.PermissionAdministrator == 0 {
+		return false, sendErrorEmbed(ctx, "Você não tem permissão para usar este comando.")
+	}
+	return true, nil
+}
+
+// download baixa o conteúdo de um anexo respeitando o tamanho máximo
+func (cc *CatalogCommands) download(url string) ([]byte, error) {
+	resp, err := cc.httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxCatalogFileSize))
+}
+
+// importReportEmbed monta o embed com o relatório de importação
+func importReportEmbed(report *services.ImportReport) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: "📥 Importação de Cartas",
+		Color: 0x00ff00,
+	}
+
+	switch {
+	case report.HasErrors():
+		embed.Description = "Nenhuma carta foi gravada: corrija os erros abaixo."
+		embed.Color = 0xff0000
+	case !report.Applied:
+		embed.Description = "Simulação: nenhuma carta foi gravada."
+		embed.Color = 0xffa500
+	default:
+		embed.Description = "Importação concluída."
+	}
+
+	addList := func(name string, ids []string) {
+		if len(ids) == 0 {
+			return
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("%s (%d)", name, len(ids)),
+			Value: truncateLines(ids, 1000),
+		})
+	}
+	addList("✨ Criadas", report.Created)
+	addList("⚠️ Conflitos (sobrescritas)", report.Conflicts)
+	addList("➖ Sem alterações", report.Unchanged)
+	addList("🔁 Duplicadas no arquivo", report.Duplicates)
+
+	if len(report.Invalid) > 0 {
+		var lines []string
+		for _, issue := range report.Invalid {
+			lines = append(lines, fmt.Sprintf("`%s`: %s", issue.CardID, issue.Reason))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("❌ Inválidas (%d)", len(report.Invalid)),
+			Value: truncateLines(lines, 1000),
+		})
+	}
+	return embed
+}
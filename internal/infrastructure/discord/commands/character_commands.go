@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/gamedata"
 	"sirdraith/internal/domain/services"
 
 	"github.com/bwmarrin/discordgo"
@@ -55,6 +57,26 @@ func (cc *CharacterCommands) Register(registry *CommandRegistry) {
 		Handler:     cc.handleList,
 	})
 
+	// Comando para listar os personagens do usuário
+	registry.RegisterCommand(&Command{
+		Name:        "meus-personagens",
+		Aliases:     []string{"meus"},
+		Description: "Lista os seus personagens neste servidor",
+		Usage:       "meus-personagens",
+		Category:    "Personagem",
+		Handler:     cc.handleMyCharacters,
+	})
+
+	// Comando para trocar o personagem ativo
+	registry.RegisterCommand(&Command{
+		Name:        "usar",
+		Aliases:     []string{"selecionar"},
+		Description: "Troca o personagem ativo usado pelos comandos",
+		Usage:       "usar <nome>",
+		Category:    "Personagem",
+		Handler:     cc.handleSelect,
+	})
+
 	// Comando para gerenciar inventário
 	registry.RegisterCommand(&Command{
 		Name:        "inventario",
@@ -64,23 +86,32 @@ func (cc *CharacterCommands) Register(registry *CommandRegistry) {
 		Category:    "Personagem",
 		Handler:     cc.handleInventory,
 	})
+
+	// Comando para usar um item consumível
+	registry.RegisterCommand(&Command{
+		Name:        "usar-item",
+		Aliases:     []string{"consumir"},
+		Description: "Usa um item consumível do inventário, como poções e antídotos",
+		Usage:       "usar-item <nome>",
+		Category:    "Personagem",
+		Handler:     cc.handleUseItem,
+	})
 }
 
 // handleCreate lida com o comando de criar personagem
 func (cc *CharacterCommands) handleCreate(ctx *CommandContext) error {
-	// Verifica se já tem um personagem
-	character, err := cc.characterService.GetCharacterByUserAndGuild(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID)
-	if err == nil && character != nil {
-		return ctx.Reply("Você já possui um personagem neste servidor!")
-	}
-
 	// Verifica se forneceu um nome
 	if len(ctx.Args) == 0 {
 		return ctx.Reply("Por favor, forneça um nome para seu personagem!")
 	}
 
-	// Inicia o wizard de criação de personagem
+	// Verifica o limite de personagens e se o nome já está em uso
 	name := strings.Join(ctx.Args, " ")
+	if err := cc.characterService.CanCreateCharacter(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID, name); err != nil {
+		return ctx.Reply(fmt.Sprintf("Não é possível criar o personagem: %s", err))
+	}
+
+	// Inicia o wizard de criação de personagem
 	wizard := NewCharacterWizard(ctx.Session, cc.characterService)
 	wizard.character = &entities.Character{
 		UserID:  ctx.Message.Author.ID,
@@ -287,6 +318,9 @@ func (cc *CharacterCommands) handleInfo(ctx *CommandContext) error {
 		return ctx.Reply("Você não possui um personagem neste servidor!")
 	}
 
+	// Armadura, ataque e poder mágico já incluem os bônus dos equipamentos
+	stats := character.DerivedStats()
+
 	// Cria embed com informações detalhadas
 	embed := &discordgo.MessageEmbed{
 		Title:       fmt.Sprintf("📜 %s", character.Name),
@@ -300,7 +334,7 @@ func (cc *CharacterCommands) handleInfo(ctx *CommandContext) error {
 			},
 			{
 				Name:   "Experiência",
-				Value:  fmt.Sprintf("%d", character.Experience),
+				Value:  experienceText(character),
 				Inline: true,
 			},
 			{
@@ -334,17 +368,46 @@ func (cc *CharacterCommands) handleInfo(ctx *CommandContext) error {
 			{
 				Name: "Combate",
 				Value: fmt.Sprintf(
-					"Vida: %d/%d\nArmadura: %d\nIniciativa: %d",
+					"Vida: %d/%d (%s)\nDados de vida: %d/%d\nArmadura: %d\nIniciativa: %d\nAtaque: %+d\nPoder Mágico: %d",
 					character.Combat.Health,
 					character.Combat.MaxHealth,
-					character.Combat.Armor,
+					character.HealthState().Name(),
+					character.HitDiceAvailable(),
+					character.Level,
+					stats.Armor,
 					character.Combat.Initiative,
+					stats.Attack,
+					stats.SpellPower,
 				),
 				Inline: false,
 			},
 		},
 	}
 
+	if buffs := character.ActiveBuffs(time.Now()); len(buffs) > 0 {
+		lines := make([]string, 0, len(buffs))
+		for _, buff := range buffs {
+			lines = append(lines, fmt.Sprintf("%s %s %+d (%s) até <t:%d:t>", attributeLabels[buff.Attribute].emoji, attributeLabels[buff.Attribute].name, buff.Value, buff.Source, buff.ExpiresAt.Unix()))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "🧪 Efeitos ativos",
+			Value: strings.Join(lines, "\n"),
+		})
+	}
+
+	if status := character.StatusNames(time.Now()); len(status) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "🌀 Condições",
+			Value: strings.Join(status, "\n"),
+		})
+	}
+
+	if character.HasPendingChoices() {
+		embed.Footer = &discordgo.MessageEmbedFooter{
+			Text: "⬆️ Há escolhas de evolução pendentes. Use evoluir para fazê-las.",
+		}
+	}
+
 	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
 	return err
 }
@@ -385,6 +448,75 @@ func (cc *CharacterCommands) handleList(ctx *CommandContext) error {
 	return err
 }
 
+// handleMyCharacters lida com o comando de listar os personagens do usuário
+func (cc *CharacterCommands) handleMyCharacters(ctx *CommandContext) error {
+	characters, err := cc.characterService.ListUserCharacters(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID)
+	if err != nil {
+		return fmt.Errorf("erro ao listar personagens: %w", err)
+	}
+	if len(characters) == 0 {
+		return ctx.Reply("Você não possui personagens neste servidor! Use criar <nome> para criar um.")
+	}
+
+	limit, err := cc.characterService.CharacterLimit(ctx.Message.GuildID)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar limite de personagens: %w", err)
+	}
+
+	// Sem nenhum selecionado, o mais antigo é o ativo
+	active := characters[0]
+	for _, char := range characters {
+		if char.IsSelected {
+			active = char
+			break
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("📚 Seus Personagens (%d/%d)", len(characters), limit),
+		Color: 0x0099ff,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Use usar <nome> para trocar o personagem ativo",
+		},
+	}
+
+	for _, char := range characters {
+		name := char.Name
+		if char == active {
+			name = "⭐ " + name + " (ativo)"
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: name,
+			Value: fmt.Sprintf(
+				"Nível %d %s\nVida: %d/%d",
+				char.Level,
+				char.Class,
+				char.Combat.Health,
+				char.Combat.MaxHealth,
+			),
+			Inline: true,
+		})
+	}
+
+	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
+
+// handleSelect lida com o comando de trocar o personagem ativo
+func (cc *CharacterCommands) handleSelect(ctx *CommandContext) error {
+	if len(ctx.Args) == 0 {
+		return ctx.Reply("Por favor, informe o nome do personagem! Use: usar <nome>")
+	}
+
+	name := strings.Join(ctx.Args, " ")
+	character, err := cc.characterService.SelectCharacter(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID, name)
+	if err != nil {
+		return ctx.Reply(fmt.Sprintf("Não foi possível trocar de personagem: %s", err))
+	}
+
+	return ctx.Reply(fmt.Sprintf("⭐ **%s** (nível %d %s) agora é seu personagem ativo!", character.Name, character.Level, character.Class))
+}
+
 // handleInventory lida com o comando de gerenciar inventário
 func (cc *CharacterCommands) handleInventory(ctx *CommandContext) error {
 	// Busca o personagem
@@ -405,7 +537,7 @@ func (cc *CharacterCommands) handleInventory(ctx *CommandContext) error {
 		if len(character.Equipment) > 0 {
 			items := make([]string, 0)
 			for _, item := range character.Equipment {
-				items = append(items, fmt.Sprintf("**%s** (%s)", item.Name, item.Slot))
+				items = append(items, fmt.Sprintf("**%s** (%s)%s", item.Name, item.Slot, itemStatsText(item.Stats)))
 			}
 			equipmentList = strings.Join(items, "\n")
 		}
@@ -457,7 +589,90 @@ func (cc *CharacterCommands) handleInventory(ctx *CommandContext) error {
 		}
 		return ctx.Reply(fmt.Sprintf("✅ **%s** desequipado com sucesso!", itemName))
 
+	case "usar", "use":
+		if len(ctx.Args) < 2 {
+			return ctx.Reply("Por favor, especifique qual item deseja usar!")
+		}
+		return cc.useItem(ctx, character, strings.Join(ctx.Args[1:], " "))
+
 	default:
-		return ctx.Reply("Subcomando inválido! Use: equipar, desequipar")
+		return ctx.Reply("Subcomando inválido! Use: equipar, desequipar, usar")
+	}
+}
+
+// handleUseItem lida com o comando de usar um item consumível
+func (cc *CharacterCommands) handleUseItem(ctx *CommandContext) error {
+	if len(ctx.Args) == 0 {
+		return ctx.Reply("Por favor, informe o item! Use: usar-item <nome>")
+	}
+
+	character, err := cc.characterService.GetCharacterByUserAndGuild(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID)
+	if err != nil {
+		return ctx.Reply("Você não possui um personagem neste servidor!")
+	}
+
+	return cc.useItem(ctx, character, strings.Join(ctx.Args, " "))
+}
+
+// useItem consome o item e mostra os efeitos aplicados
+func (cc *CharacterCommands) useItem(ctx *CommandContext, character *entities.Character, itemName string) error {
+	result, err := cc.characterService.UseItem(context.Background(), character, itemName)
+	if err != nil {
+		return ctx.Reply(fmt.Sprintf("Não foi possível usar o item: %s", err))
+	}
+
+	var lines []string
+	if result.Healed > 0 {
+		lines = append(lines, fmt.Sprintf("❤️ Recuperou %d de vida (%d/%d)", result.Healed, character.Combat.Health, character.Combat.MaxHealth))
+	}
+	for _, status := range result.Cured {
+		lines = append(lines, fmt.Sprintf("✨ Curado de **%s**", status))
+	}
+	for _, status := range result.NotCured {
+		lines = append(lines, fmt.Sprintf("➖ Não estava %s", status))
+	}
+	for _, buff := range result.Buffs {
+		lines = append(lines, fmt.Sprintf("%s %s %+d até <t:%d:t>", attributeLabels[buff.Attribute].emoji, attributeLabels[buff.Attribute].name, buff.Value, buff.ExpiresAt.Unix()))
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "Nada aconteceu.")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🧪 %s usou %s", character.Name, result.Item.Name),
+		Description: strings.Join(lines, "\n"),
+		Color:       0x00cc66,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Restam %d", result.Remaining),
+		},
+	}
+	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
+
+// itemStatsText descreve os bônus de um item, vazio se ele não tiver nenhum
+func itemStatsText(stats gamedata.ItemStats) string {
+	var parts []string
+	if stats.Attack != 0 {
+		parts = append(parts, fmt.Sprintf("ATQ %+d", stats.Attack))
+	}
+	if stats.Defense != 0 {
+		parts = append(parts, fmt.Sprintf("DEF %+d", stats.Defense))
+	}
+	if stats.MagicPower != 0 {
+		parts = append(parts, fmt.Sprintf("MAG %+d", stats.MagicPower))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " • " + strings.Join(parts, " ")
+}
+
+// experienceText mostra a experiência do personagem e quanto falta para o próximo nível
+func experienceText(character *entities.Character) string {
+	next := character.Ruleset().ExpForLevel(character.Level + 1)
+	if next == -1 {
+		return fmt.Sprintf("%d (nível máximo)", character.Experience)
 	}
+	return fmt.Sprintf("%d / %d", character.Experience, next)
 }
@@ -358,7 +358,7 @@ func (w *CharacterWizard) showSkillSelection(i *discordgo.InteractionCreate) err
 	// Criar embed com informações
 	embed := &discordgo.MessageEmbed{
 		Title:       "🎯 Seleção de Perícias",
-		Description: "Escolha as perícias em que seu personagem será proficiente:",
+		Description: fmt.Sprintf("Escolha %d perícias em que seu personagem será proficiente. As demais podem ser aprendidas ao subir de nível:", w.character.StartingSkillPicks()),
 		Fields:      make([]*discordgo.MessageEmbedField, 0),
 	}
 
@@ -482,6 +482,9 @@ func (w *CharacterWizard) handleSkillSelection(i *discordgo.InteractionCreate) e
 
 	// Se for confirmação, prosseguir para criação do personagem
 	if data == "skills_confirm" {
+		if picks := w.character.StartingSkillPicks(); w.character.ProficientSkillCount() != picks {
+			return w.respondError(i, fmt.Sprintf("Escolha exatamente %d perícias antes de confirmar", picks))
+		}
 		return w.handleCharacterConfirmation(i)
 	}
 
@@ -494,21 +497,27 @@ func (w *CharacterWizard) handleSkillSelection(i *discordgo.InteractionCreate) e
 		return w.respondError(i, "Perícia inválida para sua classe")
 	}
 
-	// Alternar proficiência na perícia
-	found := false
+	// Alternar proficiência na perícia. As demais perícias da classe ficam para
+	// as escolhas de evolução de nível
+	index := -1
 	for i, prof := range w.character.Skills {
 		if prof.Skill == skill {
-			w.character.Skills[i].IsProficient = !w.character.Skills[i].IsProficient
-			found = true
+			index = i
 			break
 		}
 	}
+	selecting := index == -1 || !w.character.Skills[index].IsProficient
+	if picks := w.character.StartingSkillPicks(); selecting && w.character.ProficientSkillCount() >= picks {
+		return w.respondError(i, fmt.Sprintf("Você já escolheu %d perícias; desmarque uma para trocar", picks))
+	}
 
-	if !found {
+	if index == -1 {
 		w.character.Skills = append(w.character.Skills, gamedata.SkillProficiency{
 			Skill:        skill,
 			IsProficient: true,
 		})
+	} else {
+		w.character.Skills[index].IsProficient = selecting
 	}
 
 	// Atualizar interface
@@ -572,6 +581,7 @@ func (w *CharacterWizard) handleCharacterConfirmation(i *discordgo.InteractionCr
 	character.Background = w.character.Background
 	character.Attributes = w.character.Attributes
 	character.Skills = w.character.Skills
+	character.ApplyProgression(character.Progression)
 
 	// Atualizar o personagem no banco
 	if err := w.characterService.UpdateCharacter(context.Background(), character); err != nil {
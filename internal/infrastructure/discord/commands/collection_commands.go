@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/services"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// rarityEmojis identifica visualmente a raridade das cartas
+var rarityEmojis = map[entities.CardRarity]string{
+	entities.CardRarityCommon:    "⚪",
+	entities.CardRarityUncommon:  "🟢",
+	entities.CardRarityRare:      "🔵",
+	entities.CardRarityEpic:      "🟣",
+	entities.CardRarityLegendary: "🟠",
+}
+
+// CollectionCommands encapsula os comandos de coleção de cartas
+type CollectionCommands struct {
+	collectionService *services.CollectionService
+}
+
+// NewCollectionCommands cria uma nova instância de CollectionCommands
+func NewCollectionCommands(collectionService *services.CollectionService) *CollectionCommands {
+	return &CollectionCommands{
+		collectionService: collectionService,
+	}
+}
+
+// Register registra os comandos de coleção
+func (cc *CollectionCommands) Register(registry *CommandRegistry) {
+	registry.RegisterCommand(&Command{
+		Name:        "colecao",
+		Aliases:     []string{"coleção"},
+		Description: "Mostra as cartas da sua coleção",
+		Usage:       "colecao",
+		Category:    "Decks",
+		Handler:     cc.handleCollection,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "booster",
+		Aliases:     []string{"pacote"},
+		Description: "Compra e abre um pacote de cartas com o ouro do seu personagem",
+		Usage:       "booster [tipo]",
+		Category:    "Decks",
+		Handler:     cc.handleBooster,
+	})
+}
+
+// handleCollection processa o comando de ver a coleção
+func (cc *CollectionCommands) handleCollection(ctx *CommandContext) error {
+	owned, err := cc.collectionService.ListCollection(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Erro ao buscar coleção: %s", err))
+	}
+
+	if len(owned) == 0 {
+		return ctx.Reply("Sua coleção está vazia! Use `booster` para comprar um pacote de cartas.")
+	}
+
+	total := 0
+	var lines []string
+	for _, o := range owned {
+		total += o.Quantity
+		lines = append(lines, fmt.Sprintf("%s **%s** x%d `%s`", rarityEmojis[o.Card.Rarity], o.Card.Name, o.Quantity, o.Card.ID))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "📚 Sua Coleção",
+		Description: truncateLines(lines, 4000),
+		Color:       0x0099ff,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("%d cartas • %d distintas", total, len(owned)),
+		},
+	}
+
+	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
+
+// handleBooster processa o comando de comprar um pacote
+func (cc *CollectionCommands) handleBooster(ctx *CommandContext) error {
+	if len(ctx.Args) < 1 {
+		return cc.sendBoosterList(ctx)
+	}
+
+	character, cards, err := cc.collectionService.OpenBooster(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID, strings.ToLower(ctx.Args[0]))
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Não foi possível abrir o pacote: %s", err))
+	}
+
+	var lines []string
+	for _, card := range cards {
+		lines = append(lines, fmt.Sprintf("%s **%s** (%s) `%s`", rarityEmojis[card.Rarity], card.Name, card.Rarity, card.ID))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "🎁 Pacote Aberto!",
+		Description: strings.Join(lines, "\n"),
+		Color:       0xffd700,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Ouro restante: %d", character.Gold),
+		},
+	}
+
+	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
+
+// sendBoosterList mostra os pacotes disponíveis
+func (cc *CollectionCommands) sendBoosterList(ctx *CommandContext) error {
+	ids := make([]string, 0, len(entities.BoosterPacks))
+	for id := range entities.BoosterPacks {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return entities.BoosterPacks[ids[i]].Price < entities.BoosterPacks[ids[j]].Price
+	})
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "🎁 Pacotes de Cartas",
+		Description: "Use `booster <tipo>` para comprar um pacote.",
+		Color:       0xffd700,
+	}
+	for _, id := range ids {
+		pack := entities.BoosterPacks[id]
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("%s (`%s`)", pack.Name, pack.ID),
+			Value:  fmt.Sprintf("💰 %d de ouro\n🃏 %d cartas", pack.Price, pack.Size),
+			Inline: true,
+		})
+	}
+
+	_, err := ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
+
+// truncateLines junta as linhas sem ultrapassar o limite de caracteres do embed
+func truncateLines(lines []string, limit int) string {
+	var sb strings.Builder
+	for i, line := range lines {
+		if sb.Len()+len(line)+1 > limit {
+			sb.WriteString(fmt.Sprintf("… e mais %d", len(lines)-i))
+			break
+		}
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
@@ -3,9 +3,13 @@ package commands
 import (
 	"fmt"
 	"log"
+	"sirdraith/internal/domain/dice"
 	"sirdraith/internal/domain/repository"
 	"sirdraith/internal/domain/services"
+	"sirdraith/internal/infrastructure/mongodb"
 	"sirdraith/internal/infrastructure/mongodb/repositories"
+	"strings"
+	"sync"
 
 	"github.com/bwmarrin/discordgo"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -24,6 +28,9 @@ type Command struct {
 // CommandHandlerFunc é a função que executa a lógica do comando
 type CommandHandlerFunc func(ctx *CommandContext) error
 
+// ComponentHandlerFunc é a função que processa a interação com um componente de mensagem
+type ComponentHandlerFunc func(s *discordgo.Session, i *discordgo.InteractionCreate) error
+
 // CommandContext contém o contexto de execução do comando
 type CommandContext struct {
 	Session  *discordgo.Session
@@ -45,9 +52,13 @@ type CommandRegistry struct {
 	defaultPrefix    string
 	configRepository repository.ConfigRepository
 	characterService *services.CharacterService
-	wizards          map[string]*CharacterWizard // Mapa de wizards ativos por userID
+	wizards          map[string]*CharacterWizard     // Mapa de wizards ativos por userID
+	components       map[string]ComponentHandlerFunc // Handlers de componentes por prefixo do CustomID
 	session          *discordgo.Session
 	db               *mongo.Database // Add MongoDB database field
+	questScheduler   *QuestScheduler // Renovação periódica das missões
+	ownerID          string          // Dono do bot, único autorizado a alterar dados globais como o catálogo de cartas
+	ownerMu          sync.Mutex      // Protege o dono do bot, buscado no Discord se não for configurado
 }
 
 // NewCommandRegistry cria um novo registro de comandos
@@ -58,6 +69,7 @@ func NewCommandRegistry(session *discordgo.Session, defaultPrefix string, config
 		configRepository: configRepo,
 		characterService: characterService,
 		wizards:          make(map[string]*CharacterWizard),
+		components:       make(map[string]ComponentHandlerFunc),
 		session:          session,
 		db:               db,
 	}
@@ -68,6 +80,31 @@ func NewCommandRegistry(session *discordgo.Session, defaultPrefix string, config
 	return registry
 }
 
+// SetOwnerID define o dono do bot. Sem dono configurado, é usado o dono da aplicação no Discord
+func (r *CommandRegistry) SetOwnerID(ownerID string) {
+	r.ownerMu.Lock()
+	defer r.ownerMu.Unlock()
+	r.ownerID = ownerID
+}
+
+// IsBotOwner verifica se o usuário é o dono do bot
+func (r *CommandRegistry) IsBotOwner(userID string) (bool, error) {
+	r.ownerMu.Lock()
+	defer r.ownerMu.Unlock()
+
+	if r.ownerID == "" {
+		app, err := r.session.Application("@me")
+		if err != nil {
+			return false, fmt.Errorf("erro ao buscar o dono do bot: %w", err)
+		}
+		if app.Owner == nil {
+			return false, fmt.Errorf("a aplicação do bot não tem dono; configure BOT_OWNER_ID")
+		}
+		r.ownerID = app.Owner.ID
+	}
+	return userID == r.ownerID, nil
+}
+
 // RegisterCommand registra um novo comando
 func (r *CommandRegistry) RegisterCommand(cmd *Command) {
 	r.commands[cmd.Name] = cmd
@@ -78,6 +115,12 @@ func (r *CommandRegistry) RegisterCommand(cmd *Command) {
 	}
 }
 
+// RegisterComponentHandler registra o handler dos componentes cujo CustomID
+// começa com o prefixo seguido de ":" (ex: "cartas:next")
+func (r *CommandRegistry) RegisterComponentHandler(prefix string, handler ComponentHandlerFunc) {
+	r.components[prefix] = handler
+}
+
 // GetCommand retorna um comando pelo nome ou alias
 func (r *CommandRegistry) GetCommand(name string) *Command {
 	return r.commands[name]
@@ -200,7 +243,15 @@ func (r *CommandRegistry) HandleInteraction(i *discordgo.InteractionCreate) erro
 	}
 
 	// Registra a interação para debug
-	log.Printf("Componente acionado: %s por %s", i.MessageComponentData().CustomID, i.Member.User.Username)
+	customID := i.MessageComponentData().CustomID
+	log.Printf("Componente acionado: %s por %s", customID, i.Member.User.Username)
+
+	// Componentes com handler registrado não pertencem ao wizard
+	if prefix, _, found := strings.Cut(customID, ":"); found {
+		if handler, ok := r.components[prefix]; ok {
+			return handler(r.session, i)
+		}
+	}
 
 	// Busca o wizard ativo para o usuário
 	wizard, exists := r.wizards[i.Member.User.ID]
@@ -214,21 +265,91 @@ func (r *CommandRegistry) HandleInteraction(i *discordgo.InteractionCreate) erro
 
 // RegisterCommands registra todos os comandos disponíveis
 func (r *CommandRegistry) RegisterCommands() {
+	// Todas as rolagens do jogo usam a mesma fonte aleatória
+	rollService := services.NewRollService(dice.NewRandomSource(), repositories.NewMongoRollRepository(r.db), r.configRepository)
+	r.characterService.SetRandomSource(rollService.Source())
+	r.characterService.SetRollRecorder(rollService)
+
 	// Registrar comandos de personagem
 	characterCommands := NewCharacterCommands(r.characterService)
 	characterCommands.Register(r)
 
 	// Registrar comandos de perícia
-	skillCommands := NewSkillCommands(r.characterService)
+	skillCommands := NewSkillCommands(r.characterService, rollService)
 	skillCommands.Register(r)
 
+	// Registrar escolhas de evolução de nível
+	levelUpCommands := NewLevelUpCommands(r.characterService)
+	levelUpCommands.Register(r)
+
+	// Registrar descansos e testes contra a morte
+	restCommands := NewRestCommands(r.characterService)
+	restCommands.Register(r)
+
 	// Registrar comandos de deck
-	deckService := services.NewDeckService(
-		repositories.NewMongoDeckRepository(r.db),
-		repositories.NewMongoCardRepository(r.db),
-	)
+	deckRepo := repositories.NewMongoDeckRepository(r.db)
+	cardRepo := repositories.NewMongoCardRepository(r.db)
+	characterRepo := mongodb.NewCharacterRepository(r.db)
+	collectionRepo := repositories.NewMongoCollectionRepository(r.db)
+	deckService := services.NewDeckService(deckRepo, cardRepo, characterRepo, collectionRepo, r.configRepository)
 	deckCommands := NewDeckCommands(deckService)
 	deckCommands.Register(r)
+
+	// Registrar comandos de busca de cartas
+	cardCommands := NewCardCommands(services.NewCardService(cardRepo))
+	cardCommands.Register(r)
+
+	// Registrar comandos de catálogo
+	catalogCommands := NewCatalogCommands(services.NewCatalogService(cardRepo))
+	catalogCommands.Register(r)
+
+	// Registrar comandos de coleção
+	collectionService := services.NewCollectionService(collectionRepo, cardRepo, characterRepo)
+	collectionService.SetRandomSource(rollService.Source())
+	collectionService.SetRollRecorder(rollService)
+	collectionCommands := NewCollectionCommands(collectionService)
+	collectionCommands.Register(r)
+
+	// Registrar comandos de caçada
+	huntService := services.NewHuntService(characterRepo, repositories.NewMongoMonsterRepository(r.db))
+	huntService.SetRandomSource(rollService.Source())
+	huntService.SetRollRecorder(rollService)
+	huntCommands := NewHuntCommands(huntService)
+	huntCommands.Register(r)
+
+	// Registrar comandos de missões
+	questService := services.NewQuestService(
+		repositories.NewMongoQuestRepository(r.db),
+		repositories.NewMongoQuestProgressRepository(r.db),
+		repositories.NewMongoQuestScheduleRepository(r.db),
+		characterRepo,
+	)
+	questService.SetRandomSource(rollService.Source())
+	questService.SetRollRecorder(rollService)
+	huntService.SetDefeatListener(questService)
+	questCommands := NewQuestCommands(questService)
+	questCommands.Register(r)
+	r.questScheduler = NewQuestScheduler(r.session, questService, r.configRepository)
+
+	// Registrar comandos de duelo
+	duelService := services.NewDuelService(repositories.NewMongoDuelRepository(r.db), deckRepo, cardRepo, characterRepo)
+	duelService.SetRandomSource(rollService.Source())
+	duelCommands := NewDuelCommands(duelService)
+	duelCommands.Register(r)
+}
+
+// StartSchedulers inicia as tarefas periódicas dos comandos
+func (r *CommandRegistry) StartSchedulers() {
+	if r.questScheduler != nil {
+		r.questScheduler.Start()
+	}
+}
+
+// StopSchedulers encerra as tarefas periódicas dos comandos
+func (r *CommandRegistry) StopSchedulers() {
+	if r.questScheduler != nil {
+		r.questScheduler.Stop()
+	}
 }
 
 // GetWizard retorna o wizard ativo para um usuário
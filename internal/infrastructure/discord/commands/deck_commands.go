@@ -2,9 +2,12 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
+	"sirdraith/internal/domain/entities"
 	"sirdraith/internal/domain/services"
 
 	"github.com/bwmarrin/discordgo"
@@ -63,6 +66,22 @@ func (dc *DeckCommands) Register(registry *CommandRegistry) {
 		Category:    "Decks",
 		Handler:     dc.handleView,
 	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "exportar-deck",
+		Description: "Gera um código para compartilhar um deck",
+		Usage:       "exportar-deck <id>",
+		Category:    "Decks",
+		Handler:     dc.handleExport,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "importar-deck",
+		Description: "Cria um deck a partir de um código compartilhado",
+		Usage:       "importar-deck <código> [nome]",
+		Category:    "Decks",
+		Handler:     dc.handleImport,
+	})
 }
 
 // handleCreate processa o comando de criar deck
@@ -120,16 +139,22 @@ func (dc *DeckCommands) handleEdit(ctx *CommandContext) error {
 	action := strings.ToLower(ctx.Args[1])
 	cardID := ctx.Args[2]
 
+	userID := ctx.Message.Author.ID
+	guildID := ctx.Message.GuildID
 	var err error
 	switch action {
 	case "adicionar":
-		err = dc.deckService.AddCardToDeck(context.Background(), deckID, cardID)
+		err = dc.deckService.AddCardToDeck(context.Background(), userID, guildID, deckID, cardID)
 	case "remover":
-		err = dc.deckService.RemoveCardFromDeck(context.Background(), deckID, cardID)
+		err = dc.deckService.RemoveCardFromDeck(context.Background(), userID, guildID, deckID, cardID)
 	default:
 		return ctx.Reply("Ação inválida! Use 'adicionar' ou 'remover'.")
 	}
 
+	var reqErr *entities.RequirementsError
+	if errors.As(err, &reqErr) {
+		return sendRequirementsEmbed(ctx, reqErr)
+	}
 	if err != nil {
 		return ctx.Reply(fmt.Sprintf("Erro ao editar deck: %s", err))
 	}
@@ -144,7 +169,7 @@ func (dc *DeckCommands) handleDelete(ctx *CommandContext) error {
 	}
 
 	deckID := ctx.Args[0]
-	if err := dc.deckService.DeleteDeck(context.Background(), deckID); err != nil {
+	if err := dc.deckService.DeleteDeck(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID, deckID); err != nil {
 		return ctx.Reply(fmt.Sprintf("Erro ao deletar deck: %s", err))
 	}
 
@@ -153,17 +178,17 @@ func (dc *DeckCommands) handleDelete(ctx *CommandContext) error {
 
 // handleList processa o comando de listar decks
 func (dc *DeckCommands) handleList(ctx *CommandContext) error {
-	decks, err := dc.deckService.ListDecksByUser(context.Background(), ctx.Message.Author.ID)
+	character, decks, err := dc.deckService.ListDecks(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID)
 	if err != nil {
 		return ctx.Reply(fmt.Sprintf("Erro ao listar decks: %s", err))
 	}
 
 	if len(decks) == 0 {
-		return ctx.Reply("Você não possui nenhum deck!")
+		return ctx.Reply(fmt.Sprintf("%s não possui nenhum deck!", character.Name))
 	}
 
 	embed := &discordgo.MessageEmbed{
-		Title: "📚 Seus Decks",
+		Title: fmt.Sprintf("📚 Decks de %s", character.Name),
 		Color: 0x0099ff,
 	}
 
@@ -174,7 +199,7 @@ func (dc *DeckCommands) handleList(ctx *CommandContext) error {
 				"ID: %s\nClasse: %s\nCartas: %d",
 				deck.ID.Hex(),
 				deck.Class,
-				len(deck.Cards),
+				deck.GetCardCount(),
 			),
 			Inline: true,
 		})
@@ -190,13 +215,15 @@ func (dc *DeckCommands) handleView(ctx *CommandContext) error {
 		return ctx.Reply("Por favor, forneça o ID do deck!")
 	}
 
-	deckID := ctx.Args[0]
-	deck, err := dc.deckService.GetDeck(context.Background(), deckID)
+	analysis, err := dc.deckService.AnalyzeDeck(context.Background(), ctx.Args[0])
 	if err != nil {
 		return ctx.Reply(fmt.Sprintf("Erro ao buscar deck: %s", err))
 	}
-	if deck == nil {
-		return ctx.Reply("Deck não encontrado!")
+	deck, stats := analysis.Deck, analysis.Stats
+
+	validity := "✅ Válido"
+	if !analysis.IsValid() {
+		validity = fmt.Sprintf("❌ %s", analysis.ValidationError)
 	}
 
 	embed := &discordgo.MessageEmbed{
@@ -216,12 +243,203 @@ func (dc *DeckCommands) handleView(ctx *CommandContext) error {
 			},
 			{
 				Name:   "Total de Cartas",
-				Value:  fmt.Sprintf("%d", len(deck.Cards)),
+				Value:  fmt.Sprintf("%d/%d (%d distintas)", stats.TotalCards, analysis.Rules.MaxCards, stats.DistinctCards),
 				Inline: true,
 			},
 		},
 	}
 
+	if stats.TotalCards > 0 {
+		embed.Fields = append(embed.Fields,
+			&discordgo.MessageEmbedField{
+				Name:   "Curva de Mana",
+				Value:  formatCostCurve(stats),
+				Inline: false,
+			},
+			&discordgo.MessageEmbedField{
+				Name:   "Tipos",
+				Value:  formatTypeCounts(stats.Types),
+				Inline: true,
+			},
+			&discordgo.MessageEmbedField{
+				Name:   "Raridades",
+				Value:  formatRarityCounts(stats.Rarities),
+				Inline: true,
+			},
+			&discordgo.MessageEmbedField{
+				Name: "Médias",
+				Value: fmt.Sprintf("💎 Custo: %.1f\n⚔️ Ataque: %.1f\n🛡️ Defesa: %.1f",
+					stats.AverageCost, stats.AverageAttack, stats.AverageDefense),
+				Inline: true,
+			},
+		)
+		if len(stats.Keywords) > 0 {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:   "Palavras-chave",
+				Value:  formatKeywordCounts(stats.Keywords),
+				Inline: false,
+			})
+		}
+		if len(stats.Missing) > 0 {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:   "⚠️ Cartas fora do catálogo",
+				Value:  strings.Join(stats.Missing, ", "),
+				Inline: false,
+			})
+		}
+	}
+
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name:   "Regras do Servidor",
+		Value:  validity,
+		Inline: false,
+	})
+
 	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
 	return err
 }
+
+// formatCostCurve desenha a curva de mana como barras, uma linha por custo
+func formatCostCurve(stats *entities.DeckStats) string {
+	var lines []string
+	for cost := 0; cost <= stats.MaxCost(); cost++ {
+		count := stats.CostCurve[cost]
+		lines = append(lines, fmt.Sprintf("`%2d` %s %d", cost, strings.Repeat("█", count), count))
+	}
+	return truncateLines(lines, 1000)
+}
+
+// formatTypeCounts lista a quantidade de cópias por tipo de carta
+func formatTypeCounts(types map[entities.CardType]int) string {
+	var lines []string
+	for _, cardType := range []entities.CardType{entities.CardTypeCreature, entities.CardTypeSpell, entities.CardTypeArtifact, entities.CardTypeEnchant} {
+		if count := types[cardType]; count > 0 {
+			lines = append(lines, fmt.Sprintf("%s: %d", cardType, count))
+		}
+	}
+	if len(lines) == 0 {
+		return "-"
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatRarityCounts lista a quantidade de cópias por raridade
+func formatRarityCounts(rarities map[entities.CardRarity]int) string {
+	var lines []string
+	for _, rarity := range []entities.CardRarity{entities.CardRarityCommon, entities.CardRarityUncommon, entities.CardRarityRare, entities.CardRarityEpic, entities.CardRarityLegendary} {
+		if count := rarities[rarity]; count > 0 {
+			lines = append(lines, fmt.Sprintf("%s %s: %d", rarityEmojis[rarity], rarity, count))
+		}
+	}
+	if len(lines) == 0 {
+		return "-"
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatKeywordCounts lista as palavras-chave da mais para a menos frequente
+func formatKeywordCounts(keywords map[string]int) string {
+	names := make([]string, 0, len(keywords))
+	for keyword := range keywords {
+		names = append(names, keyword)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if keywords[names[i]] != keywords[names[j]] {
+			return keywords[names[i]] > keywords[names[j]]
+		}
+		return names[i] < names[j]
+	})
+
+	parts := make([]string, 0, len(names))
+	for _, keyword := range names {
+		parts = append(parts, fmt.Sprintf("%s ×%d", keyword, keywords[keyword]))
+	}
+	return strings.Join(parts, " • ")
+}
+
+// handleExport processa o comando de exportar deck
+func (dc *DeckCommands) handleExport(ctx *CommandContext) error {
+	if len(ctx.Args) < 1 {
+		return ctx.Reply("Por favor, forneça o ID do deck!")
+	}
+
+	deck, code, err := dc.deckService.ExportDeckCode(context.Background(), ctx.Args[0])
+	if err != nil {
+		return ctx.Reply(fmt.Sprintf("Erro ao exportar deck: %s", err))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📤 %s", deck.Name),
+		Description: fmt.Sprintf("```\n%s\n```", code),
+		Color:       0x0099ff,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("%s • %d cartas • use importar-deck <código> para copiar", deck.Class, deck.GetCardCount()),
+		},
+	}
+
+	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
+
+// handleImport processa o comando de importar deck
+func (dc *DeckCommands) handleImport(ctx *CommandContext) error {
+	if len(ctx.Args) < 1 {
+		return ctx.Reply("Por favor, forneça o código do deck!")
+	}
+
+	name := "Deck importado"
+	if len(ctx.Args) > 1 {
+		name = strings.Join(ctx.Args[1:], " ")
+	}
+
+	deck, err := dc.deckService.ImportDeckCode(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID, ctx.Args[0], name)
+	var reqErr *entities.RequirementsError
+	if errors.As(err, &reqErr) {
+		return sendRequirementsEmbed(ctx, reqErr)
+	}
+	if err != nil {
+		return ctx.Reply(fmt.Sprintf("Erro ao importar deck: %s", err))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "📥 Deck Importado",
+		Description: fmt.Sprintf("Deck **%s** criado com sucesso!", deck.Name),
+		Color:       0x00ff00,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "ID",
+				Value:  deck.ID.Hex(),
+				Inline: true,
+			},
+			{
+				Name:   "Classe",
+				Value:  deck.Class,
+				Inline: true,
+			},
+			{
+				Name:   "Total de Cartas",
+				Value:  fmt.Sprintf("%d", deck.GetCardCount()),
+				Inline: true,
+			},
+		},
+	}
+
+	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
+
+// sendRequirementsEmbed lista os requisitos da carta que o personagem não atende
+func sendRequirementsEmbed(ctx *CommandContext, reqErr *entities.RequirementsError) error {
+	var lines []string
+	for _, failure := range reqErr.Failures {
+		lines = append(lines, "❌ "+failure)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "🔒 Requisitos não atendidos",
+		Description: fmt.Sprintf("Seu personagem não atende aos requisitos da carta **%s**:\n\n%s", reqErr.CardID, strings.Join(lines, "\n")),
+		Color:       0xff0000,
+	}
+	_, err := ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
@@ -0,0 +1,443 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"sirdraith/internal/domain/battle"
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/services"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Quantidade de eventos do histórico exibidos no tabuleiro
+const boardLogEntries = 6
+
+// DuelCommands encapsula os comandos de duelo de cartas
+type DuelCommands struct {
+	duelService *services.DuelService
+}
+
+// NewDuelCommands cria uma nova instância de DuelCommands
+func NewDuelCommands(duelService *services.DuelService) *DuelCommands {
+	return &DuelCommands{
+		duelService: duelService,
+	}
+}
+
+// Register registra os comandos de duelo
+func (dc *DuelCommands) Register(registry *CommandRegistry) {
+	registry.RegisterCommand(&Command{
+		Name:        "duelar",
+		Description: "Desafia outro membro para um duelo de cartas",
+		Usage:       "duelar <@usuário> <id-do-deck>",
+		Category:    "Duelos",
+		Handler:     dc.handleChallenge,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "aceitar",
+		Description: "Aceita o desafio pendente usando um dos seus decks",
+		Usage:       "aceitar <id-do-deck>",
+		Category:    "Duelos",
+		Handler:     dc.handleAccept,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "recusar",
+		Description: "Recusa um desafio recebido ou cancela o seu desafio",
+		Usage:       "recusar",
+		Category:    "Duelos",
+		Handler:     dc.handleDecline,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "jogar",
+		Description: "Joga uma carta da sua mão",
+		Usage:       "jogar <carta> [alvo: heroi, eu, i<n> (criatura inimiga), a<n> (criatura aliada)]",
+		Category:    "Duelos",
+		Handler:     dc.handlePlay,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "atacar",
+		Description: "Ataca com uma criatura em campo",
+		Usage:       "atacar <criatura> [alvo: heroi ou i<n>]",
+		Category:    "Duelos",
+		Handler:     dc.handleAttack,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "passar",
+		Description: "Encerra o seu turno",
+		Usage:       "passar",
+		Category:    "Duelos",
+		Handler:     dc.handleEndTurn,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "desistir",
+		Description: "Abandona o duelo em andamento",
+		Usage:       "desistir",
+		Category:    "Duelos",
+		Handler:     dc.handleSurrender,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "mao",
+		Aliases:     []string{"mão"},
+		Description: "Envia sua mão atual por mensagem privada",
+		Usage:       "mao",
+		Category:    "Duelos",
+		Handler:     dc.handleHand,
+	})
+}
+
+// handleChallenge processa o comando de desafiar outro membro
+func (dc *DuelCommands) handleChallenge(ctx *CommandContext) error {
+	if len(ctx.Message.Mentions) != 1 || len(ctx.Args) < 2 {
+		return ctx.Reply("Uso: `duelar <@usuário> <id-do-deck>`")
+	}
+
+	opponent := ctx.Message.Mentions[0]
+	if opponent.Bot {
+		return ctx.Reply("Você não pode desafiar um bot!")
+	}
+	deckID := ctx.Args[len(ctx.Args)-1]
+
+	match, err := dc.duelService.Challenge(context.Background(), ctx.Message.GuildID, ctx.Message.ChannelID,
+		ctx.Message.Author.ID, ctx.Message.Author.Username, opponent.ID, opponent.Username, deckID)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Não foi possível criar o desafio: %s", err))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: "⚔️ Desafio de Duelo",
+		Description: fmt.Sprintf("<@%s> desafiou <@%s> para um duelo de cartas!\n\nUse `aceitar <id-do-deck>` para aceitar ou `recusar` para recusar.",
+			match.ChallengerID, match.OpponentID),
+		Color: 0xffa500,
+	}
+	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
+
+// handleAccept processa o comando de aceitar um desafio
+func (dc *DuelCommands) handleAccept(ctx *CommandContext) error {
+	if len(ctx.Args) < 1 {
+		return ctx.Reply("Por favor, forneça o ID do deck que deseja usar!")
+	}
+
+	match, err := dc.duelService.Accept(context.Background(), ctx.Message.GuildID, ctx.Message.Author.ID, ctx.Args[0])
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Não foi possível aceitar o desafio: %s", err))
+	}
+
+	if err := dc.updateBoard(ctx, match); err != nil {
+		return err
+	}
+	for _, p := range match.Duel.Players {
+		dc.sendHand(ctx.Session, match, p)
+	}
+	return nil
+}
+
+// handleDecline processa o comando de recusar ou cancelar um desafio
+func (dc *DuelCommands) handleDecline(ctx *CommandContext) error {
+	match, err := dc.duelService.Decline(context.Background(), ctx.Message.GuildID, ctx.Message.Author.ID)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Não foi possível recusar o desafio: %s", err))
+	}
+
+	if match.ChallengerID == ctx.Message.Author.ID {
+		return ctx.Reply(fmt.Sprintf("🚫 <@%s> cancelou o desafio contra <@%s>.", match.ChallengerID, match.OpponentID))
+	}
+	return ctx.Reply(fmt.Sprintf("🚫 <@%s> recusou o desafio de <@%s>.", match.OpponentID, match.ChallengerID))
+}
+
+// handlePlay processa o comando de jogar uma carta
+func (dc *DuelCommands) handlePlay(ctx *CommandContext) error {
+	if len(ctx.Args) < 1 {
+		return ctx.Reply("Por favor, informe a posição da carta na sua mão!")
+	}
+
+	handIndex, err := parseInt(ctx.Args[0])
+	if err != nil {
+		return ctx.Reply("Posição de carta inválida!")
+	}
+
+	var target *battle.Target
+	if len(ctx.Args) > 1 {
+		own, err := dc.playerIndex(ctx)
+		if err != nil {
+			return sendErrorEmbed(ctx, err.Error())
+		}
+		t, err := parseTarget(ctx.Args[1], own)
+		if err != nil {
+			return sendErrorEmbed(ctx, err.Error())
+		}
+		target = &t
+	}
+
+	match, err := dc.duelService.PlayCard(context.Background(), ctx.Message.GuildID, ctx.Message.Author.ID, handIndex-1, target)
+	return dc.afterAction(ctx, match, err)
+}
+
+// handleAttack processa o comando de atacar com uma criatura
+func (dc *DuelCommands) handleAttack(ctx *CommandContext) error {
+	if len(ctx.Args) < 1 {
+		return ctx.Reply("Por favor, informe a posição da criatura atacante!")
+	}
+
+	attacker, err := parseInt(ctx.Args[0])
+	if err != nil {
+		return ctx.Reply("Posição de criatura inválida!")
+	}
+
+	own, err := dc.playerIndex(ctx)
+	if err != nil {
+		return sendErrorEmbed(ctx, err.Error())
+	}
+	target := battle.HeroTarget(1 - own)
+	if len(ctx.Args) > 1 {
+		if target, err = parseTarget(ctx.Args[1], own); err != nil {
+			return sendErrorEmbed(ctx, err.Error())
+		}
+	}
+
+	match, err := dc.duelService.Attack(context.Background(), ctx.Message.GuildID, ctx.Message.Author.ID, attacker-1, target)
+	return dc.afterAction(ctx, match, err)
+}
+
+// handleEndTurn processa o comando de encerrar o turno
+func (dc *DuelCommands) handleEndTurn(ctx *CommandContext) error {
+	match, err := dc.duelService.EndTurn(context.Background(), ctx.Message.GuildID, ctx.Message.Author.ID)
+	if err := dc.afterAction(ctx, match, err); err != nil {
+		return err
+	}
+	if match != nil && !match.Duel.IsFinished() {
+		dc.sendHand(ctx.Session, match, match.Duel.ActivePlayer())
+	}
+	return nil
+}
+
+// handleSurrender processa o comando de desistir do duelo
+func (dc *DuelCommands) handleSurrender(ctx *CommandContext) error {
+	match, err := dc.duelService.Surrender(context.Background(), ctx.Message.GuildID, ctx.Message.Author.ID)
+	return dc.afterAction(ctx, match, err)
+}
+
+// handleHand processa o comando de consultar a mão
+func (dc *DuelCommands) handleHand(ctx *CommandContext) error {
+	match, err := dc.duelService.GetOpenDuel(context.Background(), ctx.Message.GuildID, ctx.Message.Author.ID)
+	if err != nil {
+		return sendErrorEmbed(ctx, err.Error())
+	}
+	if match.Duel == nil {
+		return sendErrorEmbed(ctx, battle.ErrMatchNotActive.Error())
+	}
+
+	index, err := match.Duel.PlayerIndex(ctx.Message.Author.ID)
+	if err != nil {
+		return sendErrorEmbed(ctx, err.Error())
+	}
+	dc.sendHand(ctx.Session, match, match.Duel.Players[index])
+	return ctx.Reply("📨 Sua mão foi enviada por mensagem privada.")
+}
+
+// afterAction mostra o erro da ação ou atualiza o tabuleiro
+func (dc *DuelCommands) afterAction(ctx *CommandContext, match *battle.Match, err error) error {
+	if err != nil {
+		return sendErrorEmbed(ctx, actionErrorMessage(err))
+	}
+	return dc.updateBoard(ctx, match)
+}
+
+// playerIndex retorna a posição do autor da mensagem no duelo em andamento
+func (dc *DuelCommands) playerIndex(ctx *CommandContext) (int, error) {
+	match, err := dc.duelService.GetOpenDuel(context.Background(), ctx.Message.GuildID, ctx.Message.Author.ID)
+	if err != nil {
+		return -1, err
+	}
+	if match.Duel == nil {
+		return -1, battle.ErrMatchNotActive
+	}
+	return match.Duel.PlayerIndex(ctx.Message.Author.ID)
+}
+
+// updateBoard edita a mensagem do tabuleiro ou envia uma nova caso ela não exista mais
+func (dc *DuelCommands) updateBoard(ctx *CommandContext, match *battle.Match) error {
+	embed := buildBoardEmbed(match)
+
+	if match.MessageID != "" {
+		_, err := ctx.Session.ChannelMessageEditEmbed(match.ChannelID, match.MessageID, embed)
+		if err == nil {
+			return nil
+		}
+		log.Printf("Erro ao editar tabuleiro do duelo %s: %v", match.ID.Hex(), err)
+	}
+
+	msg, err := ctx.Session.ChannelMessageSendEmbed(match.ChannelID, embed)
+	if err != nil {
+		return fmt.Errorf("erro ao enviar tabuleiro: %w", err)
+	}
+	return dc.duelService.SetBoardMessage(context.Background(), match, msg.ID)
+}
+
+// sendHand envia a mão de um jogador por mensagem privada
+func (dc *DuelCommands) sendHand(s *discordgo.Session, match *battle.Match, p *battle.Player) {
+	channel, err := s.UserChannelCreate(p.ID)
+	if err != nil {
+		log.Printf("Erro ao abrir mensagem privada com %s: %v", p.ID, err)
+		return
+	}
+
+	var lines []string
+	for i, ci := range p.Hand {
+		lines = append(lines, fmt.Sprintf("`%d` %s", i+1, describeCard(ci)))
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "Sua mão está vazia.")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🃏 Sua mão (💧 %d/%d)", p.Mana, p.MaxMana),
+		Description: strings.Join(lines, "\n"),
+		Color:       0x0099ff,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Duelo: %s vs %s • use jogar <carta> [alvo] no canal do duelo", match.ChallengerName, match.OpponentName),
+		},
+	}
+	if _, err := s.ChannelMessageSendEmbed(channel.ID, embed); err != nil {
+		log.Printf("Erro ao enviar mão para %s: %v", p.ID, err)
+	}
+}
+
+// buildBoardEmbed monta o embed com o estado atual do duelo
+func buildBoardEmbed(match *battle.Match) *discordgo.MessageEmbed {
+	d := match.Duel
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("⚔️ Duelo: %s vs %s", match.ChallengerName, match.OpponentName),
+		Color: 0x9b59b6,
+	}
+
+	if d.IsFinished() {
+		embed.Color = 0xffd700
+		if d.Winner == "" {
+			embed.Description = "🤝 O duelo terminou empatado!"
+		} else {
+			embed.Description = fmt.Sprintf("🏆 <@%s> venceu o duelo!", d.Winner)
+		}
+	} else {
+		embed.Description = fmt.Sprintf("Turno %d • vez de <@%s>", d.Turn, d.ActivePlayer().ID)
+	}
+
+	for i, p := range d.Players {
+		name := p.Name
+		if i == d.Active && !d.IsFinished() {
+			name = "▶️ " + name
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   name,
+			Value:  describePlayer(p),
+			Inline: true,
+		})
+	}
+
+	if len(d.Log) > 0 {
+		start := len(d.Log) - boardLogEntries
+		if start < 0 {
+			start = 0
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "📜 Últimos eventos",
+			Value: strings.Join(d.Log[start:], "\n"),
+		})
+	}
+
+	embed.Footer = &discordgo.MessageEmbedFooter{
+		Text: "jogar <carta> [alvo] • atacar <criatura> [alvo] • passar • mao • desistir",
+	}
+	return embed
+}
+
+// describePlayer resume o estado de um jogador no tabuleiro
+func describePlayer(p *battle.Player) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("❤️ %d/%d • 💧 %d/%d\n", p.Health, p.MaxHealth, p.Mana, p.MaxMana))
+	sb.WriteString(fmt.Sprintf("🃏 Mão: %d • 📚 Deck: %d\n", len(p.Hand), len(p.Library)))
+
+	sb.WriteString("\n**Campo**\n")
+	if len(p.Board) == 0 {
+		sb.WriteString("Vazio\n")
+	}
+	for i, ci := range p.Board {
+		ready := ""
+		if ci.CanAttack {
+			ready = " ⚔️"
+		}
+		sb.WriteString(fmt.Sprintf("`%d` %s (%d/%d)%s\n", i+1, ci.Card.Name, ci.Attack, ci.Health, ready))
+	}
+
+	if len(p.Artifacts) > 0 {
+		sb.WriteString("\n**Artefatos**\n")
+		for _, ci := range p.Artifacts {
+			sb.WriteString(fmt.Sprintf("🏺 %s\n", ci.Card.Name))
+		}
+	}
+	return sb.String()
+}
+
+// describeCard resume uma carta da mão
+func describeCard(ci *battle.CardInstance) string {
+	text := fmt.Sprintf("**%s** (💧%d) - %s", ci.Card.Name, ci.Card.Cost, ci.Card.Type)
+	if ci.Card.Type == entities.CardTypeCreature {
+		text += fmt.Sprintf(" %d/%d", ci.Attack, ci.Health)
+	}
+	if len(ci.Card.Keywords) > 0 {
+		text += fmt.Sprintf(" [%s]", strings.Join(ci.Card.Keywords, ", "))
+	}
+	return text
+}
+
+// parseTarget converte o alvo informado pelo jogador.
+// Aceita "heroi" (herói inimigo), "eu" (próprio herói), "i<n>" (criatura inimiga) e "a<n>" (criatura aliada).
+func parseTarget(arg string, own int) (battle.Target, error) {
+	enemy := 1 - own
+	arg = strings.ToLower(arg)
+
+	switch arg {
+	case "heroi", "herói", "inimigo":
+		return battle.HeroTarget(enemy), nil
+	case "eu":
+		return battle.HeroTarget(own), nil
+	}
+
+	if len(arg) >= 2 && (arg[0] == 'i' || arg[0] == 'a') {
+		slot, err := parseInt(arg[1:])
+		if err == nil && slot > 0 {
+			player := enemy
+			if arg[0] == 'a' {
+				player = own
+			}
+			return battle.Target{Player: player, Slot: slot - 1}, nil
+		}
+	}
+
+	return battle.Target{}, fmt.Errorf("alvo inválido: %s. Use heroi, eu, i<n> ou a<n>", arg)
+}
+
+// actionErrorMessage traduz os erros do duelo para uma mensagem ao jogador
+func actionErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, battle.ErrNotYourTurn):
+		return "Aguarde o seu turno!"
+	case errors.Is(err, battle.ErrDuelFinished), errors.Is(err, battle.ErrMatchNotActive):
+		return "Não há duelo em andamento."
+	default:
+		return fmt.Sprintf("Ação inválida: %s", err)
+	}
+}
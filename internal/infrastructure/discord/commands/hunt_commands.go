@@ -0,0 +1,193 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sirdraith/internal/domain/services"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// HuntCommands encapsula os comandos de caçada de monstros
+type HuntCommands struct {
+	huntService *services.HuntService
+}
+
+// NewHuntCommands cria uma nova instância de HuntCommands
+func NewHuntCommands(huntService *services.HuntService) *HuntCommands {
+	return &HuntCommands{
+		huntService: huntService,
+	}
+}
+
+// Register registra os comandos de caçada
+func (hc *HuntCommands) Register(registry *CommandRegistry) {
+	registry.RegisterCommand(&Command{
+		Name:        "cacar",
+		Aliases:     []string{"caçar"},
+		Description: "Parte em busca de monstros adequados ao nível do seu personagem",
+		Usage:       "cacar",
+		Category:    "Personagem",
+		Handler:     hc.handleHunt,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "golpear",
+		Description: "Ataca um monstro da caçada em andamento",
+		Usage:       "golpear [número do monstro]",
+		Category:    "Personagem",
+		Handler:     hc.handleStrike,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "fugir",
+		Description: "Abandona a caçada em andamento sem recompensas",
+		Usage:       "fugir",
+		Category:    "Personagem",
+		Handler:     hc.handleFlee,
+	})
+}
+
+// handleHunt processa o comando de iniciar uma caçada
+func (hc *HuntCommands) handleHunt(ctx *CommandContext) error {
+	hunt, turn, err := hc.huntService.StartHunt(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Não foi possível caçar: %s", err))
+	}
+	return hc.sendHuntEmbed(ctx, hunt, turn)
+}
+
+// handleStrike processa o comando de atacar um monstro
+func (hc *HuntCommands) handleStrike(ctx *CommandContext) error {
+	target := 0
+	if len(ctx.Args) > 0 {
+		n, err := parseInt(ctx.Args[0])
+		if err != nil || n < 1 {
+			return sendErrorEmbed(ctx, "Número de monstro inválido!")
+		}
+		target = n
+	}
+
+	hunt, turn, err := hc.huntService.Strike(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID, target)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Não foi possível atacar: %s", err))
+	}
+	return hc.sendHuntEmbed(ctx, hunt, turn)
+}
+
+// handleFlee processa o comando de fugir da caçada
+func (hc *HuntCommands) handleFlee(ctx *CommandContext) error {
+	hunt, err := hc.huntService.Flee(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Não foi possível fugir: %s", err))
+	}
+
+	return ctx.Reply(fmt.Sprintf("🏃 **%s** fugiu da caçada com %d/%d de vida.",
+		hunt.Character.Name, hunt.Character.Combat.Health, hunt.Character.Combat.MaxHealth))
+}
+
+// sendHuntEmbed mostra a situação da caçada e o resultado da última ação
+func (hc *HuntCommands) sendHuntEmbed(ctx *CommandContext, hunt *services.Hunt, turn *services.HuntTurn) error {
+	character := hunt.Character
+
+	var monsters []string
+	for i, m := range hunt.Monsters {
+		status := fmt.Sprintf("❤️ %d/%d • CA %d", m.Health(), m.Monster.MaxHealth, m.ArmorClass())
+		if m.Health() <= 0 {
+			status = "☠️ derrotado"
+		}
+		monsters = append(monsters, fmt.Sprintf("`%d` **%s** — %s", i+1, m.Name(), status))
+	}
+
+	log := hunt.Encounter.Log
+	if len(log) > boardLogEntries {
+		log = log[len(log)-boardLogEntries:]
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("🗡️ Caçada de %s", character.Name),
+		Color: 0x8b4513,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Personagem",
+				Value:  fmt.Sprintf("❤️ %d/%d • CA %d", character.Combat.Health, character.Combat.MaxHealth, hunt.Encounter.Find(character.ID.Hex()).ArmorClass()),
+				Inline: true,
+			},
+			{
+				Name:   "Rodada",
+				Value:  fmt.Sprintf("%d", hunt.Encounter.Round),
+				Inline: true,
+			},
+			{
+				Name:   "Monstros",
+				Value:  strings.Join(monsters, "\n"),
+				Inline: false,
+			},
+			{
+				Name:   "Combate",
+				Value:  strings.Join(log, "\n"),
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Use golpear [número] para atacar ou fugir para abandonar a caçada",
+		},
+	}
+
+	if turn.Finished {
+		embed.Footer = nil
+		if turn.Victory {
+			embed.Color = 0x00ff00
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:   "🏆 Vitória!",
+				Value:  huntRewardText(turn),
+				Inline: false,
+			})
+		} else {
+			embed.Color = 0xff0000
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:   "💀 Derrota",
+				Value:  fmt.Sprintf("%s caiu e está morrendo! Use resistir para fazer testes contra a morte.", character.Name),
+				Inline: false,
+			})
+		}
+	}
+
+	if len(turn.QuestUpdates) > 0 {
+		var updates []string
+		for _, progress := range turn.QuestUpdates {
+			updates = append(updates, fmt.Sprintf("**%s**", progress.Quest.Title))
+			updates = append(updates, questObjectiveLines(progress, character)...)
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "📜 Missões",
+			Value:  truncateLines(updates, 1024),
+			Inline: false,
+		})
+	}
+
+	if _, err := ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed); err != nil {
+		return err
+	}
+	if turn.LevelsGained > 0 && character.HasPendingChoices() {
+		return sendLevelUpPrompt(ctx.Session, ctx.Message.ChannelID, character)
+	}
+	return nil
+}
+
+// huntRewardText descreve as recompensas de uma caçada vencida
+func huntRewardText(turn *services.HuntTurn) string {
+	lines := []string{fmt.Sprintf("✨ %d de experiência • 💰 %d de ouro", turn.Reward.Experience, turn.Reward.Gold)}
+	if turn.LevelsGained > 0 {
+		lines = append(lines, fmt.Sprintf("⬆️ Subiu %d nível(is)!", turn.LevelsGained))
+	}
+	for _, item := range turn.Loot {
+		lines = append(lines, fmt.Sprintf("🎒 %s", item.Name))
+	}
+	for _, item := range turn.LostLoot {
+		lines = append(lines, fmt.Sprintf("❌ %s (inventário cheio)", item.Name))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,210 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/gamedata"
+	"sirdraith/internal/domain/services"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// attributeLabels traduz os atributos para exibição, com seus emojis
+var attributeLabels = map[string]struct {
+	name  string
+	emoji string
+}{
+	"strength":     {"Força", "💪"},
+	"dexterity":    {"Destreza", "🏃"},
+	"constitution": {"Constituição", "❤️"},
+	"intelligence": {"Inteligência", "🧠"},
+	"wisdom":       {"Sabedoria", "🦉"},
+	"charisma":     {"Carisma", "👑"},
+}
+
+// LevelUpCommands encapsula as escolhas feitas ao subir de nível
+type LevelUpCommands struct {
+	characterService *services.CharacterService
+}
+
+// NewLevelUpCommands cria uma nova instância de LevelUpCommands
+func NewLevelUpCommands(characterService *services.CharacterService) *LevelUpCommands {
+	return &LevelUpCommands{
+		characterService: characterService,
+	}
+}
+
+// Register registra os comandos de evolução de nível
+func (lc *LevelUpCommands) Register(registry *CommandRegistry) {
+	registry.RegisterCommand(&Command{
+		Name:        "evoluir",
+		Aliases:     []string{"escolhas"},
+		Description: "Distribui pontos de atributo e escolhe perícias pendentes dos níveis alcançados",
+		Usage:       "evoluir",
+		Category:    "Personagem",
+		Handler:     lc.handleChoices,
+	})
+
+	registry.RegisterComponentHandler("evoluir", lc.handleChoiceButton)
+}
+
+// handleChoices mostra as escolhas pendentes do personagem ativo
+func (lc *LevelUpCommands) handleChoices(ctx *CommandContext) error {
+	character, err := lc.characterService.GetCharacterByUserAndGuild(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID)
+	if err != nil || character == nil {
+		return ctx.Reply("Você não possui um personagem neste servidor!")
+	}
+	if !character.HasPendingChoices() {
+		return ctx.Reply(fmt.Sprintf("**%s** não tem escolhas de evolução pendentes.", character.Name))
+	}
+	return sendLevelUpPrompt(ctx.Session, ctx.Message.ChannelID, character)
+}
+
+// handleChoiceButton processa os botões de atributo e perícia do painel de evolução.
+// O CustomID tem o formato evoluir:<attr|skill>:<ID do personagem>:<escolha>
+func (lc *LevelUpCommands) handleChoiceButton(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	parts := strings.Split(i.MessageComponentData().CustomID, ":")
+	if len(parts) != 4 {
+		return respondEphemeral(s, i, "Botão inválido.")
+	}
+	kind, characterID, choice := parts[1], parts[2], parts[3]
+
+	var character *entities.Character
+	var err error
+	switch kind {
+	case "attr":
+		character, err = lc.characterService.SpendAbilityPoint(context.Background(), interactionUserID(i), characterID, choice)
+	case "skill":
+		character, err = lc.characterService.ChooseSkillProficiency(context.Background(), interactionUserID(i), characterID, gamedata.Skill(choice))
+	default:
+		return respondEphemeral(s, i, "Botão inválido.")
+	}
+
+	switch {
+	case errors.Is(err, services.ErrCharacterNotFound):
+		return respondEphemeral(s, i, "Apenas o dono do personagem pode fazer estas escolhas.")
+	case errors.Is(err, services.ErrNoPendingChoices):
+		// As escolhas já foram feitas em outra mensagem; apenas atualiza o painel
+	case err != nil:
+		return respondEphemeral(s, i, fmt.Sprintf("Não foi possível evoluir: %s", err))
+	}
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{levelUpEmbed(character)},
+			Components: levelUpComponents(character),
+		},
+	})
+}
+
+// sendLevelUpPrompt envia ao canal o painel com as escolhas pendentes do personagem
+func sendLevelUpPrompt(s *discordgo.Session, channelID string, character *entities.Character) error {
+	_, err := s.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content:    fmt.Sprintf("<@%s>, você tem escolhas de evolução pendentes!", character.UserID),
+		Embed:      levelUpEmbed(character),
+		Components: levelUpComponents(character),
+	})
+	return err
+}
+
+// levelUpEmbed cria o embed com os atributos, perícias e escolhas pendentes
+func levelUpEmbed(character *entities.Character) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("⬆️ Evolução de %s", character.Name),
+		Color: 0x9b59b6,
+	}
+
+	if !character.HasPendingChoices() {
+		embed.Color = 0x00ff00
+		embed.Description = "Todas as escolhas de evolução foram feitas!"
+	} else {
+		var levels []string
+		for _, choice := range character.PendingChoices {
+			levels = append(levels, fmt.Sprintf("%d", choice.Level))
+		}
+		embed.Description = fmt.Sprintf("Escolhas dos níveis %s", strings.Join(levels, ", "))
+	}
+
+	var attributes []string
+	for _, attr := range gamedata.AttributeNames {
+		label := attributeLabels[attr]
+		attributes = append(attributes, fmt.Sprintf("%s %s: %d", label.emoji, label.name, character.Attributes.GetValue(attr)))
+	}
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name:   fmt.Sprintf("Atributos (%d ponto(s) a distribuir)", character.PendingAbilityPoints()),
+		Value:  strings.Join(attributes, "\n"),
+		Inline: true,
+	})
+
+	var skills []string
+	for _, skill := range gamedata.GetSkillsForClass(character.Class) {
+		status := "⚪"
+		for _, p := range character.Skills {
+			if p.Skill == skill && p.IsProficient {
+				status = "🟢"
+				break
+			}
+		}
+		skills = append(skills, fmt.Sprintf("%s %s", status, skill))
+	}
+	if len(skills) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("Perícias de classe (%d a escolher)", character.PendingSkillPicks()),
+			Value:  strings.Join(skills, "\n"),
+			Inline: true,
+		})
+	}
+
+	if character.HasPendingChoices() {
+		embed.Footer = &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Atributos vão até %d • Use evoluir para reabrir este painel", gamedata.MaxAttributeValue),
+		}
+	}
+	return embed
+}
+
+// levelUpComponents cria os botões das escolhas pendentes, três por linha
+func levelUpComponents(character *entities.Character) []discordgo.MessageComponent {
+	id := character.ID.Hex()
+	var buttons []discordgo.MessageComponent
+
+	if character.PendingAbilityPoints() > 0 {
+		for _, attr := range gamedata.AttributeNames {
+			label := attributeLabels[attr]
+			buttons = append(buttons, discordgo.Button{
+				Label:    fmt.Sprintf("%s +1", label.name),
+				Style:    discordgo.PrimaryButton,
+				CustomID: fmt.Sprintf("evoluir:attr:%s:%s", id, attr),
+				Disabled: character.Attributes.GetValue(attr) >= gamedata.MaxAttributeValue,
+				Emoji: discordgo.ComponentEmoji{
+					Name: label.emoji,
+				},
+			})
+		}
+	}
+
+	if character.PendingSkillPicks() > 0 {
+		for _, skill := range character.AvailableSkillChoices() {
+			buttons = append(buttons, discordgo.Button{
+				Label:    string(skill),
+				Style:    discordgo.SuccessButton,
+				CustomID: fmt.Sprintf("evoluir:skill:%s:%s", id, skill),
+				Emoji: discordgo.ComponentEmoji{
+					Name: "🎯",
+				},
+			})
+		}
+	}
+
+	var rows []discordgo.MessageComponent
+	for start := 0; start < len(buttons); start += 3 {
+		end := min(start+3, len(buttons))
+		rows = append(rows, discordgo.ActionsRow{Components: buttons[start:end]})
+	}
+	return rows
+}
@@ -0,0 +1,224 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/services"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// QuestCommands encapsula os comandos de missões
+type QuestCommands struct {
+	questService *services.QuestService
+}
+
+// NewQuestCommands cria uma nova instância de QuestCommands
+func NewQuestCommands(questService *services.QuestService) *QuestCommands {
+	return &QuestCommands{
+		questService: questService,
+	}
+}
+
+// Register registra os comandos de missões
+func (qc *QuestCommands) Register(registry *CommandRegistry) {
+	registry.RegisterCommand(&Command{
+		Name:        "missoes",
+		Aliases:     []string{"missões"},
+		Description: "Lista as missões disponíveis e o progresso das suas missões ativas",
+		Usage:       "missoes",
+		Category:    "Personagem",
+		Handler:     qc.handleList,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "aceitar-missao",
+		Aliases:     []string{"aceitar-missão"},
+		Description: "Aceita uma das missões disponíveis",
+		Usage:       "aceitar-missao <número>",
+		Category:    "Personagem",
+		Handler:     qc.handleAccept,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "concluir-missao",
+		Aliases:     []string{"concluir-missão"},
+		Description: "Tenta concluir uma missão ativa e receber as recompensas",
+		Usage:       "concluir-missao <número>",
+		Category:    "Personagem",
+		Handler:     qc.handleComplete,
+	})
+}
+
+// handleList processa o comando de listar missões
+func (qc *QuestCommands) handleList(ctx *CommandContext) error {
+	board, err := qc.questService.GetBoard(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Não foi possível listar as missões: %s", err))
+	}
+
+	available := questListLines(board.Available)
+
+	var active []string
+	for i, progress := range board.Active {
+		lines := []string{fmt.Sprintf("`%d` **%s** — prazo <t:%d:R>", i+1, progress.Quest.Title, progress.Deadline.Unix())}
+		lines = append(lines, questObjectiveLines(progress, board.Character)...)
+		active = append(active, strings.Join(lines, "\n"))
+	}
+	if len(active) == 0 {
+		active = append(active, "Nenhuma missão ativa.")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: "📜 Quadro de Missões",
+		Color: 0xdaa520,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Disponíveis",
+				Value:  truncateLines(available, 1024),
+				Inline: false,
+			},
+			{
+				Name:   fmt.Sprintf("Missões de %s (%d/%d)", board.Character.Name, len(board.Active), entities.MaxActiveQuests),
+				Value:  truncateLines(active, 1024),
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Use aceitar-missao <número> para aceitar e concluir-missao <número> para entregar",
+		},
+	}
+
+	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
+
+// handleAccept processa o comando de aceitar uma missão
+func (qc *QuestCommands) handleAccept(ctx *CommandContext) error {
+	if len(ctx.Args) < 1 {
+		return sendErrorEmbed(ctx, "Uso correto: aceitar-missao <número>")
+	}
+	number, err := parseInt(ctx.Args[0])
+	if err != nil {
+		return sendErrorEmbed(ctx, "Número de missão inválido!")
+	}
+
+	progress, err := qc.questService.AcceptQuest(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID, number)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Não foi possível aceitar a missão: %s", err))
+	}
+
+	return ctx.Reply(fmt.Sprintf("📜 Missão **%s** aceita! Prazo: <t:%d:R>.", progress.Quest.Title, progress.Deadline.Unix()))
+}
+
+// handleComplete processa o comando de concluir uma missão
+func (qc *QuestCommands) handleComplete(ctx *CommandContext) error {
+	if len(ctx.Args) < 1 {
+		return sendErrorEmbed(ctx, "Uso correto: concluir-missao <número>")
+	}
+	number, err := parseInt(ctx.Args[0])
+	if err != nil {
+		return sendErrorEmbed(ctx, "Número de missão inválido!")
+	}
+
+	completion, err := qc.questService.CompleteQuest(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID, number)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Não foi possível concluir a missão: %s", err))
+	}
+
+	progress := completion.Progress
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("📜 %s", progress.Quest.Title),
+		Color: 0xffa500,
+	}
+
+	if len(completion.Checks) > 0 {
+		var checks []string
+		for _, check := range completion.Checks {
+			status := "❌"
+			if check.Passed {
+				status = "✅"
+			}
+			checks = append(checks, fmt.Sprintf("%s %s: %d %+d = %d (CD %d)", status, check.Objective.Target,
+				check.Roll, check.Modifier, check.Roll+check.Modifier, check.Objective.Difficulty))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "🎲 Testes de Perícia",
+			Value:  strings.Join(checks, "\n"),
+			Inline: false,
+		})
+	}
+
+	if completion.Completed {
+		embed.Color = 0x00ff00
+		lines := []string{questRewardText(progress.Quest.Reward)}
+		if completion.LevelsGained > 0 {
+			lines = append(lines, fmt.Sprintf("⬆️ Subiu %d nível(is)!", completion.LevelsGained))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "🏆 Missão concluída!",
+			Value:  strings.Join(lines, "\n"),
+			Inline: false,
+		})
+	} else {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Objetivos pendentes",
+			Value:  strings.Join(questObjectiveLines(progress, completion.Character), "\n"),
+			Inline: false,
+		})
+	}
+
+	if _, err := ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed); err != nil {
+		return err
+	}
+	if completion.LevelsGained > 0 && completion.Character.HasPendingChoices() {
+		return sendLevelUpPrompt(ctx.Session, ctx.Message.ChannelID, completion.Character)
+	}
+	return nil
+}
+
+// questListLines descreve as missões oferecidas, numeradas na ordem da lista
+func questListLines(quests []*entities.Quest) []string {
+	var lines []string
+	for i, quest := range quests {
+		line := fmt.Sprintf("`%d` **%s** (nível %d+) — %s", i+1, quest.Title, quest.MinLevel, questRewardText(quest.Reward))
+		if quest.ExpiresAt != nil {
+			line += fmt.Sprintf(" • sai do quadro <t:%d:R>", quest.ExpiresAt.Unix())
+		}
+		lines = append(lines, line+"\n"+quest.Description)
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "Nenhuma missão disponível no momento.")
+	}
+	return lines
+}
+
+// questObjectiveLines descreve o progresso de cada objetivo de uma missão
+func questObjectiveLines(progress *entities.QuestProgress, character *entities.Character) []string {
+	lines := make([]string, 0, len(progress.Quest.Objectives))
+	for i, objective := range progress.Quest.Objectives {
+		current, required := progress.ObjectiveProgress(i, character)
+		status := "▫️"
+		if current >= required {
+			status = "✅"
+		}
+		if objective.Type == entities.ObjectiveSkillCheck {
+			lines = append(lines, fmt.Sprintf("%s %s", status, objective.Describe()))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s %s (%d/%d)", status, objective.Describe(), current, required))
+	}
+	return lines
+}
+
+// questRewardText descreve as recompensas de uma missão
+func questRewardText(reward entities.QuestReward) string {
+	parts := []string{fmt.Sprintf("✨ %d de experiência", reward.Experience), fmt.Sprintf("💰 %d de ouro", reward.Gold)}
+	for _, item := range reward.Items {
+		parts = append(parts, fmt.Sprintf("🎒 %s", item.Name))
+	}
+	return strings.Join(parts, " • ")
+}
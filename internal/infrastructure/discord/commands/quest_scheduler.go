@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repository"
+	"sirdraith/internal/domain/services"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// questSchedulerInterval é o intervalo entre as verificações de renovação
+const questSchedulerInterval = time.Minute
+
+// QuestScheduler renova periodicamente as missões dos servidores e anuncia o
+// novo quadro no canal de missões configurado. O estado de cada renovação fica
+// no banco, então reinícios do bot não repetem nem pulam anúncios
+type QuestScheduler struct {
+	session      *discordgo.Session
+	questService *services.QuestService
+	configRepo   repository.ConfigRepository
+	stop         chan struct{}
+	done         chan struct{}
+	once         sync.Once
+}
+
+// NewQuestScheduler cria um novo agendador de missões
+func NewQuestScheduler(session *discordgo.Session, questService *services.QuestService, configRepo repository.ConfigRepository) *QuestScheduler {
+	return &QuestScheduler{
+		session:      session,
+		questService: questService,
+		configRepo:   configRepo,
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+}
+
+// Start inicia a verificação periódica em segundo plano
+func (qs *QuestScheduler) Start() {
+	go qs.loop()
+}
+
+// Stop encerra a verificação periódica e aguarda a verificação em andamento
+func (qs *QuestScheduler) Stop() {
+	qs.once.Do(func() {
+		close(qs.stop)
+		<-qs.done
+	})
+}
+
+func (qs *QuestScheduler) loop() {
+	defer close(qs.done)
+
+	ticker := time.NewTicker(questSchedulerInterval)
+	defer ticker.Stop()
+
+	qs.run(time.Now())
+	for {
+		select {
+		case <-qs.stop:
+			return
+		case now := <-ticker.C:
+			qs.run(now)
+		}
+	}
+}
+
+// run renova as missões de todos os servidores em que o bot está
+func (qs *QuestScheduler) run(now time.Time) {
+	if qs.session.State == nil {
+		return
+	}
+
+	qs.session.State.RLock()
+	guildIDs := make([]string, 0, len(qs.session.State.Guilds))
+	for _, guild := range qs.session.State.Guilds {
+		guildIDs = append(guildIDs, guild.ID)
+	}
+	qs.session.State.RUnlock()
+
+	for _, guildID := range guildIDs {
+		if err := qs.runGuild(context.Background(), guildID, now); err != nil {
+			log.Printf("Erro ao renovar missões do servidor %s: %v\n", guildID, err)
+		}
+	}
+}
+
+// runGuild expira as missões vencidas, renova as frequências do servidor e
+// anuncia os quadros ainda não anunciados
+func (qs *QuestScheduler) runGuild(ctx context.Context, guildID string, now time.Time) error {
+	if _, err := qs.questService.ExpireOverdue(ctx, guildID, now); err != nil {
+		return err
+	}
+
+	var pending []*entities.QuestSchedule
+	for _, cadence := range entities.Cadences {
+		schedule, err := qs.questService.RotateQuests(ctx, guildID, cadence, now)
+		if err != nil {
+			return err
+		}
+		if schedule.PostedAt == nil {
+			pending = append(pending, schedule)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	config, err := qs.configRepo.GetGuildConfig(guildID)
+	if err != nil {
+		return err
+	}
+	if config.QuestChannel == "" {
+		// Sem canal não há anúncio: o período é dado como anunciado para não
+		// reler a configuração a cada verificação nem anunciar um quadro antigo
+		// quando o canal for configurado
+		return qs.markPosted(ctx, pending, now)
+	}
+
+	available, err := qs.questService.AvailableQuests(ctx, guildID, now)
+	if err != nil {
+		return err
+	}
+	if _, err := qs.session.ChannelMessageSendEmbed(config.QuestChannel, questAnnouncementEmbed(available)); err != nil {
+		return fmt.Errorf("erro ao anunciar missões: %w", err)
+	}
+
+	// Um único anúncio cobre todas as frequências renovadas
+	return qs.markPosted(ctx, pending, now)
+}
+
+// markPosted registra os períodos renovados como anunciados
+func (qs *QuestScheduler) markPosted(ctx context.Context, pending []*entities.QuestSchedule, now time.Time) error {
+	for _, schedule := range pending {
+		if err := qs.questService.MarkPosted(ctx, schedule, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// questAnnouncementEmbed monta o anúncio do novo quadro de missões
+func questAnnouncementEmbed(available []*entities.Quest) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title:       "📜 Novas missões no quadro!",
+		Description: truncateLines(questListLines(available), 4096),
+		Color:       0xdaa520,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Use aceitar-missao <número> para aceitar uma missão",
+		},
+	}
+}
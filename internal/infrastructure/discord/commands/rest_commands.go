@@ -0,0 +1,154 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/gamedata"
+	"sirdraith/internal/domain/services"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// RestCommands encapsula os descansos e os testes contra a morte
+type RestCommands struct {
+	characterService *services.CharacterService
+}
+
+// NewRestCommands cria uma nova instância de RestCommands
+func NewRestCommands(characterService *services.CharacterService) *RestCommands {
+	return &RestCommands{
+		characterService: characterService,
+	}
+}
+
+// Register registra os comandos de recuperação
+func (rc *RestCommands) Register(registry *CommandRegistry) {
+	registry.RegisterCommand(&Command{
+		Name:        "descansar",
+		Aliases:     []string{"descanso", "rest"},
+		Description: "Descansa para recuperar vida: o curto gasta dados de vida e o longo recupera tudo",
+		Usage:       "descansar <curto [dados] | longo>",
+		Category:    "Personagem",
+		Handler:     rc.handleRest,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "resistir",
+		Aliases:     []string{"teste-morte", "deathsave"},
+		Description: "Faz um teste contra a morte com o personagem caído",
+		Usage:       "resistir",
+		Category:    "Personagem",
+		Handler:     rc.handleDeathSave,
+	})
+}
+
+// handleRest processa o comando de descansar
+func (rc *RestCommands) handleRest(ctx *CommandContext) error {
+	if len(ctx.Args) == 0 {
+		return sendErrorEmbed(ctx, "Use: descansar <curto [dados] | longo>")
+	}
+	rest := gamedata.RestType(strings.ToLower(ctx.Args[0]))
+	if !gamedata.IsValidRestType(rest) {
+		return sendErrorEmbed(ctx, "Tipo de descanso inválido! Use curto ou longo.")
+	}
+
+	dice := 1
+	if rest == gamedata.ShortRest && len(ctx.Args) > 1 {
+		n, err := parseInt(ctx.Args[1])
+		if err != nil || n < 1 {
+			return sendErrorEmbed(ctx, "Quantidade de dados de vida inválida!")
+		}
+		dice = n
+	}
+
+	character, err := rc.characterService.GetCharacterByUserAndGuild(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID)
+	if err != nil || character == nil {
+		return ctx.Reply("Você não possui um personagem neste servidor!")
+	}
+
+	result, err := rc.characterService.Rest(context.Background(), character, rest, dice)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Não foi possível descansar: %s", err))
+	}
+
+	lines := []string{fmt.Sprintf("❤️ Recuperou %d de vida (%d/%d)", result.Healed, character.Combat.Health, character.Combat.MaxHealth)}
+	if len(result.HitDiceRolls) > 0 {
+		rolls := make([]string, 0, len(result.HitDiceRolls))
+		for _, roll := range result.HitDiceRolls {
+			rolls = append(rolls, fmt.Sprint(roll))
+		}
+		lines = append(lines, fmt.Sprintf("🎲 Dados de vida (d%d): %s", character.Ruleset().HitDie(character.Class), strings.Join(rolls, " + ")))
+	}
+	if result.HitDiceRecovered > 0 {
+		lines = append(lines, fmt.Sprintf("🔄 Recuperou %d dado(s) de vida", result.HitDiceRecovered))
+	}
+	for _, condition := range result.Removed {
+		lines = append(lines, fmt.Sprintf("✨ Não está mais %s", condition))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🏕️ %s: %s", gamedata.RestTypeNames[result.Type], character.Name),
+		Description: strings.Join(lines, "\n"),
+		Color:       0x00cc66,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Dados de vida disponíveis: %d/%d", result.HitDiceAvailable, character.Level),
+		},
+	}
+	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
+
+// handleDeathSave processa o comando de teste contra a morte
+func (rc *RestCommands) handleDeathSave(ctx *CommandContext) error {
+	character, err := rc.characterService.GetCharacterByUserAndGuild(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID)
+	if err != nil || character == nil {
+		return ctx.Reply("Você não possui um personagem neste servidor!")
+	}
+
+	result, err := rc.characterService.DeathSave(context.Background(), character)
+	if err != nil {
+		return sendErrorEmbed(ctx, fmt.Sprintf("Não foi possível fazer o teste: %s", err))
+	}
+
+	outcome := "❌ Falha"
+	if result.Success {
+		outcome = "✅ Sucesso"
+	}
+	lines := []string{
+		fmt.Sprintf("🎲 d20: **%d** (CD %d) — %s", result.Roll, gamedata.DeathSaveDC, outcome),
+		fmt.Sprintf("Sucessos: %s\nFalhas: %s", deathSaveMarks(result.Saves.Successes, gamedata.DeathSavesToRecover, "🟢"), deathSaveMarks(result.Saves.Failures, gamedata.DeathSavesToDie, "🔴")),
+	}
+
+	color := getResultColor(result.Success)
+	switch {
+	case result.Revived:
+		lines = append(lines, fmt.Sprintf("🌟 Um 20 natural! %s se levanta com 1 de vida.", character.Name))
+	case result.Spared:
+		lines = append(lines, fmt.Sprintf("🛌 %s escapou da morte por pouco e está estável, mas exausto.", character.Name))
+	case result.State == entities.Stable:
+		lines = append(lines, fmt.Sprintf("🛌 %s está estável. Descanse para recuperar a vida.", character.Name))
+	case result.State == entities.Dead:
+		color = 0x000000
+		lines = append(lines, fmt.Sprintf("💀 %s morreu.", character.Name))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("⚰️ Teste contra a morte: %s", character.Name),
+		Description: strings.Join(lines, "\n"),
+		Color:       color,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: result.State.Name(),
+		},
+	}
+	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
+
+// deathSaveMarks mostra a contagem de testes contra a morte como marcadores
+func deathSaveMarks(count, total int, mark string) string {
+	count = min(count, total)
+	return strings.Repeat(mark, count) + strings.Repeat("⚪", total-count)
+}
@@ -3,10 +3,10 @@ package commands
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"strings"
-	"time"
 
+	"sirdraith/internal/domain/dice"
+	"sirdraith/internal/domain/entities"
 	"sirdraith/internal/domain/gamedata"
 	"sirdraith/internal/domain/services"
 
@@ -16,15 +16,32 @@ import (
 // SkillCommands encapsula os comandos relacionados a perícias
 type SkillCommands struct {
 	characterService *services.CharacterService
+	rollService      *services.RollService
 }
 
 // NewSkillCommands cria uma nova instância de SkillCommands
-func NewSkillCommands(characterService *services.CharacterService) *SkillCommands {
+func NewSkillCommands(characterService *services.CharacterService, rollService *services.RollService) *SkillCommands {
 	return &SkillCommands{
 		characterService: characterService,
+		rollService:      rollService,
 	}
 }
 
+// rollModes são as palavras que pedem vantagem ou desvantagem em uma rolagem
+var rollModes = map[string]dice.Mode{
+	"vantagem":    dice.Advantage,
+	"adv":         dice.Advantage,
+	"desvantagem": dice.Disadvantage,
+	"desv":        dice.Disadvantage,
+	"dis":         dice.Disadvantage,
+}
+
+// rollModeNames descrevem o modo da rolagem no resultado
+var rollModeNames = map[dice.Mode]string{
+	dice.Advantage:    "⬆️ Com vantagem",
+	dice.Disadvantage: "⬇️ Com desvantagem",
+}
+
 // Register registra os comandos de perícia
 func (sc *SkillCommands) Register(registry *CommandRegistry) {
 	registry.RegisterCommand(&Command{
@@ -35,6 +52,42 @@ func (sc *SkillCommands) Register(registry *CommandRegistry) {
 		Handler:     sc.handleRoll,
 	})
 
+	registry.RegisterCommand(&Command{
+		Name:        "rolar",
+		Aliases:     []string{"r", "dados"},
+		Description: "Rola uma expressão de dados, como 2d6+3, 4d6kh3, 3d6! ou 1d20+FOR+stealth",
+		Usage:       "rolar <expressão> [vantagem | desvantagem]",
+		Category:    "Perícias",
+		Handler:     sc.handleDiceRoll,
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "teste",
+		Aliases:     []string{"check", "atributo"},
+		Description: "Realiza um teste de atributo, como Força ou Sabedoria",
+		Usage:       "teste <atributo> [dificuldade] [vantagem | desvantagem]",
+		Category:    "Perícias",
+		Handler:     sc.handleCheck(false),
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "salvaguarda",
+		Aliases:     []string{"save", "st"},
+		Description: "Realiza uma salvaguarda, somando a proficiência se a classe for proficiente no atributo",
+		Usage:       "salvaguarda <atributo> [dificuldade] [vantagem | desvantagem]",
+		Category:    "Perícias",
+		Handler:     sc.handleCheck(true),
+	})
+
+	registry.RegisterCommand(&Command{
+		Name:        "rolagens",
+		Aliases:     []string{"historico-rolagens", "rolls"},
+		Description: "Mostra as últimas rolagens registradas pela auditoria do servidor",
+		Usage:       "rolagens [@usuário]",
+		Category:    "Perícias",
+		Handler:     sc.handleHistory,
+	})
+
 	registry.RegisterCommand(&Command{
 		Name:        "skills",
 		Description: "Lista as perícias do seu personagem",
@@ -90,9 +143,12 @@ func (sc *SkillCommands) handleRoll(ctx *CommandContext) error {
 	}
 
 	// Rolar o dado
-	rand.Seed(time.Now().UnixNano())
-	roll := rand.Intn(20) + 1
 	modifier := character.GetSkillModifier(skill)
+	description := fmt.Sprintf("1d20%+d (%s, CD %d)", modifier, skill, dc)
+	roll, err := sc.rollService.RollD20(context.Background(), services.RollerOf(character), entities.RollSkillCheck, description)
+	if err != nil {
+		return sendErrorEmbed(ctx, err.Error())
+	}
 	total := roll + modifier
 
 	// Determinar o resultado
@@ -130,6 +186,230 @@ func (sc *SkillCommands) handleRoll(ctx *CommandContext) error {
 	return err
 }
 
+// handleCheck cria o processador dos testes de atributo ou, com save, das salvaguardas
+func (sc *SkillCommands) handleCheck(save bool) func(ctx *CommandContext) error {
+	command, kind, label := "teste", entities.RollAttributeCheck, "Teste"
+	if save {
+		command, kind, label = "salvaguarda", entities.RollSavingThrow, "Salvaguarda"
+	}
+
+	return func(ctx *CommandContext) error {
+		character, err := sc.characterService.GetCharacterByUserAndGuild(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID)
+		if err != nil || character == nil {
+			return ctx.Reply("Você não possui um personagem neste servidor!")
+		}
+
+		if len(ctx.Args) < 1 {
+			return ctx.Reply(fmt.Sprintf("Use: %s <atributo> [dificuldade] [vantagem | desvantagem]. Ex: %s FOR 15 vantagem", command, command))
+		}
+		attribute, ok := gamedata.FindAttribute(ctx.Args[0])
+		if !ok {
+			return ctx.Reply("Atributo inválido! Use FOR, DES, CON, INT, SAB ou CAR.")
+		}
+
+		dc := 10 // Dificuldade padrão
+		mode := dice.Normal
+		for _, arg := range ctx.Args[1:] {
+			if m, ok := rollModes[strings.ToLower(arg)]; ok {
+				mode = m
+				continue
+			}
+			n, err := parseInt(arg)
+			if err != nil || n < 1 {
+				return ctx.Reply(fmt.Sprintf("Dificuldade inválida: %s", arg))
+			}
+			dc = n
+		}
+
+		modifier := character.GetAttributeCheckModifier(attribute)
+		if save {
+			modifier = character.GetSavingThrowModifier(attribute)
+		}
+		name := attributeLabels[attribute].name
+		description := fmt.Sprintf("%s de %s, CD %d", label, name, dc)
+		result, err := sc.rollService.RollCheck(context.Background(), services.RollerOf(character), kind, description, modifier, mode)
+		if err != nil {
+			return sendErrorEmbed(ctx, err.Error())
+		}
+
+		success := result.Total >= dc
+		resultEmoji := "❌"
+		if success {
+			resultEmoji = "✅"
+		}
+		summary := fmt.Sprintf("%s Resultado: %d", resultEmoji, result.Total)
+		if modeName, ok := rollModeNames[result.Mode]; ok {
+			summary = modeName + "\n" + summary
+		}
+		modifierText := fmt.Sprintf("%+d", modifier)
+		if save && character.HasSavingThrowProficiency(attribute) {
+			modifierText += " (proficiente)"
+		}
+
+		embed := &discordgo.MessageEmbed{
+			Title:       fmt.Sprintf("%s %s de %s", attributeLabels[attribute].emoji, label, name),
+			Description: summary,
+			Color:       getResultColor(success),
+			Fields: []*discordgo.MessageEmbedField{
+				{
+					Name:   "Rolagem",
+					Value:  strings.Join(diceTermLines(result.Terms[:1]), "\n"),
+					Inline: true,
+				},
+				{
+					Name:   "Modificador",
+					Value:  modifierText,
+					Inline: true,
+				},
+				{
+					Name:   "Dificuldade",
+					Value:  fmt.Sprintf("%d", dc),
+					Inline: true,
+				},
+			},
+			Footer: &discordgo.MessageEmbedFooter{Text: character.Name},
+		}
+
+		_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+		return err
+	}
+}
+
+// handleDiceRoll processa o comando de rolar uma expressão de dados. As
+// referências a atributos e perícias usam o personagem ativo do usuário
+func (sc *SkillCommands) handleDiceRoll(ctx *CommandContext) error {
+	mode := dice.Normal
+	var parts []string
+	for _, arg := range ctx.Args {
+		if m, ok := rollModes[strings.ToLower(arg)]; ok {
+			mode = m
+			continue
+		}
+		parts = append(parts, arg)
+	}
+	if len(parts) == 0 {
+		return ctx.Reply("Use: rolar <expressão> [vantagem | desvantagem]. Ex: rolar 2d6+3, rolar 4d6kh3, rolar 1d20+FOR vantagem")
+	}
+
+	expression, err := dice.Parse(strings.Join(parts, " "))
+	if err != nil {
+		return sendErrorEmbed(ctx, err.Error())
+	}
+
+	opts := dice.Options{Mode: mode}
+	roller := services.Roller{GuildID: ctx.Message.GuildID, UserID: ctx.Message.Author.ID}
+	character, err := sc.characterService.GetCharacterByUserAndGuild(context.Background(), ctx.Message.Author.ID, ctx.Message.GuildID)
+	if err == nil && character != nil {
+		opts.Resolve = character.ResolveReference
+		roller = services.RollerOf(character)
+	}
+
+	result, err := sc.rollService.Roll(context.Background(), roller, entities.RollExpression, expression, opts)
+	if err != nil {
+		return sendErrorEmbed(ctx, err.Error())
+	}
+
+	description := fmt.Sprintf("**Total: %d**", result.Total)
+	if name, ok := rollModeNames[result.Mode]; ok {
+		description = name + "\n" + description
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🎲 %s", result.Expression),
+		Description: description,
+		Color:       0x0099ff,
+	}
+	if len(result.Terms) > 0 {
+		embed.Fields = []*discordgo.MessageEmbedField{
+			{
+				Name:  "Detalhamento",
+				Value: truncateLines(diceTermLines(result.Terms), 1000),
+			},
+		}
+	}
+	if character != nil {
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: character.Name}
+	}
+
+	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
+
+// diceTermLines descreve cada termo de uma rolagem: os dados descartados saem
+// riscados e os gerados por explosão marcados com 💥
+func diceTermLines(terms []dice.Term) []string {
+	lines := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if term.IsReference() {
+			lines = append(lines, fmt.Sprintf("`%s` → %+d", term.Notation, term.Value))
+			continue
+		}
+
+		values := make([]string, 0, len(term.Dice))
+		for _, die := range term.Dice {
+			value := fmt.Sprint(die.Value)
+			if die.Exploded {
+				value = "💥" + value
+			}
+			if die.Dropped {
+				value = "~~" + value + "~~"
+			}
+			values = append(values, value)
+		}
+		lines = append(lines, fmt.Sprintf("`%s` → [%s] = %d", term.Notation, strings.Join(values, ", "), term.Value))
+	}
+	return lines
+}
+
+// handleHistory processa o comando de listar as rolagens registradas, de todos
+// ou do usuário mencionado
+func (sc *SkillCommands) handleHistory(ctx *CommandContext) error {
+	enabled, err := sc.rollService.AuditEnabled(ctx.Message.GuildID)
+	if err != nil {
+		return sendErrorEmbed(ctx, err.Error())
+	}
+	if !enabled {
+		return ctx.Reply("A auditoria de rolagens está desligada neste servidor. Um administrador pode ligá-la com auditoria-rolagens ligar.")
+	}
+
+	userID := ""
+	title := "📜 Últimas rolagens"
+	if len(ctx.Message.Mentions) > 0 {
+		userID = ctx.Message.Mentions[0].ID
+		title = fmt.Sprintf("📜 Últimas rolagens de %s", ctx.Message.Mentions[0].Username)
+	}
+
+	records, err := sc.rollService.History(context.Background(), ctx.Message.GuildID, userID, services.MaxRollHistory)
+	if err != nil {
+		return sendErrorEmbed(ctx, "Erro ao buscar as rolagens registradas.")
+	}
+	if len(records) == 0 {
+		return ctx.Reply("Nenhuma rolagem registrada ainda.")
+	}
+
+	lines := make([]string, 0, len(records))
+	for _, record := range records {
+		who := fmt.Sprintf("<@%s>", record.UserID)
+		if record.CharacterName != "" {
+			who += " (" + record.CharacterName + ")"
+		}
+		values := make([]string, 0, len(record.Dice))
+		for _, value := range record.Dice {
+			values = append(values, fmt.Sprint(value))
+		}
+		lines = append(lines, fmt.Sprintf("<t:%d:t> %s · %s `%s` → [%s] = **%d**",
+			record.CreatedAt.Unix(), who, entities.RollKindNames[record.Kind], record.Expression, strings.Join(values, ", "), record.Total))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: truncateLines(lines, 4000),
+		Color:       0x0099ff,
+	}
+	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
+	return err
+}
+
 // handleList processa o comando de listar perícias
 func (sc *SkillCommands) handleList(ctx *CommandContext) error {
 	// Buscar o personagem
@@ -189,6 +469,21 @@ func (sc *SkillCommands) handleList(ctx *CommandContext) error {
 		}
 	}
 
+	// Salvaguardas, marcando as proficiências da classe
+	saveList := ""
+	for _, attr := range gamedata.AttributeNames {
+		profMark := "  "
+		if character.HasSavingThrowProficiency(attr) {
+			profMark = "✓ "
+		}
+		saveList += fmt.Sprintf("%s%s: %+d\n", profMark, attributeLabels[attr].name, character.GetSavingThrowModifier(attr))
+	}
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name:   "🛡️ Salvaguardas",
+		Value:  saveList,
+		Inline: true,
+	})
+
 	_, err = ctx.Session.ChannelMessageSendEmbed(ctx.Message.ChannelID, embed)
 	return err
 }
@@ -8,21 +8,38 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repository"
 )
 
-const characterCollection = "characters"
+const (
+	characterCollection          = "characters"
+	characterSelectionCollection = "character_selections"
+)
 
 // CharacterRepository implementa a interface repositories.CharacterRepository
 type CharacterRepository struct {
 	collection *mongo.Collection
+	selections *mongo.Collection
+}
+
+// characterSelection guarda o personagem ativo de um usuário em um servidor.
+// Fica fora do documento do personagem para que Update não desfaça a seleção
+type characterSelection struct {
+	ID          string             `bson:"_id"` // Usuário e servidor
+	UserID      string             `bson:"user_id"`
+	GuildID     string             `bson:"guild_id"`
+	CharacterID primitive.ObjectID `bson:"character_id"`
+	UpdatedAt   time.Time          `bson:"updated_at"`
 }
 
 // NewCharacterRepository cria uma nova instância do repositório
 func NewCharacterRepository(db *mongo.Database) *CharacterRepository {
 	return &CharacterRepository{
 		collection: db.Collection(characterCollection),
+		selections: db.Collection(characterSelectionCollection),
 	}
 }
 
@@ -42,20 +59,74 @@ func (r *CharacterRepository) Create(ctx context.Context, character *entities.Ch
 	return nil
 }
 
-// Update atualiza um personagem existente
+// Update atualiza um personagem existente. Personagens em combate não são
+// sobrescritos: a caçada salva o resultado com EndCombat
 func (r *CharacterRepository) Update(ctx context.Context, character *entities.Character) error {
 	character.UpdatedAt = time.Now()
 
-	filter := bson.M{"_id": character.ID}
+	filter := bson.M{"_id": character.ID, "combat.is_in_combat": bson.M{"$ne": true}}
 	result, err := r.collection.ReplaceOne(ctx, filter, character)
 	if err != nil {
 		return fmt.Errorf("failed to update character: %w", err)
 	}
 
-	if result.ModifiedCount == 0 {
-		return fmt.Errorf("character not found")
+	if result.MatchedCount == 0 {
+		count, err := r.collection.CountDocuments(ctx, bson.M{"_id": character.ID})
+		if err != nil {
+			return fmt.Errorf("failed to update character: %w", err)
+		}
+		if count > 0 {
+			return entities.ErrCharacterInCombat
+		}
+		return repository.ErrCharacterNotFound
+	}
+
+	return nil
+}
+
+// SetInCombat marca ou desmarca o personagem como em combate
+func (r *CharacterRepository) SetInCombat(ctx context.Context, id string, inCombat bool) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid id format: %w", err)
+	}
+
+	update := bson.M{"$set": bson.M{"combat.is_in_combat": inCombat, "updated_at": time.Now()}}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update character combat: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return repository.ErrCharacterNotFound
 	}
+	return nil
+}
 
+// EndCombat salva apenas os campos alterados por um combate e soma o ouro ganho,
+// preservando o restante do personagem salvo
+func (r *CharacterRepository) EndCombat(ctx context.Context, character *entities.Character, gold int) error {
+	character.Combat.IsInCombat = false
+	character.UpdatedAt = time.Now()
+
+	update := bson.M{
+		"$set": bson.M{
+			"combat":          character.Combat,
+			"conditions":      character.Conditions,
+			"level":           character.Level,
+			"experience":      character.Experience,
+			"pending_choices": character.PendingChoices,
+			"inventory":       character.Inventory,
+			"updated_at":      character.UpdatedAt,
+		},
+		"$inc": bson.M{"gold": gold},
+	}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": character.ID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to save combat result: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return repository.ErrCharacterNotFound
+	}
 	return nil
 }
 
@@ -80,7 +151,7 @@ func (r *CharacterRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	if result.ModifiedCount == 0 {
-		return fmt.Errorf("character not found")
+		return repository.ErrCharacterNotFound
 	}
 
 	return nil
@@ -99,7 +170,7 @@ func (r *CharacterRepository) GetByID(ctx context.Context, id string) (*entities
 	err = r.collection.FindOne(ctx, filter).Decode(&character)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("character not found")
+			return nil, repository.ErrCharacterNotFound
 		}
 		return nil, fmt.Errorf("failed to get character: %w", err)
 	}
@@ -107,19 +178,38 @@ func (r *CharacterRepository) GetByID(ctx context.Context, id string) (*entities
 	return &character, nil
 }
 
-// GetByUserAndGuild busca um personagem pelo ID do usuário e do servidor
+// GetByUserAndGuild busca o personagem selecionado pelo usuário no servidor.
+// Se nenhum estiver selecionado, retorna o personagem mais antigo
 func (r *CharacterRepository) GetByUserAndGuild(ctx context.Context, userID, guildID string) (*entities.Character, error) {
+	selected, err := r.selectedID(ctx, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+
 	var character entities.Character
 	filter := bson.M{
 		"user_id":   userID,
 		"guild_id":  guildID,
 		"is_active": true,
 	}
+	if !selected.IsZero() {
+		err := r.collection.FindOne(ctx, bson.M{"_id": selected, "user_id": userID, "guild_id": guildID, "is_active": true}).Decode(&character)
+		if err == nil {
+			character.IsSelected = true
+			return &character, nil
+		}
+		if err != mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("failed to get character: %w", err)
+		}
+		// O personagem selecionado foi apagado
+	}
 
-	err := r.collection.FindOne(ctx, filter).Decode(&character)
+	// Seleções feitas antes da coleção de seleções ficaram no próprio personagem
+	opts := options.FindOne().SetSort(bson.D{{Key: "is_selected", Value: -1}, {Key: "created_at", Value: 1}})
+	err = r.collection.FindOne(ctx, filter, opts).Decode(&character)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("character not found")
+			return nil, repository.ErrCharacterNotFound
 		}
 		return nil, fmt.Errorf("failed to get character: %w", err)
 	}
@@ -223,6 +313,90 @@ func (r *CharacterRepository) CountByUser(ctx context.Context, userID string) (i
 	return count, nil
 }
 
+// ListByUserAndGuild lista os personagens de um usuário em um servidor, do mais antigo ao mais novo
+func (r *CharacterRepository) ListByUserAndGuild(ctx context.Context, userID, guildID string) ([]*entities.Character, error) {
+	filter := bson.M{"user_id": userID, "guild_id": guildID, "is_active": true}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list characters: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var characters []*entities.Character
+	if err := cursor.All(ctx, &characters); err != nil {
+		return nil, fmt.Errorf("failed to decode characters: %w", err)
+	}
+
+	selected, err := r.selectedID(ctx, userID, guildID)
+	if err != nil {
+		return nil, err
+	}
+	for _, character := range characters {
+		character.IsSelected = character.ID == selected
+	}
+
+	return characters, nil
+}
+
+// CountByUserAndGuild conta o número de personagens de um usuário em um servidor
+func (r *CharacterRepository) CountByUserAndGuild(ctx context.Context, userID, guildID string) (int64, error) {
+	filter := bson.M{"user_id": userID, "guild_id": guildID, "is_active": true}
+	count, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count characters: %w", err)
+	}
+	return count, nil
+}
+
+// SetSelected seleciona um personagem do usuário no servidor
+func (r *CharacterRepository) SetSelected(ctx context.Context, userID, guildID, characterID string) error {
+	objectID, err := primitive.ObjectIDFromHex(characterID)
+	if err != nil {
+		return fmt.Errorf("invalid id format: %w", err)
+	}
+
+	filter := bson.M{"_id": objectID, "user_id": userID, "guild_id": guildID, "is_active": true}
+	count, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to select character: %w", err)
+	}
+	if count == 0 {
+		return repository.ErrCharacterNotFound
+	}
+
+	selection := characterSelection{
+		ID:          selectionID(userID, guildID),
+		UserID:      userID,
+		GuildID:     guildID,
+		CharacterID: objectID,
+		UpdatedAt:   time.Now(),
+	}
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.selections.ReplaceOne(ctx, bson.M{"_id": selection.ID}, selection, opts); err != nil {
+		return fmt.Errorf("failed to select character: %w", err)
+	}
+	return nil
+}
+
+// selectedID retorna o ID do personagem selecionado pelo usuário no servidor,
+// ou um ID vazio se nenhum foi selecionado
+func (r *CharacterRepository) selectedID(ctx context.Context, userID, guildID string) (primitive.ObjectID, error) {
+	var selection characterSelection
+	err := r.selections.FindOne(ctx, bson.M{"_id": selectionID(userID, guildID)}).Decode(&selection)
+	if err == mongo.ErrNoDocuments {
+		return primitive.NilObjectID, nil
+	}
+	if err != nil {
+		return primitive.NilObjectID, fmt.Errorf("failed to get character selection: %w", err)
+	}
+	return selection.CharacterID, nil
+}
+
+func selectionID(userID, guildID string) string {
+	return userID + ":" + guildID
+}
+
 // GetByUserID lista todos os personagens de um usuário
 func (r *CharacterRepository) GetByUserID(ctx context.Context, userID string) ([]*entities.Character, error) {
 	return r.ListByUser(ctx, userID)
@@ -96,6 +96,12 @@ func (r *MongoConfigRepository) UpdateGuildConfig(guildID string, config *model.
 			"prefix":          config.Prefix,
 			"welcome_channel": config.WelcomeChannel,
 			"goodbye_channel": config.GoodbyeChannel,
+			"quest_channel":   config.QuestChannel,
+			"max_characters":  config.MaxCharacters,
+			"progression":     config.Progression,
+			"permadeath":      config.Permadeath,
+			"roll_audit":      config.RollAudit,
+			"deck_rules":      config.DeckRules,
 			"updated_at":      config.UpdatedAt,
 		},
 	}
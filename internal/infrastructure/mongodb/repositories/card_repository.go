@@ -2,9 +2,11 @@ package repositories
 
 import (
 	"context"
+	"regexp"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"sirdraith/internal/domain/entities"
 	"sirdraith/internal/domain/repositories"
@@ -36,6 +38,14 @@ func (r *MongoCardRepository) Update(ctx context.Context, card *entities.Card) e
 	return err
 }
 
+// Upsert creates or replaces a card in MongoDB
+func (r *MongoCardRepository) Upsert(ctx context.Context, card *entities.Card) error {
+	filter := bson.M{"_id": card.ID}
+	opts := options.Replace().SetUpsert(true)
+	_, err := r.collection.ReplaceOne(ctx, filter, card, opts)
+	return err
+}
+
 // Delete removes a card from MongoDB
 func (r *MongoCardRepository) Delete(ctx context.Context, id string) error {
 	filter := bson.M{"_id": id}
@@ -103,3 +113,64 @@ func (r *MongoCardRepository) FindByRarity(ctx context.Context, rarity entities.
 	}
 	return cards, nil
 }
+
+// Search retrieves one page of cards matching the filter from MongoDB
+func (r *MongoCardRepository) Search(ctx context.Context, filter repositories.CardFilter, page, pageSize int) ([]*entities.Card, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	query := buildCardQuery(filter)
+	total, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64((page - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+	cursor, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var cards []*entities.Card
+	if err = cursor.All(ctx, &cards); err != nil {
+		return nil, 0, err
+	}
+	return cards, total, nil
+}
+
+// buildCardQuery converts a card filter into a MongoDB query
+func buildCardQuery(filter repositories.CardFilter) bson.M {
+	query := bson.M{}
+	if filter.Name != "" {
+		query["name"] = bson.M{"$regex": regexp.QuoteMeta(filter.Name), "$options": "i"}
+	}
+	if filter.Type != "" {
+		query["type"] = filter.Type
+	}
+	if filter.Rarity != "" {
+		query["rarity"] = filter.Rarity
+	}
+	if filter.Keyword != "" {
+		query["keywords"] = bson.M{"$regex": "^" + regexp.QuoteMeta(filter.Keyword) + "$", "$options": "i"}
+	}
+
+	cost := bson.M{}
+	if filter.MinCost != nil {
+		cost["$gte"] = *filter.MinCost
+	}
+	if filter.MaxCost != nil {
+		cost["$lte"] = *filter.MaxCost
+	}
+	if len(cost) > 0 {
+		query["cost"] = cost
+	}
+	return query
+}
@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repositories"
 
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -43,7 +45,7 @@ func TestMongoCardRepository(t *testing.T) {
 			2,
 			0,
 			0,
-			[]string{"effect1"},
+			[]entities.Effect{{Type: entities.EffectDamage, Value: 1, Target: entities.EffectTargetOpponent}},
 			[]string{"keyword1"},
 			"image.jpg",
 		)
@@ -62,7 +64,7 @@ func TestMongoCardRepository(t *testing.T) {
 			3,
 			2,
 			2,
-			[]string{"effect2"},
+			[]entities.Effect{{Type: entities.EffectDamage, Value: 2, Target: entities.EffectTargetOpponent}},
 			[]string{"keyword2"},
 			"image2.jpg",
 		)
@@ -86,7 +88,7 @@ func TestMongoCardRepository(t *testing.T) {
 			1,
 			0,
 			0,
-			[]string{"effect3"},
+			[]entities.Effect{{Type: entities.EffectDamage, Value: 3, Target: entities.EffectTargetOpponent}},
 			[]string{"keyword3"},
 			"image3.jpg",
 		)
@@ -115,7 +117,7 @@ func TestMongoCardRepository(t *testing.T) {
 			4,
 			0,
 			0,
-			[]string{"effect4"},
+			[]entities.Effect{{Type: entities.EffectDamage, Value: 4, Target: entities.EffectTargetOpponent}},
 			[]string{"keyword4"},
 			"image4.jpg",
 		)
@@ -151,4 +153,39 @@ func TestMongoCardRepository(t *testing.T) {
 			assert.Equal(t, entities.CardRarityRare, card.Rarity)
 		}
 	})
+	t.Run("Search", func(t *testing.T) {
+		minCost, maxCost := 2, 3
+		cards, total, err := repo.Search(context.Background(), repositories.CardFilter{
+			Name:    "test card",
+			MinCost: &minCost,
+			MaxCost: &maxCost,
+		}, 1, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len(cards)), total)
+		for _, card := range cards {
+			assert.GreaterOrEqual(t, card.Cost, minCost)
+			assert.LessOrEqual(t, card.Cost, maxCost)
+		}
+
+		cards, total, err = repo.Search(context.Background(), repositories.CardFilter{Keyword: "keyword2"}, 1, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, "card2", cards[0].ID)
+	})
+}
+
+func TestBuildCardQuery(t *testing.T) {
+	minCost := 2
+	query := buildCardQuery(repositories.CardFilter{
+		Name:    "fogo (antigo)",
+		Rarity:  entities.CardRarityRare,
+		MinCost: &minCost,
+	})
+
+	assert.Equal(t, bson.M{
+		"name":   bson.M{"$regex": `fogo \(antigo\)`, "$options": "i"},
+		"rarity": entities.CardRarityRare,
+		"cost":   bson.M{"$gte": 2},
+	}, query)
+	assert.Empty(t, buildCardQuery(repositories.CardFilter{}))
 }
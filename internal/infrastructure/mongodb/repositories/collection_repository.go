@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repositories"
+)
+
+// MongoCollectionRepository implementa a interface CollectionRepository usando MongoDB
+type MongoCollectionRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoCollectionRepository cria um novo repositório de coleções MongoDB
+func NewMongoCollectionRepository(db *mongo.Database) repositories.CollectionRepository {
+	return &MongoCollectionRepository{
+		collection: db.Collection("card_collections"),
+	}
+}
+
+// FindByCharacter busca a coleção de um personagem no MongoDB
+func (r *MongoCollectionRepository) FindByCharacter(ctx context.Context, characterID string) (*entities.CardCollection, error) {
+	filter := bson.M{"character_id": characterID}
+	var collection entities.CardCollection
+	err := r.collection.FindOne(ctx, filter).Decode(&collection)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &collection, nil
+}
+
+// Save cria ou substitui a coleção de um personagem no MongoDB
+func (r *MongoCollectionRepository) Save(ctx context.Context, collection *entities.CardCollection) error {
+	filter := bson.M{"character_id": collection.CharacterID}
+	opts := options.Replace().SetUpsert(true)
+	_, err := r.collection.ReplaceOne(ctx, filter, collection, opts)
+	return err
+}
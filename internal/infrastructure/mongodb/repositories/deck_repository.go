@@ -2,8 +2,10 @@ package repositories
 
 import (
 	"context"
+	"fmt"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 
 	"sirdraith/internal/domain/entities"
@@ -38,16 +40,26 @@ func (r *MongoDeckRepository) Update(ctx context.Context, deck *entities.Deck) e
 
 // Delete remove um deck do MongoDB
 func (r *MongoDeckRepository) Delete(ctx context.Context, id string) error {
-	filter := bson.M{"_id": id}
-	_, err := r.collection.DeleteOne(ctx, filter)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("id de deck inválido: %w", err)
+	}
+
+	filter := bson.M{"_id": objectID}
+	_, err = r.collection.DeleteOne(ctx, filter)
 	return err
 }
 
 // FindByID busca um deck pelo ID no MongoDB
 func (r *MongoDeckRepository) FindByID(ctx context.Context, id string) (*entities.Deck, error) {
-	filter := bson.M{"_id": id}
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil
+	}
+
+	filter := bson.M{"_id": objectID}
 	var deck entities.Deck
-	err := r.collection.FindOne(ctx, filter).Decode(&deck)
+	err = r.collection.FindOne(ctx, filter).Decode(&deck)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, nil
@@ -57,9 +69,9 @@ func (r *MongoDeckRepository) FindByID(ctx context.Context, id string) (*entitie
 	return &deck, nil
 }
 
-// FindByUser busca todos os decks de um usuário no MongoDB
-func (r *MongoDeckRepository) FindByUser(ctx context.Context, userID string) ([]*entities.Deck, error) {
-	filter := bson.M{"user_id": userID}
+// FindByUserAndGuild busca todos os decks de um usuário em um servidor no MongoDB
+func (r *MongoDeckRepository) FindByUserAndGuild(ctx context.Context, userID, guildID string) ([]*entities.Deck, error) {
+	filter := bson.M{"user_id": userID, "guild_id": guildID}
 	cursor, err := r.collection.Find(ctx, filter)
 	if err != nil {
 		return nil, err
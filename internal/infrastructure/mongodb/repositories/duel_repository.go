@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"sirdraith/internal/domain/battle"
+	"sirdraith/internal/domain/repositories"
+)
+
+// MongoDuelRepository implementa a interface DuelRepository usando MongoDB
+type MongoDuelRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoDuelRepository cria um novo repositório de duelos MongoDB
+func NewMongoDuelRepository(db *mongo.Database) repositories.DuelRepository {
+	return &MongoDuelRepository{
+		collection: db.Collection("duels"),
+	}
+}
+
+// Create armazena um novo desafio no MongoDB
+func (r *MongoDuelRepository) Create(ctx context.Context, match *battle.Match) error {
+	_, err := r.collection.InsertOne(ctx, match)
+	return err
+}
+
+// Update substitui o estado de um desafio no MongoDB
+func (r *MongoDuelRepository) Update(ctx context.Context, match *battle.Match) error {
+	filter := bson.M{"_id": match.ID}
+	_, err := r.collection.ReplaceOne(ctx, filter, match)
+	return err
+}
+
+// FindOpenByUser busca o desafio pendente ou em andamento de um usuário no MongoDB
+func (r *MongoDuelRepository) FindOpenByUser(ctx context.Context, guildID, userID string) (*battle.Match, error) {
+	filter := bson.M{
+		"guild_id": guildID,
+		"status":   bson.M{"$in": []battle.MatchStatus{battle.MatchPending, battle.MatchActive}},
+		"$or": []bson.M{
+			{"challenger_id": userID},
+			{"opponent_id": userID},
+		},
+	}
+
+	var match battle.Match
+	err := r.collection.FindOne(ctx, filter).Decode(&match)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &match, nil
+}
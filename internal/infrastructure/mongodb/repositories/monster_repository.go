@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repositories"
+)
+
+// MongoMonsterRepository implementa a interface MonsterRepository usando MongoDB
+type MongoMonsterRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoMonsterRepository cria um novo repositório de monstros MongoDB
+func NewMongoMonsterRepository(db *mongo.Database) repositories.MonsterRepository {
+	return &MongoMonsterRepository{
+		collection: db.Collection("monsters"),
+	}
+}
+
+// FindByID busca um monstro pelo ID no MongoDB
+func (r *MongoMonsterRepository) FindByID(ctx context.Context, id string) (*entities.Monster, error) {
+	var monster entities.Monster
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&monster)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &monster, nil
+}
+
+// FindAll busca todos os monstros no MongoDB
+func (r *MongoMonsterRepository) FindAll(ctx context.Context) ([]*entities.Monster, error) {
+	return r.find(ctx, bson.M{})
+}
+
+// FindByChallenge busca os monstros dentro do intervalo de desafio no MongoDB
+func (r *MongoMonsterRepository) FindByChallenge(ctx context.Context, min, max float64) ([]*entities.Monster, error) {
+	return r.find(ctx, bson.M{"challenge_rating": bson.M{"$gte": min, "$lte": max}})
+}
+
+// Upsert cria ou substitui um monstro no MongoDB
+func (r *MongoMonsterRepository) Upsert(ctx context.Context, monster *entities.Monster) error {
+	opts := options.Replace().SetUpsert(true)
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": monster.ID}, monster, opts)
+	return err
+}
+
+func (r *MongoMonsterRepository) find(ctx context.Context, filter bson.M) ([]*entities.Monster, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "challenge_rating", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var monsters []*entities.Monster
+	if err = cursor.All(ctx, &monsters); err != nil {
+		return nil, err
+	}
+	return monsters, nil
+}
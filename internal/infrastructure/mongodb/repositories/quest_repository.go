@@ -0,0 +1,231 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repositories"
+)
+
+// MongoQuestRepository implementa a interface QuestRepository usando MongoDB
+type MongoQuestRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoQuestRepository cria um novo repositório de missões MongoDB
+func NewMongoQuestRepository(db *mongo.Database) repositories.QuestRepository {
+	return &MongoQuestRepository{
+		collection: db.Collection("quests"),
+	}
+}
+
+// Create insere uma nova missão no MongoDB
+func (r *MongoQuestRepository) Create(ctx context.Context, quest *entities.Quest) error {
+	if quest.ID.IsZero() {
+		quest.ID = primitive.NewObjectID()
+	}
+	_, err := r.collection.InsertOne(ctx, quest)
+	return err
+}
+
+// FindByID busca uma missão pelo ID no MongoDB
+func (r *MongoQuestRepository) FindByID(ctx context.Context, id string) (*entities.Quest, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil
+	}
+
+	var quest entities.Quest
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&quest)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &quest, nil
+}
+
+// FindAvailable busca as missões de um servidor que ainda não expiraram no MongoDB
+func (r *MongoQuestRepository) FindAvailable(ctx context.Context, guildID string, now time.Time) ([]*entities.Quest, error) {
+	filter := bson.M{
+		"guild_id": guildID,
+		"$or": bson.A{
+			bson.M{"expires_at": bson.M{"$exists": false}},
+			bson.M{"expires_at": bson.M{"$gt": now}},
+		},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var quests []*entities.Quest
+	if err = cursor.All(ctx, &quests); err != nil {
+		return nil, err
+	}
+	return quests, nil
+}
+
+// ExpireByCadence retira do quadro as missões de uma frequência no MongoDB
+func (r *MongoQuestRepository) ExpireByCadence(ctx context.Context, guildID string, cadence entities.QuestCadence, now time.Time) error {
+	filter := bson.M{
+		"guild_id": guildID,
+		"cadence":  cadence,
+		"$or": bson.A{
+			bson.M{"expires_at": bson.M{"$exists": false}},
+			bson.M{"expires_at": bson.M{"$gt": now}},
+		},
+	}
+	_, err := r.collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"expires_at": now}})
+	return err
+}
+
+// MongoQuestProgressRepository implementa a interface QuestProgressRepository usando MongoDB
+type MongoQuestProgressRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoQuestProgressRepository cria um novo repositório de progresso de missões MongoDB
+func NewMongoQuestProgressRepository(db *mongo.Database) repositories.QuestProgressRepository {
+	return &MongoQuestProgressRepository{
+		collection: db.Collection("quest_progress"),
+	}
+}
+
+// Create insere uma missão aceita no MongoDB
+func (r *MongoQuestProgressRepository) Create(ctx context.Context, progress *entities.QuestProgress) error {
+	_, err := r.collection.InsertOne(ctx, progress)
+	return err
+}
+
+// Update substitui o progresso de uma missão no MongoDB
+func (r *MongoQuestProgressRepository) Update(ctx context.Context, progress *entities.QuestProgress) error {
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": progress.ID}, progress)
+	return err
+}
+
+// Complete salva a missão concluída no MongoDB somente se ela ainda estiver
+// ativa, para que a recompensa não seja entregue duas vezes
+func (r *MongoQuestProgressRepository) Complete(ctx context.Context, progress *entities.QuestProgress) error {
+	filter := bson.M{"_id": progress.ID, "status": entities.QuestActive}
+	result, err := r.collection.ReplaceOne(ctx, filter, progress)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return entities.ErrQuestNotActive
+	}
+	return nil
+}
+
+// FindActiveByCharacter busca as missões em andamento de um personagem no MongoDB
+func (r *MongoQuestProgressRepository) FindActiveByCharacter(ctx context.Context, characterID string) ([]*entities.QuestProgress, error) {
+	filter := bson.M{"character_id": characterID, "status": entities.QuestActive}
+	opts := options.Find().SetSort(bson.D{{Key: "accepted_at", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var progress []*entities.QuestProgress
+	if err = cursor.All(ctx, &progress); err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
+
+// FindByCharacterAndQuest busca o progresso de um personagem em uma missão no MongoDB
+func (r *MongoQuestProgressRepository) FindByCharacterAndQuest(ctx context.Context, characterID, questID string) (*entities.QuestProgress, error) {
+	var progress entities.QuestProgress
+	err := r.collection.FindOne(ctx, bson.M{"character_id": characterID, "quest_id": questID}).Decode(&progress)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &progress, nil
+}
+
+// ExpireOverdue marca como expiradas as missões com prazo vencido no MongoDB
+func (r *MongoQuestProgressRepository) ExpireOverdue(ctx context.Context, guildID string, now time.Time) (int64, error) {
+	filter := bson.M{
+		"guild_id": guildID,
+		"status":   entities.QuestActive,
+		"deadline": bson.M{"$lte": now},
+	}
+	result, err := r.collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"status": entities.QuestExpired}})
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// MongoQuestScheduleRepository implementa a interface QuestScheduleRepository usando MongoDB
+type MongoQuestScheduleRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoQuestScheduleRepository cria um novo repositório do estado da renovação de missões MongoDB
+func NewMongoQuestScheduleRepository(db *mongo.Database) repositories.QuestScheduleRepository {
+	return &MongoQuestScheduleRepository{
+		collection: db.Collection("quest_schedules"),
+	}
+}
+
+// Get busca o estado da renovação de um servidor no MongoDB
+func (r *MongoQuestScheduleRepository) Get(ctx context.Context, guildID string, cadence entities.QuestCadence) (*entities.QuestSchedule, error) {
+	var schedule entities.QuestSchedule
+	err := r.collection.FindOne(ctx, bson.M{"_id": entities.QuestScheduleID(guildID, cadence)}).Decode(&schedule)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// Claim reivindica a renovação de um período no MongoDB. A atualização só casa
+// com estados de períodos anteriores; se outro processo já reivindicou o
+// período, o upsert colide com o documento existente e nada é alterado
+func (r *MongoQuestScheduleRepository) Claim(ctx context.Context, guildID string, cadence entities.QuestCadence, period time.Time) (bool, error) {
+	filter := bson.M{
+		"_id":    entities.QuestScheduleID(guildID, cadence),
+		"period": bson.M{"$lt": period},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"guild_id":   guildID,
+			"cadence":    cadence,
+			"period":     period,
+			"updated_at": time.Now(),
+		},
+		"$unset": bson.M{"rotated_at": "", "posted_at": ""},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return result.ModifiedCount > 0 || result.UpsertedCount > 0, nil
+}
+
+// Update substitui o estado da renovação no MongoDB
+func (r *MongoQuestScheduleRepository) Update(ctx context.Context, schedule *entities.QuestSchedule) error {
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": schedule.ID}, schedule, options.Replace().SetUpsert(true))
+	return err
+}
@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"sirdraith/internal/domain/entities"
+	"sirdraith/internal/domain/repositories"
+)
+
+// MongoRollRepository implementa a interface RollRepository usando MongoDB
+type MongoRollRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoRollRepository cria um novo repositório de auditoria de rolagens MongoDB
+func NewMongoRollRepository(db *mongo.Database) repositories.RollRepository {
+	return &MongoRollRepository{
+		collection: db.Collection("roll_audit"),
+	}
+}
+
+// Create insere o registro de uma rolagem no MongoDB
+func (r *MongoRollRepository) Create(ctx context.Context, record *entities.RollRecord) error {
+	if record.ID.IsZero() {
+		record.ID = primitive.NewObjectID()
+	}
+	_, err := r.collection.InsertOne(ctx, record)
+	return err
+}
+
+// FindRecent busca as rolagens mais recentes de um servidor no MongoDB
+func (r *MongoRollRepository) FindRecent(ctx context.Context, guildID, userID string, limit int) ([]*entities.RollRecord, error) {
+	filter := bson.M{"guild_id": guildID}
+	if userID != "" {
+		filter["user_id"] = userID
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var records []*entities.RollRecord
+	if err = cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}